@@ -0,0 +1,238 @@
+package api
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"massnet.org/mass/errors"
+	"massnet.org/mass/logging"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+	minTokenLength   = 16
+)
+
+// Role is the scope granted to an API token. Roles are ordered,
+// a role is granted all methods of lower roles.
+type Role uint8
+
+const (
+	RoleReadOnly Role = iota
+	RoleMiningOperator
+	RoleWalletAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleReadOnly:       "read-only",
+	RoleMiningOperator: "mining-operator",
+	RoleWalletAdmin:    "wallet-admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("invalid(%d)", r)
+}
+
+// methodRoles is the least role required by each method of ApiService,
+// methods not listed require RoleWalletAdmin.
+var methodRoles = map[string]Role{
+	// blocks & transactions
+	"GetBestBlock":           RoleReadOnly,
+	"GetBlock":               RoleReadOnly,
+	"GetBlockHashByHeight":   RoleReadOnly,
+	"GetBlockByHeight":       RoleReadOnly,
+	"GetBlockHeader":         RoleReadOnly,
+	"GetBlockHeightByPubKey": RoleReadOnly,
+	"GetBlockV2":             RoleReadOnly,
+	"GetBlockHeaderV2":       RoleReadOnly,
+	"GetBlockVerbose1V2":     RoleReadOnly,
+	"GetCoinbase":            RoleReadOnly,
+	"GetTxPool":              RoleReadOnly,
+	"GetTxPoolVerbose0":      RoleReadOnly,
+	"GetTxPoolVerbose1":      RoleReadOnly,
+	"GetClientStatus":        RoleReadOnly,
+	"GetTxOutSetInfo":        RoleReadOnly,
+	"GenerateBlocks":         RoleMiningOperator,
+	"GetBlockTemplate":       RoleMiningOperator,
+	"SetBlockTemplatePolicy": RoleMiningOperator,
+	"PrioritiseTransaction":  RoleMiningOperator,
+	"SaveMempool":            RoleMiningOperator,
+	"DumpTxOutSet":           RoleMiningOperator,
+
+	// spaces
+	"GetCapacitySpaces":        RoleReadOnly,
+	"GetCapacitySpace":         RoleReadOnly,
+	"GetCapacitySpaceState":    RoleReadOnly,
+	"GetPlotQueue":             RoleReadOnly,
+	"GetCapacityPlan":          RoleReadOnly,
+	"GetNetworkSpace":          RoleReadOnly,
+	"ConfigureCapacity":        RoleMiningOperator,
+	"UpdatePlotQueue":          RoleMiningOperator,
+	"ApplyCapacityPlan":        RoleMiningOperator,
+	"BenchmarkProofs":          RoleMiningOperator,
+	"SimulateMining":           RoleMiningOperator,
+	"PlotCapacitySpaces":       RoleMiningOperator,
+	"PlotCapacitySpace":        RoleMiningOperator,
+	"MineCapacitySpaces":       RoleMiningOperator,
+	"MineCapacitySpace":        RoleMiningOperator,
+	"StopCapacitySpaces":       RoleMiningOperator,
+	"StopCapacitySpace":        RoleMiningOperator,
+	"UpdateCapacitySpaceState": RoleMiningOperator,
+}
+
+// requiredRole returns the least role required by fullMethod, formatted as "/package.service/method".
+func requiredRole(fullMethod string) Role {
+	if role, ok := methodRoles[path.Base(fullMethod)]; ok {
+		return role
+	}
+	return RoleWalletAdmin
+}
+
+type apiToken struct {
+	token []byte
+	role  Role
+}
+
+// tokenAuth authenticates bearer tokens configured in api_tokens.
+// All requests are allowed if no token is configured.
+type tokenAuth struct {
+	tokens []apiToken
+}
+
+// newTokenAuth parses tokens formatted as "<role>:<token>".
+func newTokenAuth(tokens []string) (*tokenAuth, error) {
+	auth := &tokenAuth{tokens: make([]apiToken, 0, len(tokens))}
+	for i, item := range tokens {
+		idx := strings.Index(item, ":")
+		if idx < 0 {
+			return nil, errors.New(fmt.Sprintf("invalid api token %d, expect <role>:<token>", i))
+		}
+		var role Role
+		var found bool
+		for r, name := range roleNames {
+			if name == item[:idx] {
+				role, found = r, true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New(fmt.Sprintf("invalid role of api token %d, %s", i, item[:idx]))
+		}
+		token := item[idx+1:]
+		if len(token) < minTokenLength {
+			return nil, errors.New(fmt.Sprintf("api token %d is shorter than %d", i, minTokenLength))
+		}
+		for _, t := range auth.tokens {
+			if string(t.token) == token {
+				return nil, errors.New(fmt.Sprintf("duplicate api token %d", i))
+			}
+		}
+		auth.tokens = append(auth.tokens, apiToken{token: []byte(token), role: role})
+	}
+	return auth, nil
+}
+
+func (a *tokenAuth) Enabled() bool {
+	return len(a.tokens) != 0
+}
+
+// Authenticate returns the role of token, all tokens are compared in constant time.
+func (a *tokenAuth) Authenticate(token string) (Role, bool) {
+	var role Role
+	var ok bool
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare(t.token, []byte(token)) == 1 {
+			role, ok = t.role, true
+		}
+	}
+	return role, ok
+}
+
+// parseBearer extracts token from the value of Authorization header.
+func parseBearer(value string) (string, bool) {
+	if len(value) <= len(bearerPrefix) || !strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	return value[len(bearerPrefix):], true
+}
+
+// authorize checks the bearer token in ctx is granted required role.
+func (a *tokenAuth) authorize(ctx context.Context, fullMethod string, required Role) error {
+	if !a.Enabled() {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		logging.CPrint(logging.WARN, "api received request without token", logging.LogFormat{"method": fullMethod})
+		return status.New(codes.Unauthenticated, "missing bearer token").Err()
+	}
+	token, ok := parseBearer(values[0])
+	if !ok {
+		logging.CPrint(logging.WARN, "api received request with malformed token", logging.LogFormat{"method": fullMethod})
+		return status.New(codes.Unauthenticated, "malformed bearer token").Err()
+	}
+	role, ok := a.Authenticate(token)
+	if !ok {
+		logging.CPrint(logging.WARN, "api received request with invalid token", logging.LogFormat{"method": fullMethod})
+		return status.New(codes.Unauthenticated, "invalid bearer token").Err()
+	}
+	if role < required {
+		logging.CPrint(logging.WARN, "api received request beyond role of token", logging.LogFormat{"method": fullMethod, "role": role, "required": required})
+		return status.New(codes.PermissionDenied, fmt.Sprintf("role %s is required", required)).Err()
+	}
+	return nil
+}
+
+func (a *tokenAuth) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorize(ctx, info.FullMethod, requiredRole(info.FullMethod)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor guards streaming services, e.g. reflection, which requires RoleReadOnly.
+func (a *tokenAuth) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod, RoleReadOnly); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authHandler rejects http requests without valid bearer token, the token is forwarded
+// to gRPC server by gateway and checked against the role required by method there.
+func authHandler(h http.Handler, auth *tokenAuth) http.Handler {
+	if !auth.Enabled() {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token, ok := parseBearer(req.Header.Get("Authorization"))
+		if ok {
+			_, ok = auth.Authenticate(token)
+		}
+		if !ok {
+			logging.CPrint(logging.WARN, "api received request without valid token", logging.LogFormat{"remote_addr": req.RemoteAddr, "url_path": req.URL.Path})
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			runtime.OtherErrorHandler(w, req, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, req)
+	})
+}
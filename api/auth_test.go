@@ -0,0 +1,299 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	pb "massnet.org/mass/api/proto"
+)
+
+const (
+	testMiningToken = "mining-operator-token-0123456789"
+	testAdminToken  = "wallet-admin-token-0123456789"
+)
+
+var testTokens = []string{
+	"read-only:" + testReadOnlyToken,
+	"mining-operator:" + testMiningToken,
+	"wallet-admin:" + testAdminToken,
+}
+
+func newTestTokenAuth(t *testing.T) *tokenAuth {
+	auth, err := newTokenAuth(testTokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return auth
+}
+
+func bearerContext(token string) context.Context {
+	ctx := context.Background()
+	if token == "" {
+		return ctx
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationKey, bearerPrefix+token))
+}
+
+func TestNewTokenAuth(t *testing.T) {
+	tests := []struct {
+		name   string
+		tokens []string
+		err    bool
+	}{
+		{"none", nil, false},
+		{"all roles", testTokens, false},
+		{"token containing colon", []string{"read-only:abc:0123456789abcdef"}, false},
+		{"missing role", []string{testReadOnlyToken}, true},
+		{"unknown role", []string{"admin:" + testAdminToken}, true},
+		{"short token", []string{"read-only:0123456789"}, true},
+		{"duplicate token", []string{"read-only:" + testAdminToken, "wallet-admin:" + testAdminToken}, true},
+	}
+	for _, test := range tests {
+		auth, err := newTokenAuth(test.tokens)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s, expected error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s, unexpected error %v", test.name, err)
+			continue
+		}
+		if auth.Enabled() != (len(test.tokens) != 0) {
+			t.Errorf("%s, enabled mismatched", test.name)
+		}
+	}
+
+	auth := newTestTokenAuth(t)
+	for token, expected := range map[string]Role{
+		testReadOnlyToken: RoleReadOnly,
+		testMiningToken:   RoleMiningOperator,
+		testAdminToken:    RoleWalletAdmin,
+	} {
+		if role, ok := auth.Authenticate(token); !ok || role != expected {
+			t.Errorf("role of %s mismatched, got %v, %v", token, role, ok)
+		}
+	}
+	if _, ok := auth.Authenticate(testAdminToken + "0"); ok {
+		t.Error("unknown token authenticated")
+	}
+}
+
+func TestParseBearer(t *testing.T) {
+	tests := []struct {
+		value string
+		token string
+		ok    bool
+	}{
+		{"Bearer abc", "abc", true},
+		{"bearer abc", "abc", true},
+		{"BEARER abc", "abc", true},
+		{"Bearer ", "", false},
+		{"Bearer", "", false},
+		{"Basic abc", "", false},
+		{"abc", "", false},
+		{"", "", false},
+	}
+	for _, test := range tests {
+		token, ok := parseBearer(test.value)
+		if token != test.token || ok != test.ok {
+			t.Errorf("%q, expected %q, %v, got %q, %v", test.value, test.token, test.ok, token, ok)
+		}
+	}
+}
+
+func TestRequiredRole(t *testing.T) {
+	if !(RoleReadOnly < RoleMiningOperator && RoleMiningOperator < RoleWalletAdmin) {
+		t.Fatal("roles are not ordered")
+	}
+	tests := []struct {
+		method string
+		role   Role
+	}{
+		{"/rpcprotobuf.ApiService/GetBestBlock", RoleReadOnly},
+		{"/rpcprotobuf.ApiService/GetCapacitySpaces", RoleReadOnly},
+		{"/rpcprotobuf.ApiService/GenerateBlocks", RoleMiningOperator},
+		{"/rpcprotobuf.ApiService/MineCapacitySpaces", RoleMiningOperator},
+		// unlisted methods default to RoleWalletAdmin
+		{"/rpcprotobuf.ApiService/UnlockWallet", RoleWalletAdmin},
+		{"/rpcprotobuf.ApiService/ExportKeystore", RoleWalletAdmin},
+		{"/rpcprotobuf.ApiService/QuitClient", RoleWalletAdmin},
+		{"/rpcprotobuf.ApiService/NoSuchMethod", RoleWalletAdmin},
+	}
+	for _, test := range tests {
+		if role := requiredRole(test.method); role != test.role {
+			t.Errorf("%s, expected %v, got %v", test.method, test.role, role)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	auth := newTestTokenAuth(t)
+	interceptor := auth.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	tests := []struct {
+		method string
+		ctx    context.Context
+		code   codes.Code
+	}{
+		{"GetBestBlock", bearerContext(""), codes.Unauthenticated},
+		{"GetBestBlock", metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, "Basic abc")), codes.Unauthenticated},
+		{"GetBestBlock", bearerContext("invalid-token-0123456789"), codes.Unauthenticated},
+		{"GetBestBlock", bearerContext(testReadOnlyToken), codes.OK},
+		{"GetBestBlock", bearerContext(testAdminToken), codes.OK},
+		{"GenerateBlocks", bearerContext(testReadOnlyToken), codes.PermissionDenied},
+		{"GenerateBlocks", bearerContext(testMiningToken), codes.OK},
+		{"GenerateBlocks", bearerContext(testAdminToken), codes.OK},
+		{"UnlockWallet", bearerContext(testMiningToken), codes.PermissionDenied},
+		{"UnlockWallet", bearerContext(testAdminToken), codes.OK},
+	}
+	for _, test := range tests {
+		info := &grpc.UnaryServerInfo{FullMethod: "/rpcprotobuf.ApiService/" + test.method}
+		resp, err := interceptor(test.ctx, nil, info, handler)
+		if code := status.Code(err); code != test.code {
+			t.Errorf("%s, expected %v, got %v", test.method, test.code, err)
+			continue
+		}
+		if test.code == codes.OK && resp != "ok" {
+			t.Errorf("%s, handler not called", test.method)
+		}
+	}
+
+	// all requests are allowed without tokens configured
+	noAuth, err := newTokenAuth(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcprotobuf.ApiService/UnlockWallet"}
+	if _, err = noAuth.UnaryServerInterceptor()(context.Background(), nil, info, handler); err != nil {
+		t.Errorf("unexpected error without tokens, %v", err)
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := newTestTokenAuth(t).StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"}
+	var called bool
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		called = true
+		return nil
+	}
+
+	err := interceptor(nil, &testServerStream{ctx: bearerContext("")}, info, handler)
+	if status.Code(err) != codes.Unauthenticated || called {
+		t.Errorf("stream without token, %v", err)
+	}
+	err = interceptor(nil, &testServerStream{ctx: bearerContext(testReadOnlyToken)}, info, handler)
+	if err != nil || !called {
+		t.Errorf("stream with read-only token, %v", err)
+	}
+}
+
+// authTestService serves methods of ApiService called by gateway tests.
+type authTestService struct {
+	pb.ApiServiceServer
+}
+
+func (s *authTestService) GetBestBlock(ctx context.Context, in *empty.Empty) (*pb.GetBestBlockResponse, error) {
+	return &pb.GetBestBlockResponse{Height: 100}, nil
+}
+
+func (s *authTestService) SaveMempool(ctx context.Context, in *empty.Empty) (*pb.SaveMempoolResponse, error) {
+	return &pb.SaveMempoolResponse{}, nil
+}
+
+func TestAuthHandler(t *testing.T) {
+	auth := newTestTokenAuth(t)
+	srv := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor()))
+	pb.RegisterApiServiceServer(srv, &authTestService{})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mux := runtime.NewServeMux()
+	if err = pb.RegisterApiServiceHandlerFromEndpoint(ctx, mux, lis.Addr().String(), []grpc.DialOption{grpc.WithInsecure()}); err != nil {
+		t.Fatal(err)
+	}
+	h := authHandler(mux, auth)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		header string
+		status int
+	}{
+		{"missing token", http.MethodGet, "/v1/blocks/best", "", http.StatusUnauthorized},
+		{"malformed token", http.MethodGet, "/v1/blocks/best", "Basic " + testReadOnlyToken, http.StatusUnauthorized},
+		{"invalid token", http.MethodGet, "/v1/blocks/best", bearerPrefix + "invalid-token-0123456789", http.StatusUnauthorized},
+		{"read-only", http.MethodGet, "/v1/blocks/best", bearerPrefix + testReadOnlyToken, http.StatusOK},
+		// role is checked by gRPC server on the forwarded token
+		{"beyond role", http.MethodPost, "/v1/transactions/pool/save", bearerPrefix + testReadOnlyToken, http.StatusForbidden},
+		{"mining-operator", http.MethodPost, "/v1/transactions/pool/save", bearerPrefix + testMiningToken, http.StatusOK},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(test.method, test.path, strings.NewReader("{}"))
+		if test.header != "" {
+			req.Header.Set("Authorization", test.header)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != test.status {
+			t.Errorf("%s, expected status %d, got %d, %s", test.name, test.status, w.Code, w.Body.String())
+			continue
+		}
+		if test.status == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") != "Bearer" {
+			t.Errorf("%s, missing WWW-Authenticate header", test.name)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blocks/best", nil)
+	req.Header.Set("Authorization", bearerPrefix+testReadOnlyToken)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	var best pb.GetBestBlockResponse
+	if err = jsonpb.Unmarshal(w.Body, &best); err != nil || best.Height != 100 {
+		t.Errorf("unexpected response %s, %v", w.Body.String(), err)
+	}
+
+	// all requests are passed without tokens configured
+	noAuth, err := newTokenAuth(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var passed bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) { passed = true })
+	authHandler(next, noAuth).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/blocks/best", nil))
+	if !passed {
+		t.Error("request not passed without tokens")
+	}
+}
@@ -8,11 +8,17 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/status"
 	pb "massnet.org/mass/api/proto"
+	"massnet.org/mass/blockchain"
+	"massnet.org/mass/config"
 	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
 	"massnet.org/mass/pocec"
 	"massnet.org/mass/wire"
 )
 
+// maxGenerateBlocks limits the count of blocks generated by one request.
+const maxGenerateBlocks = 1000
+
 func (s *Server) GetBestBlock(ctx context.Context, msg *empty.Empty) (*pb.GetBestBlockResponse, error) {
 	logging.CPrint(logging.INFO, "api get the best block height and hash")
 	node := s.chain.BestBlockNode()
@@ -33,14 +39,20 @@ func (s *Server) GetBlock(ctx context.Context, in *pb.GetBlockRequest) (*pb.GetB
 		st := status.New(ErrAPIShaHashFromStr, ErrCode[ErrAPIShaHashFromStr])
 		return nil, st.Err()
 	}
-	blk, err := s.chain.GetBlockByHash(sha)
+	view, err := s.newChainView()
+	if err != nil {
+		return nil, err
+	}
+	defer view.Close()
+
+	blk, err := view.GetBlockByHash(sha)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to query the block according to the block hash", logging.LogFormat{"hash": sha.String(), "error": err})
 		st := status.New(ErrAPIBlockNotFound, ErrCode[ErrAPIBlockNotFound])
 		return nil, st.Err()
 	}
 
-	blockReply, err := s.marshalGetBlockResponse(blk)
+	blockReply, err := s.marshalGetBlockResponse(view, blk)
 	if err == nil {
 		logging.CPrint(logging.INFO, "the request to query the block according to the block hash was successfully answered", logging.LogFormat{"height": blk.Height()})
 	}
@@ -61,14 +73,20 @@ func (s *Server) GetBlockHashByHeight(ctx context.Context, in *pb.GetBlockHashBy
 
 func (s *Server) GetBlockByHeight(ctx context.Context, in *pb.GetBlockByHeightRequest) (*pb.GetBlockResponse, error) {
 	logging.CPrint(logging.INFO, "api get block by height", logging.LogFormat{"height": in.Height})
-	blk, err := s.chain.GetBlockByHeight(in.Height)
+	view, err := s.newChainView()
+	if err != nil {
+		return nil, err
+	}
+	defer view.Close()
+
+	blk, err := view.GetBlockByHeight(in.Height)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to query the block according to the block height", logging.LogFormat{"height": in.Height, "error": err})
 		st := status.New(ErrAPIBlockNotFound, ErrCode[ErrAPIBlockNotFound])
 		return nil, st.Err()
 	}
 
-	blockReply, err := s.marshalGetBlockResponse(blk)
+	blockReply, err := s.marshalGetBlockResponse(view, blk)
 	if err == nil {
 		logging.CPrint(logging.INFO, "the request to query the block according to the block height was successfully answered", logging.LogFormat{"height": blk.Height()})
 	}
@@ -88,14 +106,20 @@ func (s *Server) GetBlockHeader(ctx context.Context, in *pb.GetBlockHeaderReques
 		return nil, st.Err()
 	}
 
-	blk, err := s.chain.GetBlockByHash(sha)
+	view, err := s.newChainView()
+	if err != nil {
+		return nil, err
+	}
+	defer view.Close()
+
+	blk, err := view.GetBlockByHash(sha)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to query the block according to the block hash", logging.LogFormat{"hash": sha.String(), "error": err})
 		st := status.New(ErrAPIBlockNotFound, ErrCode[ErrAPIBlockNotFound])
 		return nil, st.Err()
 	}
 
-	blockHeaderReply, err := s.marshalGetBlockHeaderResponse(blk)
+	blockHeaderReply, err := s.marshalGetBlockHeaderResponse(view, blk)
 	if err == nil {
 		logging.CPrint(logging.INFO, "the request to query the block header according to the block hash was successfully answered", logging.LogFormat{"hash": in.Hash})
 	}
@@ -193,3 +217,170 @@ func createFaultPubKeyResult(proposals []*wire.FaultPubKey) []*pb.FaultPubKey {
 	}
 	return result
 }
+
+func (s *Server) GenerateBlocks(ctx context.Context, in *pb.GenerateBlocksRequest) (*pb.GenerateBlocksResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GenerateBlocks",
+		logging.LogFormat{"count": in.Count, "payout_address": in.PayoutAddress})
+
+	if !s.config.RegTest {
+		logging.CPrint(logging.ERROR, "cannot generate blocks on non-regtest network", logging.LogFormat{"network": config.ChainTag})
+		return nil, status.New(ErrAPIGenerateNotAllowed, ErrCode[ErrAPIGenerateNotAllowed]).Err()
+	}
+	if in.Count == 0 || in.Count > maxGenerateBlocks {
+		logging.CPrint(logging.ERROR, "count of blocks is out of range",
+			logging.LogFormat{"count": in.Count, "max": maxGenerateBlocks})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	if err := checkAddressLen(in.PayoutAddress); err != nil {
+		return nil, err
+	}
+	payoutAddress, err := massutil.DecodeAddress(in.PayoutAddress, &config.ChainParams)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode payout address", logging.LogFormat{"address": in.PayoutAddress, "error": err})
+		return nil, status.New(ErrAPIFailedDecodeAddress, ErrCode[ErrAPIFailedDecodeAddress]).Err()
+	}
+
+	hashes, err := s.pocMiner.GenerateBlocks(int(in.Count), payoutAddress)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to generate blocks",
+			logging.LogFormat{"error": err, "generated": len(hashes)})
+		return nil, status.New(ErrAPIGenerateBlocks, err.Error()).Err()
+	}
+
+	resp := &pb.GenerateBlocksResponse{Hashes: make([]string, 0, len(hashes))}
+	for _, hash := range hashes {
+		resp.Hashes = append(resp.Hashes, hash.String())
+	}
+	logging.CPrint(logging.INFO, "GenerateBlocks completed", logging.LogFormat{"count": len(resp.Hashes)})
+	return resp, nil
+}
+
+func (s *Server) GetBlockTemplate(ctx context.Context, in *pb.GetBlockTemplateRequest) (*pb.GetBlockTemplateResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetBlockTemplate", logging.LogFormat{"payout_address": in.PayoutAddress})
+
+	// coinbase of template is redeemable by anyone if payout address is not provided
+	var payoutAddress massutil.Address
+	if in.PayoutAddress != "" {
+		if err := checkAddressLen(in.PayoutAddress); err != nil {
+			return nil, err
+		}
+		addr, err := massutil.DecodeAddress(in.PayoutAddress, &config.ChainParams)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to decode payout address", logging.LogFormat{"address": in.PayoutAddress, "error": err})
+			return nil, status.New(ErrAPIFailedDecodeAddress, ErrCode[ErrAPIFailedDecodeAddress]).Err()
+		}
+		payoutAddress = addr
+	}
+
+	policy := s.chain.TemplatePolicy()
+	feeDeltas := s.txMemPool.FeeDeltas()
+	templateCh := make(chan interface{}, 2)
+	if err := s.chain.NewBlockTemplate(payoutAddress, templateCh); err != nil {
+		logging.CPrint(logging.ERROR, "failed to create block template", logging.LogFormat{"error": err})
+		return nil, status.New(ErrAPIBlockTemplate, err.Error()).Err()
+	}
+
+	var pocTemplate *blockchain.PoCTemplate
+	var blockTemplate *blockchain.BlockTemplate
+	for blockTemplate == nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case template := <-templateCh:
+			switch t := template.(type) {
+			case *blockchain.PoCTemplate:
+				if t.Err != nil {
+					logging.CPrint(logging.ERROR, "failed to create poc template", logging.LogFormat{"error": t.Err})
+					return nil, status.New(ErrAPIBlockTemplate, t.Err.Error()).Err()
+				}
+				pocTemplate = t
+			case *blockchain.BlockTemplate:
+				if t.Err != nil {
+					logging.CPrint(logging.ERROR, "failed to create block template", logging.LogFormat{"error": t.Err})
+					return nil, status.New(ErrAPIBlockTemplate, t.Err.Error()).Err()
+				}
+				blockTemplate = t
+			}
+		}
+	}
+
+	msgBlock := blockTemplate.Block
+	totalFee, err := AmountToString(blockTemplate.TotalFee.IntValue())
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to convert total fee", logging.LogFormat{"error": err})
+		return nil, status.New(ErrAPIFailedToMaxwell, ErrCode[ErrAPIFailedToMaxwell]).Err()
+	}
+
+	// skip coinbase, which is re-created when a proof is found
+	txs := make([]*pb.BlockTemplateTx, 0, len(msgBlock.Transactions)-1)
+	inTemplate := make(map[wire.Hash]struct{}, len(msgBlock.Transactions)-1)
+	for i, msgTx := range msgBlock.Transactions[1:] {
+		txHash := msgTx.TxHash()
+		fee, err := AmountToString(blockTemplate.Fees[i+1])
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to convert fee", logging.LogFormat{"error": err, "txid": txHash})
+			return nil, status.New(ErrAPIFailedToMaxwell, ErrCode[ErrAPIFailedToMaxwell]).Err()
+		}
+		depends := make([]string, 0)
+		for _, txIn := range msgTx.TxIn {
+			if _, exists := inTemplate[txIn.PreviousOutPoint.Hash]; exists {
+				depends = append(depends, txIn.PreviousOutPoint.Hash.String())
+			}
+		}
+		inTemplate[txHash] = struct{}{}
+		txs = append(txs, &pb.BlockTemplateTx{
+			Txid:     txHash.String(),
+			Fee:      fee,
+			FeeDelta: feeDeltas[txHash],
+			Size:     uint32(msgTx.PlainSize()),
+			Sigops:   blockTemplate.SigOpCounts[i+1],
+			Depends:  depends,
+		})
+	}
+
+	resp := &pb.GetBlockTemplateResponse{
+		Height:          blockTemplate.Height,
+		ChainId:         msgBlock.Header.ChainID.String(),
+		Version:         msgBlock.Header.Version,
+		PreviousHash:    msgBlock.Header.Previous.String(),
+		Time:            pocTemplate.Timestamp.Unix(),
+		Target:          pocTemplate.GetTarget(pocTemplate.Timestamp).Text(16),
+		Challenge:       hex.EncodeToString(pocTemplate.Challenge.Bytes()),
+		TransactionRoot: msgBlock.Header.TransactionRoot.String(),
+		WitnessRoot:     msgBlock.Header.WitnessRoot.String(),
+		ProposalRoot:    msgBlock.Header.ProposalRoot.String(),
+		TotalFee:        totalFee,
+		Size:            uint32(msgBlock.PlainSize()),
+		TxCount:         uint32(len(msgBlock.Transactions)),
+		Txs:             txs,
+		Policy:          marshalBlockTemplatePolicy(policy),
+	}
+
+	logging.CPrint(logging.INFO, "GetBlockTemplate completed", logging.LogFormat{"height": resp.Height, "tx_count": resp.TxCount})
+	return resp, nil
+}
+
+func (s *Server) SetBlockTemplatePolicy(ctx context.Context, in *pb.SetBlockTemplatePolicyRequest) (*pb.BlockTemplatePolicy, error) {
+	logging.CPrint(logging.INFO, "Received a request for SetBlockTemplatePolicy", logging.LogFormat{"strategy": in.Strategy})
+
+	policy := s.chain.TemplatePolicy()
+	policy.Strategy = in.Strategy
+	if err := s.chain.SetTemplatePolicy(policy); err != nil {
+		logging.CPrint(logging.ERROR, "failed to set block template policy",
+			logging.LogFormat{"error": err, "strategy": in.Strategy, "available": blockchain.TemplateStrategies()})
+		return nil, status.New(ErrAPITemplatePolicy, err.Error()).Err()
+	}
+
+	logging.CPrint(logging.INFO, "SetBlockTemplatePolicy completed", logging.LogFormat{"strategy": in.Strategy})
+	return marshalBlockTemplatePolicy(s.chain.TemplatePolicy()), nil
+}
+
+func marshalBlockTemplatePolicy(policy blockchain.TemplatePolicy) *pb.BlockTemplatePolicy {
+	return &pb.BlockTemplatePolicy{
+		Strategy:            policy.Strategy,
+		BlockMinSize:        policy.BlockMinSize,
+		BlockMaxSize:        policy.BlockMaxSize,
+		BlockPrioritySize:   policy.BlockPrioritySize,
+		AvailableStrategies: blockchain.TemplateStrategies(),
+	}
+}
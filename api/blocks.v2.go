@@ -52,12 +52,18 @@ func (s *Server) GetBlockV2(ctx context.Context, in *pb.GetBlockRequestV2) (*pb.
 		return nil, err
 	}
 
-	blk, err := s.getBlockByID(in.Id)
+	view, err := s.newChainView()
 	if err != nil {
 		return nil, err
 	}
+	defer view.Close()
 
-	resp, err := s.marshalGetBlockV2Response(blk)
+	blk, err := s.getBlockByID(view, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.marshalGetBlockV2Response(view, blk)
 	if err != nil {
 		return nil, err
 	}
@@ -74,12 +80,18 @@ func (s *Server) GetBlockHeaderV2(ctx context.Context, in *pb.GetBlockRequestV2)
 		return nil, err
 	}
 
-	blk, err := s.getBlockByID(in.Id)
+	view, err := s.newChainView()
+	if err != nil {
+		return nil, err
+	}
+	defer view.Close()
+
+	blk, err := s.getBlockByID(view, in.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := s.marshalGetBlockHeaderResponse(blk)
+	resp, err := s.marshalGetBlockHeaderResponse(view, blk)
 	if err != nil {
 		return nil, err
 	}
@@ -96,12 +108,18 @@ func (s *Server) GetBlockVerbose1V2(ctx context.Context, in *pb.GetBlockRequestV
 		return nil, err
 	}
 
-	blk, err := s.getBlockByID(in.Id)
+	view, err := s.newChainView()
+	if err != nil {
+		return nil, err
+	}
+	defer view.Close()
+
+	blk, err := s.getBlockByID(view, in.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := s.marshalGetBlockResponse(blk)
+	resp, err := s.marshalGetBlockResponse(view, blk)
 	if err != nil {
 		return nil, err
 	}
@@ -109,12 +127,12 @@ func (s *Server) GetBlockVerbose1V2(ctx context.Context, in *pb.GetBlockRequestV
 	return resp, nil
 }
 
-func (s *Server) marshalGetBlockResponse(blk *massutil.Block) (*pb.GetBlockResponse, error) {
+func (s *Server) marshalGetBlockResponse(view *chainView, blk *massutil.Block) (*pb.GetBlockResponse, error) {
 	idx := blk.Height()
-	maxIdx := s.chain.BestBlockHeight()
+	maxIdx := view.BestBlockHeight()
 	var shaNextStr string
 	if idx < maxIdx {
-		shaNext, err := s.chain.GetBlockHashByHeight(idx + 1)
+		shaNext, err := view.GetBlockHashByHeight(idx + 1)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "failed to query next block hash according to the block height", logging.LogFormat{"height": idx, "error": err})
 			st := status.New(ErrAPIBlockHashByHeight, ErrCode[ErrAPIBlockHashByHeight])
@@ -166,7 +184,7 @@ func (s *Server) marshalGetBlockResponse(blk *massutil.Block) (*pb.GetBlockRespo
 	txns := blk.Transactions()
 	rawTxns := make([]*pb.TxRawResult, len(txns))
 	for i, tx := range txns {
-		rawTxn, err := s.createTxRawResult(&config.ChainParams,
+		rawTxn, err := s.createTxRawResult(view, &config.ChainParams,
 			tx.MsgTx(), tx.Hash().String(), blockHeader,
 			blockHash, idx, maxIdx)
 		if err != nil {
@@ -184,12 +202,12 @@ func (s *Server) marshalGetBlockResponse(blk *massutil.Block) (*pb.GetBlockRespo
 	return blockReply, nil
 }
 
-func (s *Server) marshalGetBlockV2Response(blk *massutil.Block) (*pb.GetBlockResponseV2, error) {
+func (s *Server) marshalGetBlockV2Response(view *chainView, blk *massutil.Block) (*pb.GetBlockResponseV2, error) {
 	idx := blk.Height()
-	maxIdx := s.chain.BestBlockHeight()
+	maxIdx := view.BestBlockHeight()
 	var shaNextStr string
 	if idx < maxIdx {
-		shaNext, err := s.chain.GetBlockHashByHeight(idx + 1)
+		shaNext, err := view.GetBlockHashByHeight(idx + 1)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "failed to query next block hash according to the block height", logging.LogFormat{"height": idx, "error": err})
 			st := status.New(ErrAPIBlockHashByHeight, ErrCode[ErrAPIBlockHashByHeight])
@@ -249,13 +267,13 @@ func (s *Server) marshalGetBlockV2Response(blk *massutil.Block) (*pb.GetBlockRes
 	return blockReply, nil
 }
 
-func (s *Server) marshalGetBlockHeaderResponse(blk *massutil.Block) (*pb.GetBlockHeaderResponse, error) {
-	maxIdx := s.chain.BestBlockHeight()
+func (s *Server) marshalGetBlockHeaderResponse(view *chainView, blk *massutil.Block) (*pb.GetBlockHeaderResponse, error) {
+	maxIdx := view.BestBlockHeight()
 
 	var shaNextStr string
 	idx := blk.Height()
 	if idx < maxIdx {
-		shaNext, err := s.chain.GetBlockHashByHeight(idx + 1)
+		shaNext, err := view.GetBlockHashByHeight(idx + 1)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "failed to query next block hash according to the block height", logging.LogFormat{"height": idx, "error": err})
 			st := status.New(ErrAPIBlockHashByHeight, ErrCode[ErrAPIBlockHashByHeight])
@@ -298,13 +316,13 @@ func (s *Server) marshalGetBlockHeaderResponse(blk *massutil.Block) (*pb.GetBloc
 	return blockHeaderReply, nil
 }
 
-func (s *Server) getBlockByID(id string) (*massutil.Block, error) {
+func (s *Server) getBlockByID(view *chainView, id string) (*massutil.Block, error) {
 	if height, err := decodeBlockID(id, blockIDHeight); err == nil {
-		return s.chain.GetBlockByHeight(height.(uint64))
+		return view.GetBlockByHeight(height.(uint64))
 	}
 
 	if hash, err := decodeBlockID(id, blockIDHash); err == nil {
-		return s.chain.GetBlockByHash(hash.(*wire.Hash))
+		return view.GetBlockByHash(hash.(*wire.Hash))
 	}
 
 	return nil, ErrInvalidBlockID
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"google.golang.org/grpc/status"
+	"massnet.org/mass/database"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/wire"
+)
+
+// chainView serves reads of a single request from a database snapshot, so
+// that blocks, transactions and the best height read by the request are
+// consistent with each other while blocks are connected or disconnected.
+type chainView struct {
+	db         database.Db
+	bestHeight uint64
+}
+
+func (s *Server) newChainView() (*chainView, error) {
+	db, err := s.db.Snapshot()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to take database snapshot", logging.LogFormat{"err": err})
+		st := status.New(ErrAPIUnknownErr, ErrCode[ErrAPIUnknownErr])
+		return nil, st.Err()
+	}
+	_, height, err := db.NewestSha()
+	if err != nil {
+		db.Close()
+		logging.CPrint(logging.ERROR, "failed to query the newest block in snapshot", logging.LogFormat{"err": err})
+		st := status.New(ErrAPINewestHash, ErrCode[ErrAPINewestHash])
+		return nil, st.Err()
+	}
+	return &chainView{db: db, bestHeight: height}, nil
+}
+
+// Close releases the snapshot.
+func (v *chainView) Close() {
+	v.db.Close()
+}
+
+func (v *chainView) BestBlockHeight() uint64 {
+	return v.bestHeight
+}
+
+func (v *chainView) GetBlockByHash(hash *wire.Hash) (*massutil.Block, error) {
+	return v.db.FetchBlockBySha(hash)
+}
+
+func (v *chainView) GetBlockByHeight(height uint64) (*massutil.Block, error) {
+	hash, err := v.db.FetchBlockShaByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return v.db.FetchBlockBySha(hash)
+}
+
+func (v *chainView) GetBlockHashByHeight(height uint64) (*wire.Hash, error) {
+	return v.db.FetchBlockShaByHeight(height)
+}
+
+func (v *chainView) GetTransactionInDB(hash *wire.Hash) ([]*database.TxReply, error) {
+	return v.db.FetchTxBySha(hash)
+}
+
+func (v *chainView) GetTransaction(hash *wire.Hash) (*wire.MsgTx, error) {
+	txList, err := v.db.FetchTxBySha(hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(txList) == 0 {
+		return nil, database.ErrTxShaMissing
+	}
+	return txList[0].Tx, nil
+}
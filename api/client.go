@@ -53,6 +53,30 @@ func (s *Server) GetClientStatus(ctx context.Context, in *empty.Empty) (*pb.GetC
 	}
 	resp.PeerCount = &pb.GetClientStatusResponsePeerCountInfo{Total: outCount + inCount, Outbound: outCount, Inbound: inCount}
 
+	if estimate, err := s.estimateNetworkSpace(0); err == nil {
+		resp.NetworkSpace = estimate.Bytes
+		resp.SpaceShare = estimate.share
+		resp.ExpectedSecondsToBlock = estimate.timeToBlock.Seconds()
+	} else {
+		logging.CPrint(logging.WARN, "fail to estimate network space", logging.LogFormat{"err": err})
+	}
+
+	svStats := s.chain.ScriptValidationStats()
+	resp.ScriptValidation = &pb.GetClientStatusResponseScriptValidation{
+		Workers:            uint32(svStats.Workers),
+		ValidatedInputs:    svStats.ValidatedInputs,
+		PrevalidatedInputs: svStats.PrevalidatedInputs,
+		SigCacheEntries:    uint64(svStats.SigCache.Entries),
+		SigCacheMaxEntries: uint64(svStats.SigCache.MaxEntries),
+		SigCacheHits:       svStats.SigCache.Hits,
+		SigCacheMisses:     svStats.SigCache.Misses,
+		SigCacheHitRate:    svStats.SigCache.HitRate(),
+	}
+	if svStats.AssumeValid != nil {
+		resp.ScriptValidation.AssumeValidHeight = svStats.AssumeValid.Height
+		resp.ScriptValidation.AssumeValidHash = svStats.AssumeValid.Hash.String()
+	}
+
 	logging.CPrint(logging.INFO, "GetClientStatus completed")
 	return resp, nil
 }
@@ -12,6 +12,9 @@ const (
 	ErrAPIFindingBalance  = 1108
 	ErrAPIEstimateTxFee   = 1109
 	ErrAPIUserTxFee       = 1110
+	ErrAPISaveMempool     = 1111
+	ErrAPITxOutSet        = 1112
+	ErrAPIDumpTxOutSet    = 1113
 
 	// block err
 	ErrAPINewestHash          = 1201
@@ -19,6 +22,10 @@ const (
 	ErrAPINextBlock           = 1203
 	ErrAPIBlockHashByHeight   = 1204
 	ErrAPIBlockHeaderNotFound = 1205
+	ErrAPIGenerateBlocks      = 1206
+	ErrAPIGenerateNotAllowed  = 1207
+	ErrAPIBlockTemplate       = 1208
+	ErrAPITemplatePolicy      = 1209
 
 	// txScript
 	ErrAPICreatePkScript  = 1401
@@ -66,6 +73,12 @@ const (
 	ErrAPIMinerInvalidSpaceID  = 1808
 	ErrAPIMinerNoAddress       = 1809
 	ErrAPIMinerWrongPassphrase = 1810
+	ErrAPIMinerPlotQueue       = 1811
+	ErrAPIMinerCapacityPlan    = 1812
+	ErrAPIMinerBenchmark       = 1813
+	ErrAPIMinerSimulate        = 1814
+	ErrAPIMinerNetworkSpace    = 1815
+	ErrAPIMinerSpaceState      = 1816
 
 	// Wallet err
 	ErrAPIExportWallet   = 1901
@@ -111,10 +124,17 @@ var ErrCode = map[uint32]string{
 	ErrAPINextBlock:            "No next block",
 	ErrAPIBlockHashByHeight:    "Failed to get block hash by height",
 	ErrAPIBlockHeaderNotFound:  "Failed to find block header",
+	ErrAPIGenerateBlocks:       "Failed to generate blocks",
+	ErrAPIGenerateNotAllowed:   "Generating blocks is only allowed on regtest",
+	ErrAPIBlockTemplate:        "Failed to get block template",
+	ErrAPITemplatePolicy:       "Invalid block template policy",
 	ErrNoMinningAddrress:       "No payment addresses specified via --miningaddr",
 	ErrAPIUnknownErr:           "Unknown error",
 	ErrAPIEstimateTxFee:        "Failed to estimateTxFee",
 	ErrAPIUserTxFee:            "Invalid userTxFee",
+	ErrAPISaveMempool:          "Failed to save memory pool",
+	ErrAPITxOutSet:             "Failed to read utxo set",
+	ErrAPIDumpTxOutSet:         "Failed to dump utxo set",
 	ErrAPIMinerInternal:        "Error in miner internal",
 	ErrAPIMinerNoConfig:        "No config specified",
 	ErrAPIMinerSpaceNotFound:   "Fail to find space",
@@ -130,6 +150,12 @@ var ErrCode = map[uint32]string{
 	ErrAPIInvalidSpaceID:       "Invalid space id",
 	ErrAPIMinerNoAddress:       "Missing miner payout addresses",
 	ErrAPIMinerWrongPassphrase: "Wrong miner passphrase",
+	ErrAPIMinerPlotQueue:       "Failed to update plot queue",
+	ErrAPIMinerCapacityPlan:    "Failed to plan capacity",
+	ErrAPIMinerBenchmark:       "Failed to benchmark proofs",
+	ErrAPIMinerSimulate:        "Failed to simulate mining",
+	ErrAPIMinerNetworkSpace:    "Failed to estimate network space",
+	ErrAPIMinerSpaceState:      "Failed to update space state",
 
 	// Wallet err
 	ErrAPIExportWallet:   "Failed to export wallet",
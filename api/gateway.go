@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"golang.org/x/net/context"
@@ -19,7 +20,8 @@ const (
 	DefaultHTTPLimit = 128 // DefaultHTTPLimit default max http connections
 )
 
-func Run(cfg *config.Config) error {
+// Run serves gRPC-gateway, and jsonRPC on JSONRPCPath and POST "/" if it is not nil.
+func Run(cfg *config.Config, jsonRPC http.Handler) error {
 	portHttp := cfg.Network.API.APIPortHttp
 	portGRPC := cfg.Network.API.APIPortGRPC
 
@@ -29,24 +31,60 @@ func Run(cfg *config.Config) error {
 
 	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard,
 		&runtime.JSONPb{OrigName: true, EmitDefaults: true}))
-	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize))}
+	opts := []grpc.DialOption{grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize))}
+	if cfg.Network.API.APITLS {
+		creds, err := clientTLSCredentials(cfg)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
 	echoEndpoint := flag.String("echo_endpoint", ":"+portGRPC, "endpoint of Service")
 	err := gw.RegisterApiServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
 	if err != nil {
 		return err
 	}
 
-	// TODO: CORS
 	isAllowedAddress, err := getIPAccessControlFunc(cfg.Network.API.APIWhitelist, cfg.Network.API.APIAllowedLan)
 	if err != nil {
 		return err
 	}
 
-	handle := accessControlHandler(concurrentRequestHandler(maxBytesHandler(mux)), isAllowedAddress)
+	auth, err := newTokenAuth(cfg.Network.API.APITokens)
+	if err != nil {
+		return err
+	}
+
+	handle := authHandler(concurrentRequestHandler(maxBytesHandler(jsonRPCRouter(mux, jsonRPC))), auth)
+	handle = corsHandler(rateLimitHandler(handle, cfg), cfg.Network.API.APICORSOrigins, cfg.Network.API.APICORSMethods)
+	handle = accessControlHandler(handle, isAllowedAddress)
 	port := fmt.Sprintf("%s%s", ":", portHttp)
+	if cfg.Network.API.APITLS {
+		return http.ListenAndServeTLS(port, cfg.Network.API.APITLSCert, cfg.Network.API.APITLSKey, handle)
+	}
 	return http.ListenAndServe(port, handle)
 }
 
+func isJSONRPCRequest(req *http.Request) bool {
+	return req.URL.Path == JSONRPCPath || (req.URL.Path == "/" && req.Method == http.MethodPost)
+}
+
+// jsonRPCRouter routes JSON-RPC requests to jsonRPC, and others to gateway.
+func jsonRPCRouter(gateway, jsonRPC http.Handler) http.Handler {
+	if jsonRPC == nil {
+		return gateway
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if isJSONRPCRequest(req) {
+			jsonRPC.ServeHTTP(w, req)
+			return
+		}
+		gateway.ServeHTTP(w, req)
+	})
+}
+
 var (
 	rfc1918_10  = net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(8, 32)}
 	rfc1918_192 = net.IPNet{IP: net.ParseIP("192.168.0.0"), Mask: net.CIDRMask(16, 32)}
@@ -127,6 +165,37 @@ func accessControlHandler(h http.Handler, isAllowedAddress func(addr string) boo
 	})
 }
 
+// corsHandler allows browsers from origins to call APIs with methods,
+// "*" allows all origins. Preflight requests from allowed origins are answered here.
+func corsHandler(h http.Handler, origins, methods []string) http.Handler {
+	if len(origins) == 0 {
+		return h
+	}
+	allowedOrigins := make(map[string]bool)
+	for _, origin := range origins {
+		allowedOrigins[strings.TrimRight(origin, "/")] = true
+	}
+	allowMethods := strings.Join(methods, ", ")
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		origin := req.Header.Get("Origin")
+		if origin == "" || !(allowedOrigins["*"] || allowedOrigins[origin]) {
+			h.ServeHTTP(w, req)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+		if req.Method == http.MethodOptions && req.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", allowMethods)
+			w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+			w.Header().Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Access-Control-Expose-Headers", "Retry-After")
+		h.ServeHTTP(w, req)
+	})
+}
+
 func statusUnavailableHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusServiceUnavailable)
 	w.Write([]byte("{\"err:\",\"Sorry, we received too many simultaneous requests.\nPlease try again later.\"}"))
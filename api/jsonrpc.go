@@ -0,0 +1,570 @@
+package api
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "massnet.org/mass/api/proto"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/wire"
+)
+
+const (
+	// JSONRPCPath is the http path of JSON-RPC endpoint, which is also served by POST on "/".
+	JSONRPCPath = "/jsonrpc"
+
+	// maxJSONRPCBatch is the max calls in a batch request, each call takes its own
+	// rate limit tokens.
+	maxJSONRPCBatch = 100
+)
+
+// Standard JSON-RPC 2.0 error codes, and implementation defined ones.
+// Errors of api handlers keep their own codes, e.g. ErrAPIBlockNotFound.
+const (
+	jsonRPCParseError     = -32700
+	jsonRPCInvalidRequest = -32600
+	jsonRPCMethodNotFound = -32601
+	jsonRPCInvalidParams  = -32602
+	jsonRPCInternalError  = -32603
+	jsonRPCUnauthorized   = -32001
+	jsonRPCRateLimited    = -32005
+)
+
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// isNotification returns true if request has no id, which is not answered in JSON-RPC 2.0.
+func (req *jsonRPCRequest) isNotification() bool {
+	return req.JSONRPC == "2.0" && req.ID == nil
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *jsonRPCError) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+func newJSONRPCError(code int, format string, args ...interface{}) *jsonRPCError {
+	return &jsonRPCError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// jsonRPCResponse is in JSON-RPC 2.0 format, and bitcoind-style JSON-RPC 1.0 format
+// for requests without "jsonrpc": "2.0", which has both result and error.
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc,omitempty"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonRPCResponseV1 struct {
+	Result interface{}     `json:"result"`
+	Error  *jsonRPCError   `json:"error"`
+	ID     json.RawMessage `json:"id"`
+}
+
+// jsonRPCParams are positional params, named params are arranged by names of method.
+type jsonRPCParams []json.RawMessage
+
+func (p jsonRPCParams) has(i int) bool {
+	return i < len(p) && p[i] != nil && string(p[i]) != "null"
+}
+
+func (p jsonRPCParams) decode(i int, name string, v interface{}) error {
+	if err := json.Unmarshal(p[i], v); err != nil {
+		return newJSONRPCError(jsonRPCInvalidParams, "invalid param %s: %v", name, err)
+	}
+	return nil
+}
+
+func (p jsonRPCParams) String(i int, name string) (string, error) {
+	var v string
+	if !p.has(i) {
+		return v, newJSONRPCError(jsonRPCInvalidParams, "missing param %s", name)
+	}
+	return v, p.decode(i, name, &v)
+}
+
+func (p jsonRPCParams) Uint64(i int, name string) (uint64, error) {
+	var v uint64
+	if !p.has(i) {
+		return v, newJSONRPCError(jsonRPCInvalidParams, "missing param %s", name)
+	}
+	return v, p.decode(i, name, &v)
+}
+
+func (p jsonRPCParams) Bool(i int, name string, def bool) (bool, error) {
+	if !p.has(i) {
+		return def, nil
+	}
+	var v bool
+	return v, p.decode(i, name, &v)
+}
+
+// jsonRPCMethod maps a JSON-RPC method onto api handlers, apiMethod is the method of
+// ApiService which decides the role required. Calls returning full blocks or transaction
+// pool with transaction details, or scanning the utxo set are expensive, the same as
+// expensiveRoutes of gateway.
+type jsonRPCMethod struct {
+	apiMethod string
+	params    []string
+	expensive func(params jsonRPCParams) bool
+	handler   func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error)
+}
+
+func alwaysExpensive(params jsonRPCParams) bool {
+	return true
+}
+
+var jsonRPCMethods map[string]*jsonRPCMethod
+
+func init() {
+	jsonRPCMethods = map[string]*jsonRPCMethod{
+		"getbestblockhash": {
+			apiMethod: "GetBestBlock",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				resp, err := s.GetBestBlock(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				return resp.Hash, nil
+			},
+		},
+		"getblockcount": {
+			apiMethod: "GetBestBlock",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				resp, err := s.GetBestBlock(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				return resp.Height, nil
+			},
+		},
+		"getblockhash": {
+			apiMethod: "GetBlockHashByHeight",
+			params:    []string{"height"},
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				height, err := params.Uint64(0, "height")
+				if err != nil {
+					return nil, err
+				}
+				resp, err := s.GetBlockHashByHeight(ctx, &pb.GetBlockHashByHeightRequest{Height: height})
+				if err != nil {
+					return nil, err
+				}
+				return resp.Hash, nil
+			},
+		},
+		"getblock": {
+			apiMethod: "GetBlock",
+			params:    []string{"blockhash", "verbosity"},
+			expensive: func(params jsonRPCParams) bool {
+				verbosity, err := jsonRPCVerbosity(params)
+				return err == nil && verbosity == 2
+			},
+			handler: jsonRPCGetBlock,
+		},
+		"getblockheader": {
+			apiMethod: "GetBlockHeader",
+			params:    []string{"blockhash"},
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				hash, err := params.String(0, "blockhash")
+				if err != nil {
+					return nil, err
+				}
+				return marshalJSONRPCResult(s.GetBlockHeader(ctx, &pb.GetBlockHeaderRequest{Hash: hash}))
+			},
+		},
+		"getrawmempool": {
+			apiMethod: "GetTxPoolVerbose1",
+			params:    []string{"verbose"},
+			expensive: func(params jsonRPCParams) bool {
+				verbose, err := params.Bool(0, "verbose", false)
+				return err == nil && verbose
+			},
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				verbose, err := params.Bool(0, "verbose", false)
+				if err != nil {
+					return nil, err
+				}
+				if verbose {
+					return marshalJSONRPCResult(s.GetTxPoolVerbose1(ctx, &empty.Empty{}))
+				}
+				resp, err := s.GetTxPool(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				if resp.Txs == nil {
+					return []string{}, nil
+				}
+				return resp.Txs, nil
+			},
+		},
+		"getmempoolinfo": {
+			apiMethod: "GetTxPool",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				resp, err := s.GetTxPool(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				return map[string]interface{}{
+					"size":         resp.TxCount,
+					"bytes":        resp.TxPacketSize,
+					"orphans":      resp.OrphanCount,
+					"orphan_bytes": resp.OrphanPacketSize,
+				}, nil
+			},
+		},
+		"gettxoutsetinfo": {
+			apiMethod: "GetTxOutSetInfo",
+			expensive: alwaysExpensive,
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				resp, err := s.GetTxOutSetInfo(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				return map[string]interface{}{
+					"height":           resp.Height,
+					"bestblock":        resp.BestBlock,
+					"transactions":     resp.Transactions,
+					"txouts":           resp.Txouts,
+					"bytes_serialized": resp.SerializedSize,
+					"hash_serialized":  resp.HashSerialized,
+					"total_amount":     resp.TotalAmount,
+					"staking_amount":   resp.StakingAmount,
+					"binding_amount":   resp.BindingAmount,
+				}, nil
+			},
+		},
+		"getpeerinfo": {
+			apiMethod: "GetClientStatus",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				resp, err := s.GetClientStatus(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				peers := make([]map[string]interface{}, 0)
+				for _, list := range [][]*pb.GetClientStatusResponsePeerInfo{resp.Peers.Outbound, resp.Peers.Inbound, resp.Peers.Other} {
+					for _, peer := range list {
+						peers = append(peers, map[string]interface{}{
+							"id":      peer.Id,
+							"addr":    peer.Address,
+							"inbound": peer.Direction == "inbound",
+						})
+					}
+				}
+				return peers, nil
+			},
+		},
+		"getconnectioncount": {
+			apiMethod: "GetClientStatus",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				resp, err := s.GetClientStatus(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				return resp.PeerCount.Total, nil
+			},
+		},
+		"getblockchaininfo": {
+			apiMethod: "GetClientStatus",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				clientStatus, err := s.GetClientStatus(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				best, err := s.GetBestBlock(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				return map[string]interface{}{
+					"chain":                clientStatus.ChainId,
+					"blocks":               clientStatus.LocalBestHeight,
+					"headers":              clientStatus.KnownBestHeight,
+					"bestblockhash":        best.Hash,
+					"initialblockdownload": clientStatus.Syncing,
+					"networkspace":         clientStatus.NetworkSpace,
+				}, nil
+			},
+		},
+		"getinfo": {
+			apiMethod: "GetClientStatus",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				return marshalJSONRPCResult(s.GetClientStatus(ctx, &empty.Empty{}))
+			},
+		},
+		"stop": {
+			apiMethod: "QuitClient",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				resp, err := s.QuitClient(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				return resp.Msg, nil
+			},
+		},
+		"help": {
+			apiMethod: "GetClientStatus",
+			handler: func(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+				names := make([]string, 0, len(jsonRPCMethods))
+				for name := range jsonRPCMethods {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				return names, nil
+			},
+		},
+	}
+}
+
+// jsonRPCGetBlock returns serialized block in hex for verbosity 0, block with txids
+// for verbosity 1, and block with transaction details for verbosity 2.
+func jsonRPCGetBlock(ctx context.Context, s *Server, params jsonRPCParams) (interface{}, error) {
+	hash, err := params.String(0, "blockhash")
+	if err != nil {
+		return nil, err
+	}
+	verbosity, err := jsonRPCVerbosity(params)
+	if err != nil {
+		return nil, err
+	}
+
+	switch verbosity {
+	case 0:
+		if err = checkHashLen(hash); err != nil {
+			return nil, err
+		}
+		sha, err := wire.NewHashFromStr(hash)
+		if err != nil {
+			return nil, status.New(ErrAPIShaHashFromStr, ErrCode[ErrAPIShaHashFromStr]).Err()
+		}
+		blk, err := s.chain.GetBlockByHash(sha)
+		if err != nil {
+			return nil, status.New(ErrAPIBlockNotFound, ErrCode[ErrAPIBlockNotFound]).Err()
+		}
+		buf, err := blk.Bytes(wire.Packet)
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(buf), nil
+	case 1:
+		return marshalJSONRPCResult(s.GetBlockV2(ctx, &pb.GetBlockRequestV2{Id: hash}))
+	case 2:
+		return marshalJSONRPCResult(s.GetBlock(ctx, &pb.GetBlockRequest{Hash: hash}))
+	default:
+		return nil, newJSONRPCError(jsonRPCInvalidParams, "invalid param verbosity: %d", verbosity)
+	}
+}
+
+// jsonRPCVerbosity returns verbosity of getblock, which is 1 by default.
+func jsonRPCVerbosity(params jsonRPCParams) (int, error) {
+	var verbosity = 1
+	if params.has(1) {
+		// verbose of bool is accepted as bitcoind does
+		var verbose bool
+		if json.Unmarshal(params[1], &verbose) == nil {
+			if !verbose {
+				verbosity = 0
+			}
+		} else if err := params.decode(1, "verbosity", &verbosity); err != nil {
+			return 0, err
+		}
+	}
+	return verbosity, nil
+}
+
+var jsonRPCMarshaler = &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+
+// marshalJSONRPCResult marshals response of api handlers the same as gateway.
+func marshalJSONRPCResult(msg proto.Message, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = jsonRPCMarshaler.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+// toJSONRPCError converts errors of api handlers.
+func toJSONRPCError(err error) *jsonRPCError {
+	if e, ok := err.(*jsonRPCError); ok {
+		return e
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return newJSONRPCError(jsonRPCInternalError, "%v", err)
+	}
+	switch {
+	case st.Code() == codes.InvalidArgument:
+		return newJSONRPCError(jsonRPCInvalidParams, "%s", st.Message())
+	case st.Code() == codes.Unauthenticated || st.Code() == codes.PermissionDenied:
+		return newJSONRPCError(jsonRPCUnauthorized, "%s", st.Message())
+	case uint32(st.Code()) >= 1000:
+		return newJSONRPCError(int(st.Code()), "%s", st.Message())
+	default:
+		return newJSONRPCError(jsonRPCInternalError, "%s", st.Message())
+	}
+}
+
+// jsonRPCHandler serves JSON-RPC requests, including batch requests, by api handlers.
+// Role of bearer token is checked against the api method of each request.
+func (s *Server) jsonRPCHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		var role = RoleWalletAdmin
+		if s.auth.Enabled() {
+			token, _ := parseBearer(req.Header.Get("Authorization"))
+			role, _ = s.auth.Authenticate(token)
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			writeJSONRPC(w, &jsonRPCResponse{JSONRPC: "2.0", Error: newJSONRPCError(jsonRPCParseError, "%v", err), ID: json.RawMessage("null")})
+			return
+		}
+		body = bytes.TrimSpace(body)
+
+		// batch request
+		if len(body) > 0 && body[0] == '[' {
+			var batch []json.RawMessage
+			if err = json.Unmarshal(body, &batch); err != nil {
+				writeJSONRPC(w, &jsonRPCResponse{JSONRPC: "2.0", Error: newJSONRPCError(jsonRPCParseError, "%v", err), ID: json.RawMessage("null")})
+				return
+			}
+			if len(batch) == 0 || len(batch) > maxJSONRPCBatch {
+				writeJSONRPC(w, &jsonRPCResponse{JSONRPC: "2.0", Error: newJSONRPCError(jsonRPCInvalidRequest, "batch size should be in [1, %d]", maxJSONRPCBatch), ID: json.RawMessage("null")})
+				return
+			}
+			logging.CPrint(logging.INFO, "Received a JSON-RPC batch request", logging.LogFormat{"count": len(batch)})
+			results := make([]interface{}, 0, len(batch))
+			for _, raw := range batch {
+				if resp := s.serveJSONRPC(req.Context(), raw, role); resp != nil {
+					results = append(results, resp)
+				}
+			}
+			if len(results) == 0 {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			writeJSONRPC(w, results)
+			return
+		}
+
+		if resp := s.serveJSONRPC(req.Context(), body, role); resp != nil {
+			writeJSONRPC(w, resp)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// serveJSONRPC serves a single request, nil is returned for notifications.
+func (s *Server) serveJSONRPC(ctx context.Context, raw json.RawMessage, role Role) interface{} {
+	var req jsonRPCRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		code := jsonRPCInvalidRequest
+		if _, ok := err.(*json.SyntaxError); ok {
+			code = jsonRPCParseError
+		}
+		return &jsonRPCResponse{JSONRPC: "2.0", Error: newJSONRPCError(code, "%v", err), ID: json.RawMessage("null")}
+	}
+	result, err := s.callJSONRPC(ctx, &req, role)
+	if req.isNotification() {
+		return nil
+	}
+	if req.ID == nil {
+		req.ID = json.RawMessage("null")
+	}
+
+	var rpcErr *jsonRPCError
+	if err != nil {
+		rpcErr = toJSONRPCError(err)
+		logging.CPrint(logging.WARN, "JSON-RPC request failed", logging.LogFormat{"method": req.Method, "code": rpcErr.Code, "err": rpcErr.Message})
+	}
+	if req.JSONRPC != "2.0" {
+		return &jsonRPCResponseV1{Result: result, Error: rpcErr, ID: req.ID}
+	}
+	if rpcErr == nil && result == nil {
+		result = json.RawMessage("null")
+	}
+	return &jsonRPCResponse{JSONRPC: "2.0", Result: result, Error: rpcErr, ID: req.ID}
+}
+
+func (s *Server) callJSONRPC(ctx context.Context, req *jsonRPCRequest, role Role) (interface{}, error) {
+	if req.Method == "" {
+		return nil, newJSONRPCError(jsonRPCInvalidRequest, "missing method")
+	}
+	method, ok := jsonRPCMethods[req.Method]
+	if !ok {
+		return nil, newJSONRPCError(jsonRPCMethodNotFound, "method not found: %s", req.Method)
+	}
+	if required := requiredRole(method.apiMethod); role < required {
+		logging.CPrint(logging.WARN, "api received JSON-RPC request beyond role of token", logging.LogFormat{"method": req.Method, "role": role, "required": required})
+		return nil, newJSONRPCError(jsonRPCUnauthorized, "role %s is required", required)
+	}
+
+	var params jsonRPCParams
+	trimmed := bytes.TrimSpace(req.Params)
+	switch {
+	case len(trimmed) == 0 || string(trimmed) == "null":
+	case trimmed[0] == '[':
+		if err := json.Unmarshal(trimmed, &params); err != nil {
+			return nil, newJSONRPCError(jsonRPCInvalidParams, "%v", err)
+		}
+	case trimmed[0] == '{':
+		var named map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &named); err != nil {
+			return nil, newJSONRPCError(jsonRPCInvalidParams, "%v", err)
+		}
+		params = make(jsonRPCParams, len(method.params))
+		for i, name := range method.params {
+			params[i] = named[name]
+		}
+	default:
+		return nil, newJSONRPCError(jsonRPCInvalidParams, "params should be array or object")
+	}
+	if len(params) > len(method.params) {
+		return nil, newJSONRPCError(jsonRPCInvalidParams, "too many params, expect at most %d", len(method.params))
+	}
+
+	if limit := rateLimitFromContext(ctx); limit != nil {
+		expensive := method.expensive != nil && method.expensive(params)
+		if ok, wait := limit(expensive); !ok {
+			logging.CPrint(logging.WARN, "too many JSON-RPC requests", logging.LogFormat{"method": req.Method, "expensive": expensive})
+			return nil, newJSONRPCError(jsonRPCRateLimited, "too many requests, retry after %d seconds", int(math.Ceil(wait.Seconds())))
+		}
+	}
+	return method.handler(ctx, s, params)
+}
+
+func writeJSONRPC(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logging.CPrint(logging.WARN, "fail to write JSON-RPC response", logging.LogFormat{"err": err})
+	}
+}
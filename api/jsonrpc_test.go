@@ -0,0 +1,205 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"massnet.org/mass/config"
+	configpb "massnet.org/mass/config/pb"
+)
+
+const testReadOnlyToken = "read-only-token-0123456789"
+
+func newTestJSONRPCServer(t *testing.T, tokens ...string) *Server {
+	auth, err := newTokenAuth(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Server{auth: auth}
+}
+
+func postJSONRPC(t *testing.T, h http.Handler, body, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, JSONRPCPath, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", bearerPrefix+token)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+type testJSONRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result"`
+	Error   *jsonRPCError   `json:"error"`
+	ID      json.RawMessage `json:"id"`
+}
+
+func TestJSONRPCHandler(t *testing.T) {
+	s := newTestJSONRPCServer(t, "read-only:"+testReadOnlyToken)
+	tests := []struct {
+		name  string
+		body  string
+		token string
+		code  int
+		id    string
+	}{
+		{"parse error", `{"jsonrpc":"2.0","method":`, testReadOnlyToken, jsonRPCParseError, "null"},
+		{"invalid request", `{"jsonrpc":"2.0","method":1,"id":1}`, testReadOnlyToken, jsonRPCInvalidRequest, "null"},
+		{"missing method", `{"jsonrpc":"2.0","id":2}`, testReadOnlyToken, jsonRPCInvalidRequest, "2"},
+		{"unknown method", `{"jsonrpc":"2.0","method":"nosuchmethod","id":3}`, testReadOnlyToken, jsonRPCMethodNotFound, "3"},
+		{"invalid params", `{"jsonrpc":"2.0","method":"getblockhash","params":["a"],"id":4}`, testReadOnlyToken, jsonRPCInvalidParams, "4"},
+		{"too many params", `{"jsonrpc":"2.0","method":"help","params":[1],"id":5}`, testReadOnlyToken, jsonRPCInvalidParams, "5"},
+		{"params not structured", `{"jsonrpc":"2.0","method":"help","params":1,"id":6}`, testReadOnlyToken, jsonRPCInvalidParams, "6"},
+		{"beyond role", `{"jsonrpc":"2.0","method":"stop","id":7}`, testReadOnlyToken, jsonRPCUnauthorized, "7"},
+		{"empty batch", `[]`, testReadOnlyToken, jsonRPCInvalidRequest, "null"},
+		{"oversized batch", "[" + strings.Repeat(`{"jsonrpc":"2.0","method":"help","id":1},`, maxJSONRPCBatch) + `{"jsonrpc":"2.0","method":"help","id":1}]`, testReadOnlyToken, jsonRPCInvalidRequest, "null"},
+		{"ok", `{"jsonrpc":"2.0","method":"help","id":"a"}`, testReadOnlyToken, 0, `"a"`},
+	}
+
+	for _, test := range tests {
+		w := postJSONRPC(t, s.jsonRPCHandler(), test.body, test.token)
+		var resp testJSONRPCResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Errorf("%s, invalid response %s, %v", test.name, w.Body.String(), err)
+			continue
+		}
+		if resp.JSONRPC != "2.0" || string(resp.ID) != test.id {
+			t.Errorf("%s, unexpected response %s", test.name, w.Body.String())
+		}
+		if test.code == 0 {
+			if resp.Error != nil || resp.Result == nil {
+				t.Errorf("%s, unexpected error %v", test.name, resp.Error)
+			}
+			continue
+		}
+		if resp.Error == nil || resp.Error.Code != test.code {
+			t.Errorf("%s, error mismatched, got %v, want %d", test.name, resp.Error, test.code)
+		}
+	}
+}
+
+func TestJSONRPCHandlerV1(t *testing.T) {
+	s := newTestJSONRPCServer(t)
+	w := postJSONRPC(t, s.jsonRPCHandler(), `{"method":"nosuchmethod","id":1}`, "")
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp["jsonrpc"]; ok || string(resp["result"]) != "null" || string(resp["id"]) != "1" {
+		t.Errorf("unexpected JSON-RPC 1.0 response, %s", w.Body.String())
+	}
+}
+
+func TestJSONRPCHandlerBatch(t *testing.T) {
+	s := newTestJSONRPCServer(t)
+
+	body := `[
+		{"jsonrpc":"2.0","method":"help","id":1},
+		{"jsonrpc":"2.0","method":"help"},
+		{"jsonrpc":"2.0","method":"nosuchmethod","id":2},
+		{"jsonrpc":"2.0","method":"nosuchmethod"},
+		1
+	]`
+	w := postJSONRPC(t, s.jsonRPCHandler(), body, "")
+	var batch []testJSONRPCResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &batch); err != nil {
+		t.Fatalf("invalid batch response %s, %v", w.Body.String(), err)
+	}
+	if len(batch) != 3 {
+		t.Fatalf("notifications should not be answered, %s", w.Body.String())
+	}
+	if string(batch[0].ID) != "1" || batch[0].Error != nil {
+		t.Errorf("unexpected response of help, %s", w.Body.String())
+	}
+	if string(batch[1].ID) != "2" || batch[1].Error == nil || batch[1].Error.Code != jsonRPCMethodNotFound {
+		t.Errorf("unexpected response of unknown method, %s", w.Body.String())
+	}
+	if string(batch[2].ID) != "null" || batch[2].Error == nil || batch[2].Error.Code != jsonRPCInvalidRequest {
+		t.Errorf("unexpected response of invalid request, %s", w.Body.String())
+	}
+
+	// batch of notifications
+	w = postJSONRPC(t, s.jsonRPCHandler(), `[{"jsonrpc":"2.0","method":"help"},{"jsonrpc":"2.0","method":"nosuchmethod"}]`, "")
+	if w.Code != http.StatusNoContent || w.Body.Len() != 0 {
+		t.Errorf("batch of notifications should not be answered, %d, %s", w.Code, w.Body.String())
+	}
+}
+
+func TestJSONRPCHandlerNotification(t *testing.T) {
+	s := newTestJSONRPCServer(t)
+	for _, body := range []string{
+		`{"jsonrpc":"2.0","method":"help"}`,
+		`{"jsonrpc":"2.0","method":"nosuchmethod"}`,
+	} {
+		w := postJSONRPC(t, s.jsonRPCHandler(), body, "")
+		if w.Code != http.StatusNoContent || w.Body.Len() != 0 {
+			t.Errorf("notification %s should not be answered, %d, %s", body, w.Code, w.Body.String())
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, JSONRPCPath, nil)
+	w := httptest.NewRecorder()
+	s.jsonRPCHandler().ServeHTTP(w, req)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("unexpected status of GET, %d", w.Code)
+	}
+}
+
+func TestJSONRPCMethodExpensive(t *testing.T) {
+	tests := []struct {
+		method    string
+		params    string
+		expensive bool
+	}{
+		{"getblock", `["hash"]`, false},
+		{"getblock", `["hash", 0]`, false},
+		{"getblock", `["hash", false]`, false},
+		{"getblock", `["hash", 2]`, true},
+		{"getrawmempool", `[]`, false},
+		{"getrawmempool", `[true]`, true},
+		{"gettxoutsetinfo", `[]`, true},
+		{"getblockcount", `[]`, false},
+	}
+	for _, test := range tests {
+		var params jsonRPCParams
+		if err := json.Unmarshal([]byte(test.params), &params); err != nil {
+			t.Fatal(err)
+		}
+		method := jsonRPCMethods[test.method]
+		if expensive := method.expensive != nil && method.expensive(params); expensive != test.expensive {
+			t.Errorf("%s %s, expensive mismatched, got %v", test.method, test.params, expensive)
+		}
+	}
+}
+
+func TestJSONRPCRateLimit(t *testing.T) {
+	s := newTestJSONRPCServer(t)
+	cfg := &config.Config{Config: &configpb.Config{Network: &configpb.NetworkConfig{API: &configpb.APIConfig{
+		APIRateLimit: 0.001,
+		APIRateBurst: 3,
+	}}}}
+	h := rateLimitHandler(s.jsonRPCHandler(), cfg)
+
+	// each call in a batch takes a token
+	w := postJSONRPC(t, h, `[{"jsonrpc":"2.0","method":"help","id":1},{"jsonrpc":"2.0","method":"help","id":2}]`, "")
+	var batch []testJSONRPCResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &batch); err != nil {
+		t.Fatal(err)
+	}
+	if len(batch) != 2 || batch[0].Error != nil || batch[1].Error != nil {
+		t.Fatalf("unexpected batch response, %s", w.Body.String())
+	}
+
+	w = postJSONRPC(t, h, `[{"jsonrpc":"2.0","method":"help","id":1},{"jsonrpc":"2.0","method":"help","id":2}]`, "")
+	batch = nil
+	if err := json.Unmarshal(w.Body.Bytes(), &batch); err != nil {
+		t.Fatal(err)
+	}
+	if len(batch) != 2 || batch[0].Error != nil || batch[1].Error == nil || batch[1].Error.Code != jsonRPCRateLimited {
+		t.Errorf("call beyond burst should be limited, %s", w.Body.String())
+	}
+}
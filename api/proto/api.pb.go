@@ -2040,6 +2040,12 @@ type GetTxDescVerbose1Response struct {
 	TotalInputAge        int64         `protobuf:"varint,8,opt,name=total_input_age,json=totalInputAge,proto3" json:"total_input_age,omitempty"`
 	CurrentPriority      float64       `protobuf:"fixed64,9,opt,name=current_priority,json=currentPriority,proto3" json:"current_priority,omitempty"`
 	Depends              []*TxOutPoint `protobuf:"bytes,10,rep,name=depends,proto3" json:"depends,omitempty"`
+	AncestorCount        uint32        `protobuf:"varint,11,opt,name=ancestor_count,json=ancestorCount,proto3" json:"ancestor_count,omitempty"`
+	AncestorSize         uint64        `protobuf:"varint,12,opt,name=ancestor_size,json=ancestorSize,proto3" json:"ancestor_size,omitempty"`
+	AncestorFees         string        `protobuf:"bytes,13,opt,name=ancestor_fees,json=ancestorFees,proto3" json:"ancestor_fees,omitempty"`
+	DescendantCount      uint32        `protobuf:"varint,14,opt,name=descendant_count,json=descendantCount,proto3" json:"descendant_count,omitempty"`
+	DescendantSize       uint64        `protobuf:"varint,15,opt,name=descendant_size,json=descendantSize,proto3" json:"descendant_size,omitempty"`
+	DescendantFees       string        `protobuf:"bytes,16,opt,name=descendant_fees,json=descendantFees,proto3" json:"descendant_fees,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -2139,6 +2145,48 @@ func (m *GetTxDescVerbose1Response) GetDepends() []*TxOutPoint {
 	return nil
 }
 
+func (m *GetTxDescVerbose1Response) GetAncestorCount() uint32 {
+	if m != nil {
+		return m.AncestorCount
+	}
+	return 0
+}
+
+func (m *GetTxDescVerbose1Response) GetAncestorSize() uint64 {
+	if m != nil {
+		return m.AncestorSize
+	}
+	return 0
+}
+
+func (m *GetTxDescVerbose1Response) GetAncestorFees() string {
+	if m != nil {
+		return m.AncestorFees
+	}
+	return ""
+}
+
+func (m *GetTxDescVerbose1Response) GetDescendantCount() uint32 {
+	if m != nil {
+		return m.DescendantCount
+	}
+	return 0
+}
+
+func (m *GetTxDescVerbose1Response) GetDescendantSize() uint64 {
+	if m != nil {
+		return m.DescendantSize
+	}
+	return 0
+}
+
+func (m *GetTxDescVerbose1Response) GetDescendantFees() string {
+	if m != nil {
+		return m.DescendantFees
+	}
+	return ""
+}
+
 type GetOrphanTxDescResponse struct {
 	Txid                 string        `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	PlainSize            uint32        `protobuf:"varint,2,opt,name=plain_size,json=plainSize,proto3" json:"plain_size,omitempty"`
@@ -2847,334 +2895,2402 @@ func (m *ActOnSpaceKeeperResponse) GetErrorMessage() string {
 	return ""
 }
 
-type GetClientStatusResponse struct {
-	Version              string                                `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	PeerListening        bool                                  `protobuf:"varint,2,opt,name=peer_listening,json=peerListening,proto3" json:"peer_listening,omitempty"`
-	Syncing              bool                                  `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	Mining               bool                                  `protobuf:"varint,4,opt,name=mining,proto3" json:"mining,omitempty"`
-	SpaceKeeping         bool                                  `protobuf:"varint,5,opt,name=space_keeping,json=spaceKeeping,proto3" json:"space_keeping,omitempty"`
-	ChainId              string                                `protobuf:"bytes,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	LocalBestHeight      uint64                                `protobuf:"varint,7,opt,name=local_best_height,json=localBestHeight,proto3" json:"local_best_height,omitempty"`
-	KnownBestHeight      uint64                                `protobuf:"varint,8,opt,name=known_best_height,json=knownBestHeight,proto3" json:"known_best_height,omitempty"`
-	P2PId                string                                `protobuf:"bytes,9,opt,name=p2p_id,json=p2pId,proto3" json:"p2p_id,omitempty"`
-	PeerCount            *GetClientStatusResponsePeerCountInfo `protobuf:"bytes,10,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
-	Peers                *GetClientStatusResponsePeerList      `protobuf:"bytes,11,opt,name=peers,proto3" json:"peers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+type PlotQueueItem struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Running              bool     `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	Position             uint32   `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Progress             float64  `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	WouldMine            bool     `protobuf:"varint,6,opt,name=would_mine,json=wouldMine,proto3" json:"would_mine,omitempty"`
+	Memory               uint64   `protobuf:"varint,7,opt,name=memory,proto3" json:"memory,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetClientStatusResponse) Reset()         { *m = GetClientStatusResponse{} }
-func (m *GetClientStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*GetClientStatusResponse) ProtoMessage()    {}
-func (*GetClientStatusResponse) Descriptor() ([]byte, []int) {
+func (m *PlotQueueItem) Reset()         { *m = PlotQueueItem{} }
+func (m *PlotQueueItem) String() string { return proto.CompactTextString(m) }
+func (*PlotQueueItem) ProtoMessage()    {}
+func (*PlotQueueItem) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
-func (m *GetClientStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClientStatusResponse.Unmarshal(m, b)
+func (m *PlotQueueItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlotQueueItem.Unmarshal(m, b)
 }
-func (m *GetClientStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClientStatusResponse.Marshal(b, m, deterministic)
+func (m *PlotQueueItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlotQueueItem.Marshal(b, m, deterministic)
 }
-func (m *GetClientStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClientStatusResponse.Merge(m, src)
+func (m *PlotQueueItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlotQueueItem.Merge(m, src)
 }
-func (m *GetClientStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_GetClientStatusResponse.Size(m)
+func (m *PlotQueueItem) XXX_Size() int {
+	return xxx_messageInfo_PlotQueueItem.Size(m)
 }
-func (m *GetClientStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClientStatusResponse.DiscardUnknown(m)
+func (m *PlotQueueItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlotQueueItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClientStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_PlotQueueItem proto.InternalMessageInfo
 
-func (m *GetClientStatusResponse) GetVersion() string {
+func (m *PlotQueueItem) GetSpaceId() string {
 	if m != nil {
-		return m.Version
+		return m.SpaceId
 	}
 	return ""
 }
 
-func (m *GetClientStatusResponse) GetPeerListening() bool {
+func (m *PlotQueueItem) GetDevice() string {
 	if m != nil {
-		return m.PeerListening
+		return m.Device
 	}
-	return false
+	return ""
 }
 
-func (m *GetClientStatusResponse) GetSyncing() bool {
+func (m *PlotQueueItem) GetRunning() bool {
 	if m != nil {
-		return m.Syncing
+		return m.Running
 	}
 	return false
 }
 
-func (m *GetClientStatusResponse) GetMining() bool {
+func (m *PlotQueueItem) GetPosition() uint32 {
 	if m != nil {
-		return m.Mining
+		return m.Position
 	}
-	return false
+	return 0
 }
 
-func (m *GetClientStatusResponse) GetSpaceKeeping() bool {
+func (m *PlotQueueItem) GetProgress() float64 {
 	if m != nil {
-		return m.SpaceKeeping
+		return m.Progress
 	}
-	return false
+	return 0
 }
 
-func (m *GetClientStatusResponse) GetChainId() string {
+func (m *PlotQueueItem) GetWouldMine() bool {
 	if m != nil {
-		return m.ChainId
+		return m.WouldMine
 	}
-	return ""
+	return false
 }
 
-func (m *GetClientStatusResponse) GetLocalBestHeight() uint64 {
+func (m *PlotQueueItem) GetMemory() uint64 {
 	if m != nil {
-		return m.LocalBestHeight
+		return m.Memory
 	}
 	return 0
 }
 
-func (m *GetClientStatusResponse) GetKnownBestHeight() uint64 {
-	if m != nil {
-		return m.KnownBestHeight
-	}
-	return 0
+type PlotQueueResponse struct {
+	Paused               bool             `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Items                []*PlotQueueItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetClientStatusResponse) GetP2PId() string {
-	if m != nil {
-		return m.P2PId
-	}
-	return ""
+func (m *PlotQueueResponse) Reset()         { *m = PlotQueueResponse{} }
+func (m *PlotQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*PlotQueueResponse) ProtoMessage()    {}
+func (*PlotQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+}
+func (m *PlotQueueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlotQueueResponse.Unmarshal(m, b)
+}
+func (m *PlotQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlotQueueResponse.Marshal(b, m, deterministic)
+}
+func (m *PlotQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlotQueueResponse.Merge(m, src)
+}
+func (m *PlotQueueResponse) XXX_Size() int {
+	return xxx_messageInfo_PlotQueueResponse.Size(m)
+}
+func (m *PlotQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlotQueueResponse.DiscardUnknown(m)
 }
 
-func (m *GetClientStatusResponse) GetPeerCount() *GetClientStatusResponsePeerCountInfo {
+var xxx_messageInfo_PlotQueueResponse proto.InternalMessageInfo
+
+func (m *PlotQueueResponse) GetPaused() bool {
 	if m != nil {
-		return m.PeerCount
+		return m.Paused
 	}
-	return nil
+	return false
 }
 
-func (m *GetClientStatusResponse) GetPeers() *GetClientStatusResponsePeerList {
+func (m *PlotQueueResponse) GetItems() []*PlotQueueItem {
 	if m != nil {
-		return m.Peers
+		return m.Items
 	}
 	return nil
 }
 
-type GetClientStatusResponsePeerCountInfo struct {
-	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Outbound             uint32   `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
-	Inbound              uint32   `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+type UpdatePlotQueueRequest struct {
+	Action               string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	SpaceIds             []string `protobuf:"bytes,2,rep,name=space_ids,json=spaceIds,proto3" json:"space_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetClientStatusResponsePeerCountInfo) Reset()         { *m = GetClientStatusResponsePeerCountInfo{} }
-func (m *GetClientStatusResponsePeerCountInfo) String() string { return proto.CompactTextString(m) }
-func (*GetClientStatusResponsePeerCountInfo) ProtoMessage()    {}
-func (*GetClientStatusResponsePeerCountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37, 0}
+func (m *UpdatePlotQueueRequest) Reset()         { *m = UpdatePlotQueueRequest{} }
+func (m *UpdatePlotQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePlotQueueRequest) ProtoMessage()    {}
+func (*UpdatePlotQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Unmarshal(m, b)
+func (m *UpdatePlotQueueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePlotQueueRequest.Unmarshal(m, b)
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Marshal(b, m, deterministic)
+func (m *UpdatePlotQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePlotQueueRequest.Marshal(b, m, deterministic)
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Merge(m, src)
+func (m *UpdatePlotQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePlotQueueRequest.Merge(m, src)
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_Size() int {
-	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Size(m)
+func (m *UpdatePlotQueueRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdatePlotQueueRequest.Size(m)
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClientStatusResponsePeerCountInfo.DiscardUnknown(m)
+func (m *UpdatePlotQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePlotQueueRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClientStatusResponsePeerCountInfo proto.InternalMessageInfo
-
-func (m *GetClientStatusResponsePeerCountInfo) GetTotal() uint32 {
-	if m != nil {
-		return m.Total
-	}
-	return 0
-}
+var xxx_messageInfo_UpdatePlotQueueRequest proto.InternalMessageInfo
 
-func (m *GetClientStatusResponsePeerCountInfo) GetOutbound() uint32 {
+func (m *UpdatePlotQueueRequest) GetAction() string {
 	if m != nil {
-		return m.Outbound
+		return m.Action
 	}
-	return 0
+	return ""
 }
 
-func (m *GetClientStatusResponsePeerCountInfo) GetInbound() uint32 {
+func (m *UpdatePlotQueueRequest) GetSpaceIds() []string {
 	if m != nil {
-		return m.Inbound
+		return m.SpaceIds
 	}
-	return 0
+	return nil
 }
 
-type GetClientStatusResponsePeerInfo struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Direction            string   `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+type CapacityPlanSpaces struct {
+	BitLength            uint32   `protobuf:"varint,1,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetClientStatusResponsePeerInfo) Reset()         { *m = GetClientStatusResponsePeerInfo{} }
-func (m *GetClientStatusResponsePeerInfo) String() string { return proto.CompactTextString(m) }
-func (*GetClientStatusResponsePeerInfo) ProtoMessage()    {}
-func (*GetClientStatusResponsePeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37, 1}
+func (m *CapacityPlanSpaces) Reset()         { *m = CapacityPlanSpaces{} }
+func (m *CapacityPlanSpaces) String() string { return proto.CompactTextString(m) }
+func (*CapacityPlanSpaces) ProtoMessage()    {}
+func (*CapacityPlanSpaces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Unmarshal(m, b)
+func (m *CapacityPlanSpaces) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityPlanSpaces.Unmarshal(m, b)
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Marshal(b, m, deterministic)
+func (m *CapacityPlanSpaces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityPlanSpaces.Marshal(b, m, deterministic)
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClientStatusResponsePeerInfo.Merge(m, src)
+func (m *CapacityPlanSpaces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityPlanSpaces.Merge(m, src)
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_Size() int {
-	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Size(m)
+func (m *CapacityPlanSpaces) XXX_Size() int {
+	return xxx_messageInfo_CapacityPlanSpaces.Size(m)
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClientStatusResponsePeerInfo.DiscardUnknown(m)
+func (m *CapacityPlanSpaces) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityPlanSpaces.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClientStatusResponsePeerInfo proto.InternalMessageInfo
+var xxx_messageInfo_CapacityPlanSpaces proto.InternalMessageInfo
 
-func (m *GetClientStatusResponsePeerInfo) GetId() string {
+func (m *CapacityPlanSpaces) GetBitLength() uint32 {
 	if m != nil {
-		return m.Id
+		return m.BitLength
 	}
-	return ""
+	return 0
 }
 
-func (m *GetClientStatusResponsePeerInfo) GetAddress() string {
+func (m *CapacityPlanSpaces) GetCount() uint32 {
 	if m != nil {
-		return m.Address
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-func (m *GetClientStatusResponsePeerInfo) GetDirection() string {
-	if m != nil {
-		return m.Direction
-	}
-	return ""
+type CapacityPlanDir struct {
+	Dir                  string                `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	Device               string                `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	TotalBytes           uint64                `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	FreeBytes            uint64                `protobuf:"varint,4,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	ReservedBytes        uint64                `protobuf:"varint,5,opt,name=reserved_bytes,json=reservedBytes,proto3" json:"reserved_bytes,omitempty"`
+	PlannedBytes         uint64                `protobuf:"varint,6,opt,name=planned_bytes,json=plannedBytes,proto3" json:"planned_bytes,omitempty"`
+	Spaces               []*CapacityPlanSpaces `protobuf:"bytes,7,rep,name=spaces,proto3" json:"spaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-type GetClientStatusResponsePeerList struct {
-	Outbound             []*GetClientStatusResponsePeerInfo `protobuf:"bytes,1,rep,name=outbound,proto3" json:"outbound,omitempty"`
-	Inbound              []*GetClientStatusResponsePeerInfo `protobuf:"bytes,2,rep,name=inbound,proto3" json:"inbound,omitempty"`
-	Other                []*GetClientStatusResponsePeerInfo `protobuf:"bytes,3,rep,name=other,proto3" json:"other,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+func (m *CapacityPlanDir) Reset()         { *m = CapacityPlanDir{} }
+func (m *CapacityPlanDir) String() string { return proto.CompactTextString(m) }
+func (*CapacityPlanDir) ProtoMessage()    {}
+func (*CapacityPlanDir) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
 }
-
-func (m *GetClientStatusResponsePeerList) Reset()         { *m = GetClientStatusResponsePeerList{} }
-func (m *GetClientStatusResponsePeerList) String() string { return proto.CompactTextString(m) }
-func (*GetClientStatusResponsePeerList) ProtoMessage()    {}
-func (*GetClientStatusResponsePeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37, 2}
+func (m *CapacityPlanDir) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityPlanDir.Unmarshal(m, b)
 }
-func (m *GetClientStatusResponsePeerList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClientStatusResponsePeerList.Unmarshal(m, b)
+func (m *CapacityPlanDir) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityPlanDir.Marshal(b, m, deterministic)
 }
-func (m *GetClientStatusResponsePeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClientStatusResponsePeerList.Marshal(b, m, deterministic)
+func (m *CapacityPlanDir) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityPlanDir.Merge(m, src)
 }
-func (m *GetClientStatusResponsePeerList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClientStatusResponsePeerList.Merge(m, src)
+func (m *CapacityPlanDir) XXX_Size() int {
+	return xxx_messageInfo_CapacityPlanDir.Size(m)
 }
-func (m *GetClientStatusResponsePeerList) XXX_Size() int {
-	return xxx_messageInfo_GetClientStatusResponsePeerList.Size(m)
+func (m *CapacityPlanDir) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityPlanDir.DiscardUnknown(m)
 }
-func (m *GetClientStatusResponsePeerList) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClientStatusResponsePeerList.DiscardUnknown(m)
+
+var xxx_messageInfo_CapacityPlanDir proto.InternalMessageInfo
+
+func (m *CapacityPlanDir) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetClientStatusResponsePeerList proto.InternalMessageInfo
+func (m *CapacityPlanDir) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
 
-func (m *GetClientStatusResponsePeerList) GetOutbound() []*GetClientStatusResponsePeerInfo {
+func (m *CapacityPlanDir) GetTotalBytes() uint64 {
 	if m != nil {
-		return m.Outbound
+		return m.TotalBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *GetClientStatusResponsePeerList) GetInbound() []*GetClientStatusResponsePeerInfo {
+func (m *CapacityPlanDir) GetFreeBytes() uint64 {
 	if m != nil {
-		return m.Inbound
+		return m.FreeBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *GetClientStatusResponsePeerList) GetOther() []*GetClientStatusResponsePeerInfo {
+func (m *CapacityPlanDir) GetReservedBytes() uint64 {
 	if m != nil {
-		return m.Other
+		return m.ReservedBytes
+	}
+	return 0
+}
+
+func (m *CapacityPlanDir) GetPlannedBytes() uint64 {
+	if m != nil {
+		return m.PlannedBytes
+	}
+	return 0
+}
+
+func (m *CapacityPlanDir) GetSpaces() []*CapacityPlanSpaces {
+	if m != nil {
+		return m.Spaces
 	}
 	return nil
 }
 
-type QuitClientResponse struct {
-	ErrorCode            uint32   `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
-	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CapacityPlanResponse struct {
+	PlanId               string             `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Dirs                 []*CapacityPlanDir `protobuf:"bytes,2,rep,name=dirs,proto3" json:"dirs,omitempty"`
+	PlannedBytes         uint64             `protobuf:"varint,3,opt,name=planned_bytes,json=plannedBytes,proto3" json:"planned_bytes,omitempty"`
+	AvailableBytes       uint64             `protobuf:"varint,4,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *QuitClientResponse) Reset()         { *m = QuitClientResponse{} }
-func (m *QuitClientResponse) String() string { return proto.CompactTextString(m) }
-func (*QuitClientResponse) ProtoMessage()    {}
-func (*QuitClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+func (m *CapacityPlanResponse) Reset()         { *m = CapacityPlanResponse{} }
+func (m *CapacityPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*CapacityPlanResponse) ProtoMessage()    {}
+func (*CapacityPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
 }
-func (m *QuitClientResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QuitClientResponse.Unmarshal(m, b)
+func (m *CapacityPlanResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityPlanResponse.Unmarshal(m, b)
 }
-func (m *QuitClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QuitClientResponse.Marshal(b, m, deterministic)
+func (m *CapacityPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityPlanResponse.Marshal(b, m, deterministic)
 }
-func (m *QuitClientResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuitClientResponse.Merge(m, src)
+func (m *CapacityPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityPlanResponse.Merge(m, src)
 }
-func (m *QuitClientResponse) XXX_Size() int {
-	return xxx_messageInfo_QuitClientResponse.Size(m)
+func (m *CapacityPlanResponse) XXX_Size() int {
+	return xxx_messageInfo_CapacityPlanResponse.Size(m)
 }
-func (m *QuitClientResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuitClientResponse.DiscardUnknown(m)
+func (m *CapacityPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityPlanResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuitClientResponse proto.InternalMessageInfo
+var xxx_messageInfo_CapacityPlanResponse proto.InternalMessageInfo
 
-func (m *QuitClientResponse) GetErrorCode() uint32 {
+func (m *CapacityPlanResponse) GetPlanId() string {
 	if m != nil {
-		return m.ErrorCode
+		return m.PlanId
 	}
-	return 0
+	return ""
 }
 
-func (m *QuitClientResponse) GetMsg() string {
+func (m *CapacityPlanResponse) GetDirs() []*CapacityPlanDir {
 	if m != nil {
-		return m.Msg
+		return m.Dirs
 	}
-	return ""
+	return nil
 }
 
-type GetBlockHeightByPubKeyRequest struct {
-	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+func (m *CapacityPlanResponse) GetPlannedBytes() uint64 {
+	if m != nil {
+		return m.PlannedBytes
+	}
+	return 0
+}
+
+func (m *CapacityPlanResponse) GetAvailableBytes() uint64 {
+	if m != nil {
+		return m.AvailableBytes
+	}
+	return 0
+}
+
+type ApplyCapacityPlanRequest struct {
+	PlanId               string   `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PayoutAddresses      []string `protobuf:"bytes,2,rep,name=payout_addresses,json=payoutAddresses,proto3" json:"payout_addresses,omitempty"`
+	Passphrase           string   `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyCapacityPlanRequest) Reset()         { *m = ApplyCapacityPlanRequest{} }
+func (m *ApplyCapacityPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyCapacityPlanRequest) ProtoMessage()    {}
+func (*ApplyCapacityPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+}
+func (m *ApplyCapacityPlanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyCapacityPlanRequest.Unmarshal(m, b)
+}
+func (m *ApplyCapacityPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyCapacityPlanRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyCapacityPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyCapacityPlanRequest.Merge(m, src)
+}
+func (m *ApplyCapacityPlanRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyCapacityPlanRequest.Size(m)
+}
+func (m *ApplyCapacityPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyCapacityPlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyCapacityPlanRequest proto.InternalMessageInfo
+
+func (m *ApplyCapacityPlanRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *ApplyCapacityPlanRequest) GetPayoutAddresses() []string {
+	if m != nil {
+		return m.PayoutAddresses
+	}
+	return nil
+}
+
+func (m *ApplyCapacityPlanRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type BenchmarkProofsRequest struct {
+	Challenges           uint32   `protobuf:"varint,1,opt,name=challenges,proto3" json:"challenges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BenchmarkProofsRequest) Reset()         { *m = BenchmarkProofsRequest{} }
+func (m *BenchmarkProofsRequest) String() string { return proto.CompactTextString(m) }
+func (*BenchmarkProofsRequest) ProtoMessage()    {}
+func (*BenchmarkProofsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+}
+func (m *BenchmarkProofsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BenchmarkProofsRequest.Unmarshal(m, b)
+}
+func (m *BenchmarkProofsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BenchmarkProofsRequest.Marshal(b, m, deterministic)
+}
+func (m *BenchmarkProofsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BenchmarkProofsRequest.Merge(m, src)
+}
+func (m *BenchmarkProofsRequest) XXX_Size() int {
+	return xxx_messageInfo_BenchmarkProofsRequest.Size(m)
+}
+func (m *BenchmarkProofsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BenchmarkProofsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BenchmarkProofsRequest proto.InternalMessageInfo
+
+func (m *BenchmarkProofsRequest) GetChallenges() uint32 {
+	if m != nil {
+		return m.Challenges
+	}
+	return 0
+}
+
+type ProofLatency struct {
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	P50Ms                float64  `protobuf:"fixed64,2,opt,name=p50_ms,json=p50Ms,proto3" json:"p50_ms,omitempty"`
+	P95Ms                float64  `protobuf:"fixed64,3,opt,name=p95_ms,json=p95Ms,proto3" json:"p95_ms,omitempty"`
+	P99Ms                float64  `protobuf:"fixed64,4,opt,name=p99_ms,json=p99Ms,proto3" json:"p99_ms,omitempty"`
+	MaxMs                float64  `protobuf:"fixed64,5,opt,name=max_ms,json=maxMs,proto3" json:"max_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProofLatency) Reset()         { *m = ProofLatency{} }
+func (m *ProofLatency) String() string { return proto.CompactTextString(m) }
+func (*ProofLatency) ProtoMessage()    {}
+func (*ProofLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+func (m *ProofLatency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProofLatency.Unmarshal(m, b)
+}
+func (m *ProofLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProofLatency.Marshal(b, m, deterministic)
+}
+func (m *ProofLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofLatency.Merge(m, src)
+}
+func (m *ProofLatency) XXX_Size() int {
+	return xxx_messageInfo_ProofLatency.Size(m)
+}
+func (m *ProofLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofLatency proto.InternalMessageInfo
+
+func (m *ProofLatency) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ProofLatency) GetP50Ms() float64 {
+	if m != nil {
+		return m.P50Ms
+	}
+	return 0
+}
+
+func (m *ProofLatency) GetP95Ms() float64 {
+	if m != nil {
+		return m.P95Ms
+	}
+	return 0
+}
+
+func (m *ProofLatency) GetP99Ms() float64 {
+	if m != nil {
+		return m.P99Ms
+	}
+	return 0
+}
+
+func (m *ProofLatency) GetMaxMs() float64 {
+	if m != nil {
+		return m.MaxMs
+	}
+	return 0
+}
+
+type SpaceProofLatency struct {
+	SpaceId              string        `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Dir                  string        `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	Errors               uint32        `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	Latency              *ProofLatency `protobuf:"bytes,4,opt,name=latency,proto3" json:"latency,omitempty"`
+	Corrupted            uint32        `protobuf:"varint,5,opt,name=corrupted,proto3" json:"corrupted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SpaceProofLatency) Reset()         { *m = SpaceProofLatency{} }
+func (m *SpaceProofLatency) String() string { return proto.CompactTextString(m) }
+func (*SpaceProofLatency) ProtoMessage()    {}
+func (*SpaceProofLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+func (m *SpaceProofLatency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpaceProofLatency.Unmarshal(m, b)
+}
+func (m *SpaceProofLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpaceProofLatency.Marshal(b, m, deterministic)
+}
+func (m *SpaceProofLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceProofLatency.Merge(m, src)
+}
+func (m *SpaceProofLatency) XXX_Size() int {
+	return xxx_messageInfo_SpaceProofLatency.Size(m)
+}
+func (m *SpaceProofLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceProofLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpaceProofLatency proto.InternalMessageInfo
+
+func (m *SpaceProofLatency) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *SpaceProofLatency) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *SpaceProofLatency) GetErrors() uint32 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+func (m *SpaceProofLatency) GetLatency() *ProofLatency {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
+}
+
+func (m *SpaceProofLatency) GetCorrupted() uint32 {
+	if m != nil {
+		return m.Corrupted
+	}
+	return 0
+}
+
+type DirProofLatency struct {
+	Dir                  string        `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	Latency              *ProofLatency `protobuf:"bytes,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DirProofLatency) Reset()         { *m = DirProofLatency{} }
+func (m *DirProofLatency) String() string { return proto.CompactTextString(m) }
+func (*DirProofLatency) ProtoMessage()    {}
+func (*DirProofLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+func (m *DirProofLatency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DirProofLatency.Unmarshal(m, b)
+}
+func (m *DirProofLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DirProofLatency.Marshal(b, m, deterministic)
+}
+func (m *DirProofLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirProofLatency.Merge(m, src)
+}
+func (m *DirProofLatency) XXX_Size() int {
+	return xxx_messageInfo_DirProofLatency.Size(m)
+}
+func (m *DirProofLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirProofLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirProofLatency proto.InternalMessageInfo
+
+func (m *DirProofLatency) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *DirProofLatency) GetLatency() *ProofLatency {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
+}
+
+type BenchmarkProofsResponse struct {
+	Challenges           uint32               `protobuf:"varint,1,opt,name=challenges,proto3" json:"challenges,omitempty"`
+	BudgetMs             float64              `protobuf:"fixed64,2,opt,name=budget_ms,json=budgetMs,proto3" json:"budget_ms,omitempty"`
+	GetProofs            *ProofLatency        `protobuf:"bytes,3,opt,name=get_proofs,json=getProofs,proto3" json:"get_proofs,omitempty"`
+	Spaces               []*SpaceProofLatency `protobuf:"bytes,4,rep,name=spaces,proto3" json:"spaces,omitempty"`
+	Dirs                 []*DirProofLatency   `protobuf:"bytes,5,rep,name=dirs,proto3" json:"dirs,omitempty"`
+	Warnings             []string             `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BenchmarkProofsResponse) Reset()         { *m = BenchmarkProofsResponse{} }
+func (m *BenchmarkProofsResponse) String() string { return proto.CompactTextString(m) }
+func (*BenchmarkProofsResponse) ProtoMessage()    {}
+func (*BenchmarkProofsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+func (m *BenchmarkProofsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BenchmarkProofsResponse.Unmarshal(m, b)
+}
+func (m *BenchmarkProofsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BenchmarkProofsResponse.Marshal(b, m, deterministic)
+}
+func (m *BenchmarkProofsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BenchmarkProofsResponse.Merge(m, src)
+}
+func (m *BenchmarkProofsResponse) XXX_Size() int {
+	return xxx_messageInfo_BenchmarkProofsResponse.Size(m)
+}
+func (m *BenchmarkProofsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BenchmarkProofsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BenchmarkProofsResponse proto.InternalMessageInfo
+
+func (m *BenchmarkProofsResponse) GetChallenges() uint32 {
+	if m != nil {
+		return m.Challenges
+	}
+	return 0
+}
+
+func (m *BenchmarkProofsResponse) GetBudgetMs() float64 {
+	if m != nil {
+		return m.BudgetMs
+	}
+	return 0
+}
+
+func (m *BenchmarkProofsResponse) GetGetProofs() *ProofLatency {
+	if m != nil {
+		return m.GetProofs
+	}
+	return nil
+}
+
+func (m *BenchmarkProofsResponse) GetSpaces() []*SpaceProofLatency {
+	if m != nil {
+		return m.Spaces
+	}
+	return nil
+}
+
+func (m *BenchmarkProofsResponse) GetDirs() []*DirProofLatency {
+	if m != nil {
+		return m.Dirs
+	}
+	return nil
+}
+
+func (m *BenchmarkProofsResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type SimulateMiningRequest struct {
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	SpaceIds             []string `protobuf:"bytes,2,rep,name=space_ids,json=spaceIds,proto3" json:"space_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateMiningRequest) Reset()         { *m = SimulateMiningRequest{} }
+func (m *SimulateMiningRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateMiningRequest) ProtoMessage()    {}
+func (*SimulateMiningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+func (m *SimulateMiningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateMiningRequest.Unmarshal(m, b)
+}
+func (m *SimulateMiningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateMiningRequest.Marshal(b, m, deterministic)
+}
+func (m *SimulateMiningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateMiningRequest.Merge(m, src)
+}
+func (m *SimulateMiningRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateMiningRequest.Size(m)
+}
+func (m *SimulateMiningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateMiningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateMiningRequest proto.InternalMessageInfo
+
+func (m *SimulateMiningRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SimulateMiningRequest) GetSpaceIds() []string {
+	if m != nil {
+		return m.SpaceIds
+	}
+	return nil
+}
+
+type SimulatedBlock struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	SpaceId              string   `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Slot                 uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	SlotsAhead           uint64   `protobuf:"varint,4,opt,name=slots_ahead,json=slotsAhead,proto3" json:"slots_ahead,omitempty"`
+	Quality              string   `protobuf:"bytes,5,opt,name=quality,proto3" json:"quality,omitempty"`
+	Target               string   `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulatedBlock) Reset()         { *m = SimulatedBlock{} }
+func (m *SimulatedBlock) String() string { return proto.CompactTextString(m) }
+func (*SimulatedBlock) ProtoMessage()    {}
+func (*SimulatedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+}
+func (m *SimulatedBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulatedBlock.Unmarshal(m, b)
+}
+func (m *SimulatedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulatedBlock.Marshal(b, m, deterministic)
+}
+func (m *SimulatedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedBlock.Merge(m, src)
+}
+func (m *SimulatedBlock) XXX_Size() int {
+	return xxx_messageInfo_SimulatedBlock.Size(m)
+}
+func (m *SimulatedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedBlock proto.InternalMessageInfo
+
+func (m *SimulatedBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *SimulatedBlock) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetSlotsAhead() uint64 {
+	if m != nil {
+		return m.SlotsAhead
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetQuality() string {
+	if m != nil {
+		return m.Quality
+	}
+	return ""
+}
+
+func (m *SimulatedBlock) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type SimulateMiningResponse struct {
+	StartHeight          uint64            `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight            uint64            `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Blocks               uint32            `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Spaces               uint32            `protobuf:"varint,4,opt,name=spaces,proto3" json:"spaces,omitempty"`
+	Won                  []*SimulatedBlock `protobuf:"bytes,5,rep,name=won,proto3" json:"won,omitempty"`
+	WinRate              float64           `protobuf:"fixed64,6,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	ExpectedPerDay       float64           `protobuf:"fixed64,7,opt,name=expected_per_day,json=expectedPerDay,proto3" json:"expected_per_day,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SimulateMiningResponse) Reset()         { *m = SimulateMiningResponse{} }
+func (m *SimulateMiningResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateMiningResponse) ProtoMessage()    {}
+func (*SimulateMiningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+func (m *SimulateMiningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateMiningResponse.Unmarshal(m, b)
+}
+func (m *SimulateMiningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateMiningResponse.Marshal(b, m, deterministic)
+}
+func (m *SimulateMiningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateMiningResponse.Merge(m, src)
+}
+func (m *SimulateMiningResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulateMiningResponse.Size(m)
+}
+func (m *SimulateMiningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateMiningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateMiningResponse proto.InternalMessageInfo
+
+func (m *SimulateMiningResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *SimulateMiningResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *SimulateMiningResponse) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *SimulateMiningResponse) GetSpaces() uint32 {
+	if m != nil {
+		return m.Spaces
+	}
+	return 0
+}
+
+func (m *SimulateMiningResponse) GetWon() []*SimulatedBlock {
+	if m != nil {
+		return m.Won
+	}
+	return nil
+}
+
+func (m *SimulateMiningResponse) GetWinRate() float64 {
+	if m != nil {
+		return m.WinRate
+	}
+	return 0
+}
+
+func (m *SimulateMiningResponse) GetExpectedPerDay() float64 {
+	if m != nil {
+		return m.ExpectedPerDay
+	}
+	return 0
+}
+
+type GetNetworkSpaceRequest struct {
+	Blocks               uint32   `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNetworkSpaceRequest) Reset()         { *m = GetNetworkSpaceRequest{} }
+func (m *GetNetworkSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkSpaceRequest) ProtoMessage()    {}
+func (*GetNetworkSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+func (m *GetNetworkSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetworkSpaceRequest.Unmarshal(m, b)
+}
+func (m *GetNetworkSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetworkSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNetworkSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkSpaceRequest.Merge(m, src)
+}
+func (m *GetNetworkSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNetworkSpaceRequest.Size(m)
+}
+func (m *GetNetworkSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNetworkSpaceRequest proto.InternalMessageInfo
+
+func (m *GetNetworkSpaceRequest) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+type GetNetworkSpaceResponse struct {
+	StartHeight            uint64   `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight              uint64   `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Target                 string   `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	NetworkSpace           float64  `protobuf:"fixed64,4,opt,name=network_space,json=networkSpace,proto3" json:"network_space,omitempty"`
+	NetworkQualitySpace    float64  `protobuf:"fixed64,5,opt,name=network_quality_space,json=networkQualitySpace,proto3" json:"network_quality_space,omitempty"`
+	MiningSpaceCount       uint32   `protobuf:"varint,6,opt,name=mining_space_count,json=miningSpaceCount,proto3" json:"mining_space_count,omitempty"`
+	MiningSpace            uint64   `protobuf:"varint,7,opt,name=mining_space,json=miningSpace,proto3" json:"mining_space,omitempty"`
+	MiningQualitySpace     float64  `protobuf:"fixed64,8,opt,name=mining_quality_space,json=miningQualitySpace,proto3" json:"mining_quality_space,omitempty"`
+	Share                  float64  `protobuf:"fixed64,9,opt,name=share,proto3" json:"share,omitempty"`
+	ExpectedSecondsToBlock float64  `protobuf:"fixed64,10,opt,name=expected_seconds_to_block,json=expectedSecondsToBlock,proto3" json:"expected_seconds_to_block,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *GetNetworkSpaceResponse) Reset()         { *m = GetNetworkSpaceResponse{} }
+func (m *GetNetworkSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkSpaceResponse) ProtoMessage()    {}
+func (*GetNetworkSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+func (m *GetNetworkSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetworkSpaceResponse.Unmarshal(m, b)
+}
+func (m *GetNetworkSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetworkSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNetworkSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkSpaceResponse.Merge(m, src)
+}
+func (m *GetNetworkSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNetworkSpaceResponse.Size(m)
+}
+func (m *GetNetworkSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNetworkSpaceResponse proto.InternalMessageInfo
+
+func (m *GetNetworkSpaceResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetNetworkSpaceResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GetNetworkSpaceResponse) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *GetNetworkSpaceResponse) GetNetworkSpace() float64 {
+	if m != nil {
+		return m.NetworkSpace
+	}
+	return 0
+}
+
+func (m *GetNetworkSpaceResponse) GetNetworkQualitySpace() float64 {
+	if m != nil {
+		return m.NetworkQualitySpace
+	}
+	return 0
+}
+
+func (m *GetNetworkSpaceResponse) GetMiningSpaceCount() uint32 {
+	if m != nil {
+		return m.MiningSpaceCount
+	}
+	return 0
+}
+
+func (m *GetNetworkSpaceResponse) GetMiningSpace() uint64 {
+	if m != nil {
+		return m.MiningSpace
+	}
+	return 0
+}
+
+func (m *GetNetworkSpaceResponse) GetMiningQualitySpace() float64 {
+	if m != nil {
+		return m.MiningQualitySpace
+	}
+	return 0
+}
+
+func (m *GetNetworkSpaceResponse) GetShare() float64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+func (m *GetNetworkSpaceResponse) GetExpectedSecondsToBlock() float64 {
+	if m != nil {
+		return m.ExpectedSecondsToBlock
+	}
+	return 0
+}
+
+type WorkSpaceStateResponse struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Desired              string   `protobuf:"bytes,2,opt,name=desired,proto3" json:"desired,omitempty"`
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	PayoutAddress        string   `protobuf:"bytes,4,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkSpaceStateResponse) Reset()         { *m = WorkSpaceStateResponse{} }
+func (m *WorkSpaceStateResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkSpaceStateResponse) ProtoMessage()    {}
+func (*WorkSpaceStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+}
+func (m *WorkSpaceStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkSpaceStateResponse.Unmarshal(m, b)
+}
+func (m *WorkSpaceStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkSpaceStateResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkSpaceStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkSpaceStateResponse.Merge(m, src)
+}
+func (m *WorkSpaceStateResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkSpaceStateResponse.Size(m)
+}
+func (m *WorkSpaceStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkSpaceStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkSpaceStateResponse proto.InternalMessageInfo
+
+func (m *WorkSpaceStateResponse) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *WorkSpaceStateResponse) GetDesired() string {
+	if m != nil {
+		return m.Desired
+	}
+	return ""
+}
+
+func (m *WorkSpaceStateResponse) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *WorkSpaceStateResponse) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+type UpdateWorkSpaceStateRequest struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Tags                 []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	PayoutAddress        string   `protobuf:"bytes,3,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateWorkSpaceStateRequest) Reset()         { *m = UpdateWorkSpaceStateRequest{} }
+func (m *UpdateWorkSpaceStateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateWorkSpaceStateRequest) ProtoMessage()    {}
+func (*UpdateWorkSpaceStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+}
+func (m *UpdateWorkSpaceStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateWorkSpaceStateRequest.Unmarshal(m, b)
+}
+func (m *UpdateWorkSpaceStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateWorkSpaceStateRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateWorkSpaceStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkSpaceStateRequest.Merge(m, src)
+}
+func (m *UpdateWorkSpaceStateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateWorkSpaceStateRequest.Size(m)
+}
+func (m *UpdateWorkSpaceStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkSpaceStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkSpaceStateRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkSpaceStateRequest) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *UpdateWorkSpaceStateRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *UpdateWorkSpaceStateRequest) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+type GetClientStatusResponse struct {
+	Version                string                                   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	PeerListening          bool                                     `protobuf:"varint,2,opt,name=peer_listening,json=peerListening,proto3" json:"peer_listening,omitempty"`
+	Syncing                bool                                     `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	Mining                 bool                                     `protobuf:"varint,4,opt,name=mining,proto3" json:"mining,omitempty"`
+	SpaceKeeping           bool                                     `protobuf:"varint,5,opt,name=space_keeping,json=spaceKeeping,proto3" json:"space_keeping,omitempty"`
+	ChainId                string                                   `protobuf:"bytes,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	LocalBestHeight        uint64                                   `protobuf:"varint,7,opt,name=local_best_height,json=localBestHeight,proto3" json:"local_best_height,omitempty"`
+	KnownBestHeight        uint64                                   `protobuf:"varint,8,opt,name=known_best_height,json=knownBestHeight,proto3" json:"known_best_height,omitempty"`
+	P2PId                  string                                   `protobuf:"bytes,9,opt,name=p2p_id,json=p2pId,proto3" json:"p2p_id,omitempty"`
+	PeerCount              *GetClientStatusResponsePeerCountInfo    `protobuf:"bytes,10,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	Peers                  *GetClientStatusResponsePeerList         `protobuf:"bytes,11,opt,name=peers,proto3" json:"peers,omitempty"`
+	NetworkSpace           float64                                  `protobuf:"fixed64,12,opt,name=network_space,json=networkSpace,proto3" json:"network_space,omitempty"`
+	SpaceShare             float64                                  `protobuf:"fixed64,13,opt,name=space_share,json=spaceShare,proto3" json:"space_share,omitempty"`
+	ExpectedSecondsToBlock float64                                  `protobuf:"fixed64,14,opt,name=expected_seconds_to_block,json=expectedSecondsToBlock,proto3" json:"expected_seconds_to_block,omitempty"`
+	ScriptValidation       *GetClientStatusResponseScriptValidation `protobuf:"bytes,15,opt,name=script_validation,json=scriptValidation,proto3" json:"script_validation,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                                 `json:"-"`
+	XXX_unrecognized       []byte                                   `json:"-"`
+	XXX_sizecache          int32                                    `json:"-"`
+}
+
+func (m *GetClientStatusResponse) Reset()         { *m = GetClientStatusResponse{} }
+func (m *GetClientStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponse) ProtoMessage()    {}
+func (*GetClientStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+}
+func (m *GetClientStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponse.Unmarshal(m, b)
+}
+func (m *GetClientStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetClientStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponse.Merge(m, src)
+}
+func (m *GetClientStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponse.Size(m)
+}
+func (m *GetClientStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClientStatusResponse proto.InternalMessageInfo
+
+func (m *GetClientStatusResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetClientStatusResponse) GetPeerListening() bool {
+	if m != nil {
+		return m.PeerListening
+	}
+	return false
+}
+
+func (m *GetClientStatusResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *GetClientStatusResponse) GetMining() bool {
+	if m != nil {
+		return m.Mining
+	}
+	return false
+}
+
+func (m *GetClientStatusResponse) GetSpaceKeeping() bool {
+	if m != nil {
+		return m.SpaceKeeping
+	}
+	return false
+}
+
+func (m *GetClientStatusResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GetClientStatusResponse) GetLocalBestHeight() uint64 {
+	if m != nil {
+		return m.LocalBestHeight
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponse) GetKnownBestHeight() uint64 {
+	if m != nil {
+		return m.KnownBestHeight
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponse) GetP2PId() string {
+	if m != nil {
+		return m.P2PId
+	}
+	return ""
+}
+
+func (m *GetClientStatusResponse) GetPeerCount() *GetClientStatusResponsePeerCountInfo {
+	if m != nil {
+		return m.PeerCount
+	}
+	return nil
+}
+
+func (m *GetClientStatusResponse) GetPeers() *GetClientStatusResponsePeerList {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *GetClientStatusResponse) GetNetworkSpace() float64 {
+	if m != nil {
+		return m.NetworkSpace
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponse) GetSpaceShare() float64 {
+	if m != nil {
+		return m.SpaceShare
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponse) GetExpectedSecondsToBlock() float64 {
+	if m != nil {
+		return m.ExpectedSecondsToBlock
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponse) GetScriptValidation() *GetClientStatusResponseScriptValidation {
+	if m != nil {
+		return m.ScriptValidation
+	}
+	return nil
+}
+
+type GetClientStatusResponsePeerCountInfo struct {
+	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Outbound             uint32   `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Inbound              uint32   `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClientStatusResponsePeerCountInfo) Reset()         { *m = GetClientStatusResponsePeerCountInfo{} }
+func (m *GetClientStatusResponsePeerCountInfo) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponsePeerCountInfo) ProtoMessage()    {}
+func (*GetClientStatusResponsePeerCountInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56, 0}
+}
+func (m *GetClientStatusResponsePeerCountInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Unmarshal(m, b)
+}
+func (m *GetClientStatusResponsePeerCountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Marshal(b, m, deterministic)
+}
+func (m *GetClientStatusResponsePeerCountInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Merge(m, src)
+}
+func (m *GetClientStatusResponsePeerCountInfo) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Size(m)
+}
+func (m *GetClientStatusResponsePeerCountInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponsePeerCountInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClientStatusResponsePeerCountInfo proto.InternalMessageInfo
+
+func (m *GetClientStatusResponsePeerCountInfo) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponsePeerCountInfo) GetOutbound() uint32 {
+	if m != nil {
+		return m.Outbound
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponsePeerCountInfo) GetInbound() uint32 {
+	if m != nil {
+		return m.Inbound
+	}
+	return 0
+}
+
+type GetClientStatusResponsePeerInfo struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Direction            string   `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClientStatusResponsePeerInfo) Reset()         { *m = GetClientStatusResponsePeerInfo{} }
+func (m *GetClientStatusResponsePeerInfo) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponsePeerInfo) ProtoMessage()    {}
+func (*GetClientStatusResponsePeerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56, 1}
+}
+func (m *GetClientStatusResponsePeerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Unmarshal(m, b)
+}
+func (m *GetClientStatusResponsePeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Marshal(b, m, deterministic)
+}
+func (m *GetClientStatusResponsePeerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponsePeerInfo.Merge(m, src)
+}
+func (m *GetClientStatusResponsePeerInfo) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Size(m)
+}
+func (m *GetClientStatusResponsePeerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponsePeerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClientStatusResponsePeerInfo proto.InternalMessageInfo
+
+func (m *GetClientStatusResponsePeerInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetClientStatusResponsePeerInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetClientStatusResponsePeerInfo) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+type GetClientStatusResponsePeerList struct {
+	Outbound             []*GetClientStatusResponsePeerInfo `protobuf:"bytes,1,rep,name=outbound,proto3" json:"outbound,omitempty"`
+	Inbound              []*GetClientStatusResponsePeerInfo `protobuf:"bytes,2,rep,name=inbound,proto3" json:"inbound,omitempty"`
+	Other                []*GetClientStatusResponsePeerInfo `protobuf:"bytes,3,rep,name=other,proto3" json:"other,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *GetClientStatusResponsePeerList) Reset()         { *m = GetClientStatusResponsePeerList{} }
+func (m *GetClientStatusResponsePeerList) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponsePeerList) ProtoMessage()    {}
+func (*GetClientStatusResponsePeerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56, 2}
+}
+func (m *GetClientStatusResponsePeerList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponsePeerList.Unmarshal(m, b)
+}
+func (m *GetClientStatusResponsePeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponsePeerList.Marshal(b, m, deterministic)
+}
+func (m *GetClientStatusResponsePeerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponsePeerList.Merge(m, src)
+}
+func (m *GetClientStatusResponsePeerList) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponsePeerList.Size(m)
+}
+func (m *GetClientStatusResponsePeerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponsePeerList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClientStatusResponsePeerList proto.InternalMessageInfo
+
+func (m *GetClientStatusResponsePeerList) GetOutbound() []*GetClientStatusResponsePeerInfo {
+	if m != nil {
+		return m.Outbound
+	}
+	return nil
+}
+
+func (m *GetClientStatusResponsePeerList) GetInbound() []*GetClientStatusResponsePeerInfo {
+	if m != nil {
+		return m.Inbound
+	}
+	return nil
+}
+
+func (m *GetClientStatusResponsePeerList) GetOther() []*GetClientStatusResponsePeerInfo {
+	if m != nil {
+		return m.Other
+	}
+	return nil
+}
+
+type GetClientStatusResponseScriptValidation struct {
+	Workers              uint32   `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	ValidatedInputs      uint64   `protobuf:"varint,2,opt,name=validated_inputs,json=validatedInputs,proto3" json:"validated_inputs,omitempty"`
+	PrevalidatedInputs   uint64   `protobuf:"varint,3,opt,name=prevalidated_inputs,json=prevalidatedInputs,proto3" json:"prevalidated_inputs,omitempty"`
+	SigCacheEntries      uint64   `protobuf:"varint,4,opt,name=sig_cache_entries,json=sigCacheEntries,proto3" json:"sig_cache_entries,omitempty"`
+	SigCacheMaxEntries   uint64   `protobuf:"varint,5,opt,name=sig_cache_max_entries,json=sigCacheMaxEntries,proto3" json:"sig_cache_max_entries,omitempty"`
+	SigCacheHits         uint64   `protobuf:"varint,6,opt,name=sig_cache_hits,json=sigCacheHits,proto3" json:"sig_cache_hits,omitempty"`
+	SigCacheMisses       uint64   `protobuf:"varint,7,opt,name=sig_cache_misses,json=sigCacheMisses,proto3" json:"sig_cache_misses,omitempty"`
+	SigCacheHitRate      float64  `protobuf:"fixed64,8,opt,name=sig_cache_hit_rate,json=sigCacheHitRate,proto3" json:"sig_cache_hit_rate,omitempty"`
+	AssumeValidHeight    uint64   `protobuf:"varint,9,opt,name=assume_valid_height,json=assumeValidHeight,proto3" json:"assume_valid_height,omitempty"`
+	AssumeValidHash      string   `protobuf:"bytes,10,opt,name=assume_valid_hash,json=assumeValidHash,proto3" json:"assume_valid_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClientStatusResponseScriptValidation) Reset() {
+	*m = GetClientStatusResponseScriptValidation{}
+}
+func (m *GetClientStatusResponseScriptValidation) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponseScriptValidation) ProtoMessage()    {}
+func (*GetClientStatusResponseScriptValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56, 3}
+}
+func (m *GetClientStatusResponseScriptValidation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponseScriptValidation.Unmarshal(m, b)
+}
+func (m *GetClientStatusResponseScriptValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponseScriptValidation.Marshal(b, m, deterministic)
+}
+func (m *GetClientStatusResponseScriptValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponseScriptValidation.Merge(m, src)
+}
+func (m *GetClientStatusResponseScriptValidation) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponseScriptValidation.Size(m)
+}
+func (m *GetClientStatusResponseScriptValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponseScriptValidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClientStatusResponseScriptValidation proto.InternalMessageInfo
+
+func (m *GetClientStatusResponseScriptValidation) GetWorkers() uint32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetValidatedInputs() uint64 {
+	if m != nil {
+		return m.ValidatedInputs
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetPrevalidatedInputs() uint64 {
+	if m != nil {
+		return m.PrevalidatedInputs
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetSigCacheEntries() uint64 {
+	if m != nil {
+		return m.SigCacheEntries
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetSigCacheMaxEntries() uint64 {
+	if m != nil {
+		return m.SigCacheMaxEntries
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetSigCacheHits() uint64 {
+	if m != nil {
+		return m.SigCacheHits
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetSigCacheMisses() uint64 {
+	if m != nil {
+		return m.SigCacheMisses
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetSigCacheHitRate() float64 {
+	if m != nil {
+		return m.SigCacheHitRate
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetAssumeValidHeight() uint64 {
+	if m != nil {
+		return m.AssumeValidHeight
+	}
+	return 0
+}
+
+func (m *GetClientStatusResponseScriptValidation) GetAssumeValidHash() string {
+	if m != nil {
+		return m.AssumeValidHash
+	}
+	return ""
+}
+
+type QuitClientResponse struct {
+	ErrorCode            uint32   `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuitClientResponse) Reset()         { *m = QuitClientResponse{} }
+func (m *QuitClientResponse) String() string { return proto.CompactTextString(m) }
+func (*QuitClientResponse) ProtoMessage()    {}
+func (*QuitClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+func (m *QuitClientResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuitClientResponse.Unmarshal(m, b)
+}
+func (m *QuitClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuitClientResponse.Marshal(b, m, deterministic)
+}
+func (m *QuitClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuitClientResponse.Merge(m, src)
+}
+func (m *QuitClientResponse) XXX_Size() int {
+	return xxx_messageInfo_QuitClientResponse.Size(m)
+}
+func (m *QuitClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuitClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuitClientResponse proto.InternalMessageInfo
+
+func (m *QuitClientResponse) GetErrorCode() uint32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
+func (m *QuitClientResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type GenerateBlocksRequest struct {
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	PayoutAddress        string   `protobuf:"bytes,2,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateBlocksRequest) Reset()         { *m = GenerateBlocksRequest{} }
+func (m *GenerateBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateBlocksRequest) ProtoMessage()    {}
+func (*GenerateBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+func (m *GenerateBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateBlocksRequest.Unmarshal(m, b)
+}
+func (m *GenerateBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateBlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *GenerateBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateBlocksRequest.Merge(m, src)
+}
+func (m *GenerateBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateBlocksRequest.Size(m)
+}
+func (m *GenerateBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateBlocksRequest proto.InternalMessageInfo
+
+func (m *GenerateBlocksRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GenerateBlocksRequest) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+type GenerateBlocksResponse struct {
+	Hashes               []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateBlocksResponse) Reset()         { *m = GenerateBlocksResponse{} }
+func (m *GenerateBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateBlocksResponse) ProtoMessage()    {}
+func (*GenerateBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+}
+func (m *GenerateBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateBlocksResponse.Unmarshal(m, b)
+}
+func (m *GenerateBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *GenerateBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateBlocksResponse.Merge(m, src)
+}
+func (m *GenerateBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateBlocksResponse.Size(m)
+}
+func (m *GenerateBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateBlocksResponse proto.InternalMessageInfo
+
+func (m *GenerateBlocksResponse) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type GetBlockTemplateRequest struct {
+	PayoutAddress        string   `protobuf:"bytes,1,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockTemplateRequest) Reset()         { *m = GetBlockTemplateRequest{} }
+func (m *GetBlockTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockTemplateRequest) ProtoMessage()    {}
+func (*GetBlockTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+func (m *GetBlockTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockTemplateRequest.Unmarshal(m, b)
+}
+func (m *GetBlockTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockTemplateRequest.Merge(m, src)
+}
+func (m *GetBlockTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockTemplateRequest.Size(m)
+}
+func (m *GetBlockTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockTemplateRequest proto.InternalMessageInfo
+
+func (m *GetBlockTemplateRequest) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+type BlockTemplatePolicy struct {
+	Strategy             string   `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	BlockMinSize         uint32   `protobuf:"varint,2,opt,name=block_min_size,json=blockMinSize,proto3" json:"block_min_size,omitempty"`
+	BlockMaxSize         uint32   `protobuf:"varint,3,opt,name=block_max_size,json=blockMaxSize,proto3" json:"block_max_size,omitempty"`
+	BlockPrioritySize    uint32   `protobuf:"varint,4,opt,name=block_priority_size,json=blockPrioritySize,proto3" json:"block_priority_size,omitempty"`
+	AvailableStrategies  []string `protobuf:"bytes,5,rep,name=available_strategies,json=availableStrategies,proto3" json:"available_strategies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTemplatePolicy) Reset()         { *m = BlockTemplatePolicy{} }
+func (m *BlockTemplatePolicy) String() string { return proto.CompactTextString(m) }
+func (*BlockTemplatePolicy) ProtoMessage()    {}
+func (*BlockTemplatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+func (m *BlockTemplatePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTemplatePolicy.Unmarshal(m, b)
+}
+func (m *BlockTemplatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTemplatePolicy.Marshal(b, m, deterministic)
+}
+func (m *BlockTemplatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTemplatePolicy.Merge(m, src)
+}
+func (m *BlockTemplatePolicy) XXX_Size() int {
+	return xxx_messageInfo_BlockTemplatePolicy.Size(m)
+}
+func (m *BlockTemplatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTemplatePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTemplatePolicy proto.InternalMessageInfo
+
+func (m *BlockTemplatePolicy) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *BlockTemplatePolicy) GetBlockMinSize() uint32 {
+	if m != nil {
+		return m.BlockMinSize
+	}
+	return 0
+}
+
+func (m *BlockTemplatePolicy) GetBlockMaxSize() uint32 {
+	if m != nil {
+		return m.BlockMaxSize
+	}
+	return 0
+}
+
+func (m *BlockTemplatePolicy) GetBlockPrioritySize() uint32 {
+	if m != nil {
+		return m.BlockPrioritySize
+	}
+	return 0
+}
+
+func (m *BlockTemplatePolicy) GetAvailableStrategies() []string {
+	if m != nil {
+		return m.AvailableStrategies
+	}
+	return nil
+}
+
+type BlockTemplateTx struct {
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Fee                  string   `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeDelta             int64    `protobuf:"varint,3,opt,name=fee_delta,json=feeDelta,proto3" json:"fee_delta,omitempty"`
+	Size                uint32   `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Sigops               int64    `protobuf:"varint,5,opt,name=sigops,proto3" json:"sigops,omitempty"`
+	Depends              []string `protobuf:"bytes,6,rep,name=depends,proto3" json:"depends,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTemplateTx) Reset()         { *m = BlockTemplateTx{} }
+func (m *BlockTemplateTx) String() string { return proto.CompactTextString(m) }
+func (*BlockTemplateTx) ProtoMessage()    {}
+func (*BlockTemplateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+func (m *BlockTemplateTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTemplateTx.Unmarshal(m, b)
+}
+func (m *BlockTemplateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTemplateTx.Marshal(b, m, deterministic)
+}
+func (m *BlockTemplateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTemplateTx.Merge(m, src)
+}
+func (m *BlockTemplateTx) XXX_Size() int {
+	return xxx_messageInfo_BlockTemplateTx.Size(m)
+}
+func (m *BlockTemplateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTemplateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTemplateTx proto.InternalMessageInfo
+
+func (m *BlockTemplateTx) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *BlockTemplateTx) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *BlockTemplateTx) GetFeeDelta() int64 {
+	if m != nil {
+		return m.FeeDelta
+	}
+	return 0
+}
+
+func (m *BlockTemplateTx) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *BlockTemplateTx) GetSigops() int64 {
+	if m != nil {
+		return m.Sigops
+	}
+	return 0
+}
+
+func (m *BlockTemplateTx) GetDepends() []string {
+	if m != nil {
+		return m.Depends
+	}
+	return nil
+}
+
+type GetBlockTemplateResponse struct {
+	Height               uint64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ChainId              string               `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Version              uint64               `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	PreviousHash         string               `protobuf:"bytes,4,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+	Time                 int64                `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	Target               string               `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	Challenge            string               `protobuf:"bytes,7,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	TransactionRoot      string               `protobuf:"bytes,8,opt,name=transaction_root,json=transactionRoot,proto3" json:"transaction_root,omitempty"`
+	WitnessRoot          string               `protobuf:"bytes,9,opt,name=witness_root,json=witnessRoot,proto3" json:"witness_root,omitempty"`
+	ProposalRoot         string               `protobuf:"bytes,10,opt,name=proposal_root,json=proposalRoot,proto3" json:"proposal_root,omitempty"`
+	TotalFee             string               `protobuf:"bytes,11,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	Size                uint32               `protobuf:"varint,12,opt,name=size,proto3" json:"size,omitempty"`
+	TxCount              uint32               `protobuf:"varint,13,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	Txs                  []*BlockTemplateTx   `protobuf:"bytes,14,rep,name=txs,proto3" json:"txs,omitempty"`
+	Policy               *BlockTemplatePolicy `protobuf:"bytes,15,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetBlockTemplateResponse) Reset()         { *m = GetBlockTemplateResponse{} }
+func (m *GetBlockTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockTemplateResponse) ProtoMessage()    {}
+func (*GetBlockTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+func (m *GetBlockTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockTemplateResponse.Unmarshal(m, b)
+}
+func (m *GetBlockTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBlockTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockTemplateResponse.Merge(m, src)
+}
+func (m *GetBlockTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockTemplateResponse.Size(m)
+}
+func (m *GetBlockTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockTemplateResponse proto.InternalMessageInfo
+
+func (m *GetBlockTemplateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockTemplateResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GetBlockTemplateResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetBlockTemplateResponse) GetPreviousHash() string {
+	if m != nil {
+		return m.PreviousHash
+	}
+	return ""
+}
+
+func (m *GetBlockTemplateResponse) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *GetBlockTemplateResponse) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *GetBlockTemplateResponse) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *GetBlockTemplateResponse) GetTransactionRoot() string {
+	if m != nil {
+		return m.TransactionRoot
+	}
+	return ""
+}
+
+func (m *GetBlockTemplateResponse) GetWitnessRoot() string {
+	if m != nil {
+		return m.WitnessRoot
+	}
+	return ""
+}
+
+func (m *GetBlockTemplateResponse) GetProposalRoot() string {
+	if m != nil {
+		return m.ProposalRoot
+	}
+	return ""
+}
+
+func (m *GetBlockTemplateResponse) GetTotalFee() string {
+	if m != nil {
+		return m.TotalFee
+	}
+	return ""
+}
+
+func (m *GetBlockTemplateResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetBlockTemplateResponse) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *GetBlockTemplateResponse) GetTxs() []*BlockTemplateTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *GetBlockTemplateResponse) GetPolicy() *BlockTemplatePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type SetBlockTemplatePolicyRequest struct {
+	Strategy             string   `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBlockTemplatePolicyRequest) Reset()         { *m = SetBlockTemplatePolicyRequest{} }
+func (m *SetBlockTemplatePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBlockTemplatePolicyRequest) ProtoMessage()    {}
+func (*SetBlockTemplatePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+func (m *SetBlockTemplatePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBlockTemplatePolicyRequest.Unmarshal(m, b)
+}
+func (m *SetBlockTemplatePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBlockTemplatePolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBlockTemplatePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBlockTemplatePolicyRequest.Merge(m, src)
+}
+func (m *SetBlockTemplatePolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBlockTemplatePolicyRequest.Size(m)
+}
+func (m *SetBlockTemplatePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBlockTemplatePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBlockTemplatePolicyRequest proto.InternalMessageInfo
+
+func (m *SetBlockTemplatePolicyRequest) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+type PrioritiseTransactionRequest struct {
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	FeeDelta             int64    `protobuf:"varint,2,opt,name=fee_delta,json=feeDelta,proto3" json:"fee_delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrioritiseTransactionRequest) Reset()         { *m = PrioritiseTransactionRequest{} }
+func (m *PrioritiseTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*PrioritiseTransactionRequest) ProtoMessage()    {}
+func (*PrioritiseTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+func (m *PrioritiseTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrioritiseTransactionRequest.Unmarshal(m, b)
+}
+func (m *PrioritiseTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrioritiseTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *PrioritiseTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrioritiseTransactionRequest.Merge(m, src)
+}
+func (m *PrioritiseTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_PrioritiseTransactionRequest.Size(m)
+}
+func (m *PrioritiseTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrioritiseTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrioritiseTransactionRequest proto.InternalMessageInfo
+
+func (m *PrioritiseTransactionRequest) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *PrioritiseTransactionRequest) GetFeeDelta() int64 {
+	if m != nil {
+		return m.FeeDelta
+	}
+	return 0
+}
+
+type PrioritiseTransactionResponse struct {
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	FeeDelta             int64    `protobuf:"varint,2,opt,name=fee_delta,json=feeDelta,proto3" json:"fee_delta,omitempty"`
+	InPool               bool     `protobuf:"varint,3,opt,name=in_pool,json=inPool,proto3" json:"in_pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrioritiseTransactionResponse) Reset()         { *m = PrioritiseTransactionResponse{} }
+func (m *PrioritiseTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*PrioritiseTransactionResponse) ProtoMessage()    {}
+func (*PrioritiseTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+func (m *PrioritiseTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrioritiseTransactionResponse.Unmarshal(m, b)
+}
+func (m *PrioritiseTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrioritiseTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *PrioritiseTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrioritiseTransactionResponse.Merge(m, src)
+}
+func (m *PrioritiseTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_PrioritiseTransactionResponse.Size(m)
+}
+func (m *PrioritiseTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrioritiseTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrioritiseTransactionResponse proto.InternalMessageInfo
+
+func (m *PrioritiseTransactionResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *PrioritiseTransactionResponse) GetFeeDelta() int64 {
+	if m != nil {
+		return m.FeeDelta
+	}
+	return 0
+}
+
+func (m *PrioritiseTransactionResponse) GetInPool() bool {
+	if m != nil {
+		return m.InPool
+	}
+	return false
+}
+
+type SaveMempoolResponse struct {
+	TxCount              uint32   `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	OrphanCount          uint32   `protobuf:"varint,2,opt,name=orphan_count,json=orphanCount,proto3" json:"orphan_count,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SaveMempoolResponse) Reset()         { *m = SaveMempoolResponse{} }
+func (m *SaveMempoolResponse) String() string { return proto.CompactTextString(m) }
+func (*SaveMempoolResponse) ProtoMessage()    {}
+func (*SaveMempoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+func (m *SaveMempoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveMempoolResponse.Unmarshal(m, b)
+}
+func (m *SaveMempoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveMempoolResponse.Marshal(b, m, deterministic)
+}
+func (m *SaveMempoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveMempoolResponse.Merge(m, src)
+}
+func (m *SaveMempoolResponse) XXX_Size() int {
+	return xxx_messageInfo_SaveMempoolResponse.Size(m)
+}
+func (m *SaveMempoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveMempoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveMempoolResponse proto.InternalMessageInfo
+
+func (m *SaveMempoolResponse) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *SaveMempoolResponse) GetOrphanCount() uint32 {
+	if m != nil {
+		return m.OrphanCount
+	}
+	return 0
+}
+
+func (m *SaveMempoolResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type GetTxOutSetInfoResponse struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BestBlock            string   `protobuf:"bytes,2,opt,name=best_block,json=bestBlock,proto3" json:"best_block,omitempty"`
+	Transactions         uint64   `protobuf:"varint,3,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	Txouts               uint64   `protobuf:"varint,4,opt,name=txouts,proto3" json:"txouts,omitempty"`
+	TotalAmount          string   `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	StakingAmount        string   `protobuf:"bytes,6,opt,name=staking_amount,json=stakingAmount,proto3" json:"staking_amount,omitempty"`
+	BindingAmount        string   `protobuf:"bytes,7,opt,name=binding_amount,json=bindingAmount,proto3" json:"binding_amount,omitempty"`
+	SerializedSize       uint64   `protobuf:"varint,8,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	HashSerialized       string   `protobuf:"bytes,9,opt,name=hash_serialized,json=hashSerialized,proto3" json:"hash_serialized,omitempty"`
+	Path                 string   `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTxOutSetInfoResponse) Reset()         { *m = GetTxOutSetInfoResponse{} }
+func (m *GetTxOutSetInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxOutSetInfoResponse) ProtoMessage()    {}
+func (*GetTxOutSetInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+func (m *GetTxOutSetInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxOutSetInfoResponse.Unmarshal(m, b)
+}
+func (m *GetTxOutSetInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxOutSetInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTxOutSetInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxOutSetInfoResponse.Merge(m, src)
+}
+func (m *GetTxOutSetInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTxOutSetInfoResponse.Size(m)
+}
+func (m *GetTxOutSetInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxOutSetInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxOutSetInfoResponse proto.InternalMessageInfo
+
+func (m *GetTxOutSetInfoResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetTxOutSetInfoResponse) GetBestBlock() string {
+	if m != nil {
+		return m.BestBlock
+	}
+	return ""
+}
+
+func (m *GetTxOutSetInfoResponse) GetTransactions() uint64 {
+	if m != nil {
+		return m.Transactions
+	}
+	return 0
+}
+
+func (m *GetTxOutSetInfoResponse) GetTxouts() uint64 {
+	if m != nil {
+		return m.Txouts
+	}
+	return 0
+}
+
+func (m *GetTxOutSetInfoResponse) GetTotalAmount() string {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return ""
+}
+
+func (m *GetTxOutSetInfoResponse) GetStakingAmount() string {
+	if m != nil {
+		return m.StakingAmount
+	}
+	return ""
+}
+
+func (m *GetTxOutSetInfoResponse) GetBindingAmount() string {
+	if m != nil {
+		return m.BindingAmount
+	}
+	return ""
+}
+
+func (m *GetTxOutSetInfoResponse) GetSerializedSize() uint64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
+func (m *GetTxOutSetInfoResponse) GetHashSerialized() string {
+	if m != nil {
+		return m.HashSerialized
+	}
+	return ""
+}
+
+func (m *GetTxOutSetInfoResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type GetBlockHeightByPubKeyRequest struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3184,7 +5300,7 @@ func (m *GetBlockHeightByPubKeyRequest) Reset()         { *m = GetBlockHeightByP
 func (m *GetBlockHeightByPubKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeightByPubKeyRequest) ProtoMessage()    {}
 func (*GetBlockHeightByPubKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
 }
 func (m *GetBlockHeightByPubKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockHeightByPubKeyRequest.Unmarshal(m, b)
@@ -3222,7 +5338,7 @@ func (m *GetBlockHeightByPubKeyResponse) Reset()         { *m = GetBlockHeightBy
 func (m *GetBlockHeightByPubKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeightByPubKeyResponse) ProtoMessage()    {}
 func (*GetBlockHeightByPubKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
 }
 func (m *GetBlockHeightByPubKeyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockHeightByPubKeyResponse.Unmarshal(m, b)
@@ -3260,7 +5376,7 @@ func (m *GetCoinbaseRequest) Reset()         { *m = GetCoinbaseRequest{} }
 func (m *GetCoinbaseRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCoinbaseRequest) ProtoMessage()    {}
 func (*GetCoinbaseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
 }
 func (m *GetCoinbaseRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCoinbaseRequest.Unmarshal(m, b)
@@ -3301,7 +5417,7 @@ func (m *CoinbaseVout) Reset()         { *m = CoinbaseVout{} }
 func (m *CoinbaseVout) String() string { return proto.CompactTextString(m) }
 func (*CoinbaseVout) ProtoMessage()    {}
 func (*CoinbaseVout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
 }
 func (m *CoinbaseVout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CoinbaseVout.Unmarshal(m, b)
@@ -3371,7 +5487,7 @@ func (m *GetCoinbaseResponse) Reset()         { *m = GetCoinbaseResponse{} }
 func (m *GetCoinbaseResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCoinbaseResponse) ProtoMessage()    {}
 func (*GetCoinbaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
 }
 func (m *GetCoinbaseResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCoinbaseResponse.Unmarshal(m, b)
@@ -3488,7 +5604,7 @@ func (m *ExportKeystoreRequest) Reset()         { *m = ExportKeystoreRequest{} }
 func (m *ExportKeystoreRequest) String() string { return proto.CompactTextString(m) }
 func (*ExportKeystoreRequest) ProtoMessage()    {}
 func (*ExportKeystoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
 }
 func (m *ExportKeystoreRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExportKeystoreRequest.Unmarshal(m, b)
@@ -3540,7 +5656,7 @@ func (m *ExportKeystoreResponse) Reset()         { *m = ExportKeystoreResponse{}
 func (m *ExportKeystoreResponse) String() string { return proto.CompactTextString(m) }
 func (*ExportKeystoreResponse) ProtoMessage()    {}
 func (*ExportKeystoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
 }
 func (m *ExportKeystoreResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExportKeystoreResponse.Unmarshal(m, b)
@@ -3580,7 +5696,7 @@ func (m *ImportKeystoreRequest) Reset()         { *m = ImportKeystoreRequest{} }
 func (m *ImportKeystoreRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportKeystoreRequest) ProtoMessage()    {}
 func (*ImportKeystoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
 }
 func (m *ImportKeystoreRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportKeystoreRequest.Unmarshal(m, b)
@@ -3634,7 +5750,7 @@ func (m *ImportKeystoreResponse) Reset()         { *m = ImportKeystoreResponse{}
 func (m *ImportKeystoreResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportKeystoreResponse) ProtoMessage()    {}
 func (*ImportKeystoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
 }
 func (m *ImportKeystoreResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportKeystoreResponse.Unmarshal(m, b)
@@ -3687,7 +5803,7 @@ func (m *WalletSummary) Reset()         { *m = WalletSummary{} }
 func (m *WalletSummary) String() string { return proto.CompactTextString(m) }
 func (*WalletSummary) ProtoMessage()    {}
 func (*WalletSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
 }
 func (m *WalletSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletSummary.Unmarshal(m, b)
@@ -3732,7 +5848,7 @@ func (m *GetKeystoreResponse) Reset()         { *m = GetKeystoreResponse{} }
 func (m *GetKeystoreResponse) String() string { return proto.CompactTextString(m) }
 func (*GetKeystoreResponse) ProtoMessage()    {}
 func (*GetKeystoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
 }
 func (m *GetKeystoreResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetKeystoreResponse.Unmarshal(m, b)
@@ -3770,7 +5886,7 @@ func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
 func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletRequest) ProtoMessage()    {}
 func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
 }
 func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
@@ -3809,7 +5925,7 @@ func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
 func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletResponse) ProtoMessage()    {}
 func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
 }
 func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
@@ -3855,7 +5971,7 @@ func (m *LockWalletResponse) Reset()         { *m = LockWalletResponse{} }
 func (m *LockWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*LockWalletResponse) ProtoMessage()    {}
 func (*LockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
 }
 func (m *LockWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LockWalletResponse.Unmarshal(m, b)
@@ -3901,7 +6017,7 @@ func (m *ChangePrivatePassRequest) Reset()         { *m = ChangePrivatePassReque
 func (m *ChangePrivatePassRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePrivatePassRequest) ProtoMessage()    {}
 func (*ChangePrivatePassRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
 }
 func (m *ChangePrivatePassRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePrivatePassRequest.Unmarshal(m, b)
@@ -3946,7 +6062,7 @@ func (m *ChangePrivatePassResponse) Reset()         { *m = ChangePrivatePassResp
 func (m *ChangePrivatePassResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePrivatePassResponse) ProtoMessage()    {}
 func (*ChangePrivatePassResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
 }
 func (m *ChangePrivatePassResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePrivatePassResponse.Unmarshal(m, b)
@@ -3985,7 +6101,7 @@ func (m *ChangePublicPassRequest) Reset()         { *m = ChangePublicPassRequest
 func (m *ChangePublicPassRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePublicPassRequest) ProtoMessage()    {}
 func (*ChangePublicPassRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
 }
 func (m *ChangePublicPassRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePublicPassRequest.Unmarshal(m, b)
@@ -4030,7 +6146,7 @@ func (m *ChangePublicPassResponse) Reset()         { *m = ChangePublicPassRespon
 func (m *ChangePublicPassResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePublicPassResponse) ProtoMessage()    {}
 func (*ChangePublicPassResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
 }
 func (m *ChangePublicPassResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePublicPassResponse.Unmarshal(m, b)
@@ -4095,11 +6211,42 @@ func init() {
 	proto.RegisterType((*WorkSpaceResponse)(nil), "rpcprotobuf.WorkSpaceResponse")
 	proto.RegisterType((*WorkSpacesResponse)(nil), "rpcprotobuf.WorkSpacesResponse")
 	proto.RegisterType((*ActOnSpaceKeeperResponse)(nil), "rpcprotobuf.ActOnSpaceKeeperResponse")
+	proto.RegisterType((*PlotQueueItem)(nil), "rpcprotobuf.PlotQueueItem")
+	proto.RegisterType((*PlotQueueResponse)(nil), "rpcprotobuf.PlotQueueResponse")
+	proto.RegisterType((*UpdatePlotQueueRequest)(nil), "rpcprotobuf.UpdatePlotQueueRequest")
+	proto.RegisterType((*CapacityPlanSpaces)(nil), "rpcprotobuf.CapacityPlanSpaces")
+	proto.RegisterType((*CapacityPlanDir)(nil), "rpcprotobuf.CapacityPlanDir")
+	proto.RegisterType((*CapacityPlanResponse)(nil), "rpcprotobuf.CapacityPlanResponse")
+	proto.RegisterType((*ApplyCapacityPlanRequest)(nil), "rpcprotobuf.ApplyCapacityPlanRequest")
+	proto.RegisterType((*BenchmarkProofsRequest)(nil), "rpcprotobuf.BenchmarkProofsRequest")
+	proto.RegisterType((*ProofLatency)(nil), "rpcprotobuf.ProofLatency")
+	proto.RegisterType((*SpaceProofLatency)(nil), "rpcprotobuf.SpaceProofLatency")
+	proto.RegisterType((*DirProofLatency)(nil), "rpcprotobuf.DirProofLatency")
+	proto.RegisterType((*BenchmarkProofsResponse)(nil), "rpcprotobuf.BenchmarkProofsResponse")
+	proto.RegisterType((*SimulateMiningRequest)(nil), "rpcprotobuf.SimulateMiningRequest")
+	proto.RegisterType((*SimulatedBlock)(nil), "rpcprotobuf.SimulatedBlock")
+	proto.RegisterType((*SimulateMiningResponse)(nil), "rpcprotobuf.SimulateMiningResponse")
+	proto.RegisterType((*GetNetworkSpaceRequest)(nil), "rpcprotobuf.GetNetworkSpaceRequest")
+	proto.RegisterType((*GetNetworkSpaceResponse)(nil), "rpcprotobuf.GetNetworkSpaceResponse")
+	proto.RegisterType((*WorkSpaceStateResponse)(nil), "rpcprotobuf.WorkSpaceStateResponse")
+	proto.RegisterType((*UpdateWorkSpaceStateRequest)(nil), "rpcprotobuf.UpdateWorkSpaceStateRequest")
 	proto.RegisterType((*GetClientStatusResponse)(nil), "rpcprotobuf.GetClientStatusResponse")
 	proto.RegisterType((*GetClientStatusResponsePeerCountInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerCountInfo")
 	proto.RegisterType((*GetClientStatusResponsePeerInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerInfo")
 	proto.RegisterType((*GetClientStatusResponsePeerList)(nil), "rpcprotobuf.GetClientStatusResponse.peerList")
+	proto.RegisterType((*GetClientStatusResponseScriptValidation)(nil), "rpcprotobuf.GetClientStatusResponse.scriptValidation")
 	proto.RegisterType((*QuitClientResponse)(nil), "rpcprotobuf.QuitClientResponse")
+	proto.RegisterType((*GenerateBlocksRequest)(nil), "rpcprotobuf.GenerateBlocksRequest")
+	proto.RegisterType((*GenerateBlocksResponse)(nil), "rpcprotobuf.GenerateBlocksResponse")
+	proto.RegisterType((*GetBlockTemplateRequest)(nil), "rpcprotobuf.GetBlockTemplateRequest")
+	proto.RegisterType((*BlockTemplatePolicy)(nil), "rpcprotobuf.BlockTemplatePolicy")
+	proto.RegisterType((*BlockTemplateTx)(nil), "rpcprotobuf.BlockTemplateTx")
+	proto.RegisterType((*GetBlockTemplateResponse)(nil), "rpcprotobuf.GetBlockTemplateResponse")
+	proto.RegisterType((*SetBlockTemplatePolicyRequest)(nil), "rpcprotobuf.SetBlockTemplatePolicyRequest")
+	proto.RegisterType((*PrioritiseTransactionRequest)(nil), "rpcprotobuf.PrioritiseTransactionRequest")
+	proto.RegisterType((*PrioritiseTransactionResponse)(nil), "rpcprotobuf.PrioritiseTransactionResponse")
+	proto.RegisterType((*SaveMempoolResponse)(nil), "rpcprotobuf.SaveMempoolResponse")
+	proto.RegisterType((*GetTxOutSetInfoResponse)(nil), "rpcprotobuf.GetTxOutSetInfoResponse")
 	proto.RegisterType((*GetBlockHeightByPubKeyRequest)(nil), "rpcprotobuf.GetBlockHeightByPubKeyRequest")
 	proto.RegisterType((*GetBlockHeightByPubKeyResponse)(nil), "rpcprotobuf.GetBlockHeightByPubKeyResponse")
 	proto.RegisterType((*GetCoinbaseRequest)(nil), "rpcprotobuf.GetCoinbaseRequest")
@@ -4123,253 +6270,387 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 3929 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4d, 0x6f, 0x1b, 0x49,
-	0x76, 0x69, 0x52, 0xa2, 0xc4, 0x47, 0xea, 0xab, 0x64, 0xcb, 0x14, 0x25, 0x5b, 0x72, 0x8f, 0x3d,
-	0xf1, 0x7a, 0xc6, 0xe2, 0x88, 0xf1, 0x64, 0x11, 0x1f, 0x16, 0x2b, 0x6b, 0xbe, 0x04, 0xcf, 0xec,
-	0x68, 0x5b, 0x5a, 0xed, 0x61, 0x83, 0x70, 0x9b, 0xcd, 0x12, 0xd9, 0x23, 0xb2, 0xab, 0xdd, 0x55,
-	0x94, 0xa8, 0xf5, 0x1a, 0x08, 0x06, 0xd9, 0x20, 0x87, 0x6c, 0x0e, 0x59, 0x20, 0x40, 0x72, 0x08,
-	0x92, 0x43, 0x0e, 0x39, 0xe5, 0x94, 0x1f, 0x12, 0xe4, 0x0f, 0x04, 0x48, 0x2e, 0xc9, 0x2d, 0xc8,
-	0x3d, 0x08, 0xea, 0x55, 0xf5, 0x27, 0x9b, 0x14, 0x77, 0xbe, 0x92, 0x60, 0xe7, 0xa4, 0xae, 0x57,
-	0xaf, 0xde, 0x57, 0xbd, 0xf7, 0xaa, 0xde, 0x2b, 0x0a, 0xca, 0xb6, 0xef, 0xee, 0xf9, 0x01, 0x13,
-	0x8c, 0x54, 0x02, 0xdf, 0xc1, 0xaf, 0xf6, 0xf0, 0xbc, 0xbe, 0xdd, 0x65, 0xac, 0xdb, 0xa7, 0x0d,
-	0xdb, 0x77, 0x1b, 0xb6, 0xe7, 0x31, 0x61, 0x0b, 0x97, 0x79, 0x5c, 0xa1, 0xd6, 0xdf, 0xc6, 0x3f,
-	0xce, 0x93, 0x2e, 0xf5, 0x9e, 0xf0, 0x2b, 0xbb, 0xdb, 0xa5, 0x41, 0x83, 0xf9, 0x88, 0x91, 0x83,
-	0xbd, 0xa5, 0x69, 0x85, 0xc4, 0x1b, 0x74, 0xe0, 0x8b, 0x6b, 0x35, 0x69, 0xbe, 0x0b, 0x5b, 0x1f,
-	0x52, 0xf1, 0xbc, 0xcf, 0x9c, 0x8b, 0x8f, 0x6c, 0xde, 0x7b, 0x7e, 0xfd, 0x11, 0x75, 0xbb, 0x3d,
-	0x61, 0xd1, 0x97, 0x43, 0xca, 0x05, 0xd9, 0x80, 0x52, 0x0f, 0x01, 0x35, 0x63, 0xd7, 0x78, 0x34,
-	0x67, 0xe9, 0x91, 0xd9, 0x84, 0xed, 0xfc, 0x65, 0xdc, 0x67, 0x1e, 0xa7, 0x84, 0xc0, 0x5c, 0xcf,
-	0xe6, 0x3d, 0x5c, 0x55, 0xb6, 0xf0, 0xdb, 0x7c, 0x0e, 0xb7, 0xe4, 0x1a, 0xca, 0xd5, 0xba, 0x69,
-	0xb8, 0x09, 0xbe, 0x85, 0x14, 0xdf, 0x3d, 0xa8, 0x25, 0x69, 0x48, 0xde, 0x53, 0x79, 0x3e, 0x84,
-	0x95, 0x50, 0xce, 0x50, 0xa5, 0x3c, 0xb4, 0x7d, 0xb8, 0x13, 0xa2, 0xcd, 0x6a, 0x81, 0x4f, 0x60,
-	0xfe, 0x38, 0x60, 0xec, 0x9c, 0x54, 0xc1, 0x18, 0x69, 0x62, 0xc6, 0x88, 0xdc, 0x81, 0x85, 0x51,
-	0xcb, 0x0f, 0xdc, 0x01, 0x45, 0xc9, 0xcb, 0x56, 0x69, 0x74, 0x2c, 0x47, 0xe4, 0x2e, 0x40, 0xdb,
-	0x15, 0xad, 0x3e, 0xf5, 0xba, 0xa2, 0x57, 0x2b, 0xee, 0x1a, 0x8f, 0x96, 0xac, 0x72, 0xdb, 0x15,
-	0x1f, 0x23, 0xc0, 0x7c, 0x0c, 0xd5, 0x63, 0x76, 0x78, 0xe2, 0x76, 0x3d, 0x5b, 0x0c, 0x03, 0x2a,
-	0xa9, 0x06, 0x21, 0xd5, 0x40, 0x8e, 0xb8, 0xa6, 0x67, 0x70, 0x93, 0xc2, 0x32, 0x8a, 0x7a, 0xe4,
-	0x9d, 0xb3, 0x0f, 0x58, 0x70, 0x3a, 0x9a, 0x24, 0x24, 0x32, 0x95, 0x98, 0x2d, 0xd4, 0x58, 0x11,
-	0x28, 0xb7, 0x43, 0xcb, 0x91, 0x6d, 0x28, 0x0b, 0x77, 0x40, 0xb9, 0xb0, 0x07, 0x3e, 0x8a, 0x54,
-	0xb4, 0x62, 0x80, 0xe9, 0x40, 0xf1, 0xcc, 0xf5, 0xa4, 0xbd, 0xc4, 0xc8, 0xed, 0x84, 0xf6, 0x92,
-	0xdf, 0x12, 0x76, 0xc9, 0x86, 0x6a, 0x73, 0x96, 0x2c, 0xfc, 0x26, 0x75, 0x58, 0xe4, 0xd2, 0x66,
-	0x9e, 0x43, 0x91, 0xd6, 0x9c, 0x15, 0x8d, 0x49, 0x0d, 0x16, 0xae, 0x5c, 0xe1, 0x51, 0xce, 0x6b,
-	0x73, 0xbb, 0xc5, 0x47, 0x65, 0x2b, 0x1c, 0x9a, 0xdf, 0x87, 0xe5, 0x53, 0x76, 0xd0, 0xe9, 0x04,
-	0x94, 0x73, 0xa5, 0x4b, 0x0d, 0x16, 0x6c, 0x35, 0xae, 0x19, 0x0a, 0x57, 0x0f, 0xc9, 0x2d, 0x98,
-	0xbf, 0xb4, 0xfb, 0xc3, 0xd0, 0xb2, 0x6a, 0x60, 0x9e, 0x03, 0x1c, 0x79, 0xfe, 0x50, 0xf0, 0x23,
-	0xef, 0x74, 0x94, 0x2b, 0xed, 0x2d, 0x98, 0x77, 0xbd, 0x0e, 0x1d, 0x69, 0x71, 0xd5, 0x20, 0xc9,
-	0xa7, 0x38, 0x81, 0xcf, 0x5c, 0x92, 0xcf, 0x3f, 0x19, 0x40, 0x4e, 0x9c, 0xc0, 0xf5, 0xc5, 0xf1,
-	0xb0, 0xfd, 0x82, 0x5e, 0x5b, 0x94, 0x0f, 0xfb, 0x82, 0xac, 0x42, 0xd1, 0xe6, 0x03, 0xcd, 0x4f,
-	0x7e, 0x4a, 0x48, 0x4f, 0x33, 0x2b, 0x5b, 0xf2, 0x93, 0x6c, 0xc2, 0x62, 0x40, 0x5f, 0xb6, 0xb8,
-	0xdb, 0xe5, 0x7a, 0xe7, 0x17, 0x02, 0xfa, 0xf2, 0xc4, 0xed, 0x72, 0x94, 0xf7, 0xda, 0x0f, 0x59,
-	0xe1, 0x37, 0x79, 0x03, 0x96, 0xce, 0x03, 0xf6, 0x33, 0xea, 0xb5, 0x7c, 0x1a, 0xb8, 0xac, 0x53,
-	0x9b, 0xc7, 0x35, 0x55, 0x05, 0x3c, 0x46, 0x18, 0x79, 0x08, 0xcb, 0x01, 0xbd, 0xb2, 0x83, 0x4e,
-	0x2b, 0xd4, 0xa2, 0x84, 0x24, 0x96, 0x14, 0x54, 0x9b, 0x54, 0x6e, 0xb1, 0x9e, 0xa7, 0xbc, 0xb6,
-	0x80, 0x7a, 0xc6, 0x00, 0xf3, 0x0a, 0xe6, 0xce, 0xe4, 0xde, 0x45, 0x1a, 0x1b, 0x09, 0x8d, 0xa5,
-	0xd7, 0x79, 0xda, 0x66, 0x86, 0x47, 0x5e, 0xc0, 0x1a, 0x47, 0xf5, 0x5b, 0xfe, 0xb0, 0xdd, 0x77,
-	0x9d, 0xd6, 0x05, 0xbd, 0x46, 0x6d, 0x2a, 0xcd, 0x9d, 0xbd, 0x44, 0xee, 0xda, 0x1b, 0x37, 0x92,
-	0xb5, 0xc2, 0x43, 0x58, 0xdf, 0x75, 0x5e, 0xd0, 0x6b, 0xf3, 0xdf, 0x8b, 0x50, 0x39, 0x1d, 0x59,
-	0xf6, 0x95, 0xb6, 0x62, 0xde, 0xb6, 0xd5, 0x60, 0xe1, 0x92, 0x06, 0xdc, 0x65, 0xa1, 0x10, 0xe1,
-	0x90, 0x6c, 0x41, 0x19, 0xbd, 0x5a, 0xfa, 0x6a, 0xe8, 0x6b, 0x12, 0x70, 0x2a, 0x03, 0x6d, 0x1f,
-	0xe6, 0xd1, 0xc3, 0xd1, 0xa4, 0x95, 0xe6, 0x56, 0x4a, 0xb6, 0x74, 0xdc, 0x58, 0x0a, 0x93, 0x98,
-	0x50, 0xbc, 0x74, 0xbd, 0xda, 0xfc, 0x6e, 0xf1, 0x51, 0xa5, 0xb9, 0x9a, 0x5a, 0x70, 0xe6, 0x7a,
-	0x96, 0x9c, 0x24, 0x0f, 0xb5, 0xcb, 0x97, 0x10, 0x69, 0x2d, 0x8d, 0xc4, 0x86, 0x42, 0x47, 0xc1,
-	0x7d, 0x90, 0xdb, 0x34, 0x88, 0x36, 0x45, 0x99, 0xbc, 0x22, 0x61, 0xe1, 0x96, 0xbc, 0x05, 0x05,
-	0xc1, 0x6a, 0x8b, 0x48, 0x27, 0x2d, 0x5d, 0x3a, 0x12, 0xac, 0x82, 0x60, 0xa4, 0x01, 0x25, 0x17,
-	0xbd, 0xbb, 0x56, 0xc6, 0x05, 0x77, 0x52, 0x0b, 0x62, 0xc7, 0xb7, 0x34, 0x9a, 0xb4, 0x9a, 0x6f,
-	0x5f, 0xf7, 0x99, 0xdd, 0xa9, 0x01, 0x1a, 0x33, 0x1c, 0x92, 0x07, 0xb0, 0xe4, 0x30, 0xef, 0xdc,
-	0x0d, 0x06, 0xea, 0x78, 0xa8, 0x55, 0xd0, 0x72, 0x69, 0xa0, 0xdc, 0x09, 0xee, 0xfe, 0x8c, 0xd6,
-	0xaa, 0x2a, 0xb4, 0xe5, 0xb7, 0xf4, 0xe8, 0x73, 0x4a, 0x6b, 0x4b, 0xca, 0xa3, 0xcf, 0x29, 0x95,
-	0x09, 0x87, 0x0b, 0x5b, 0x0c, 0x79, 0x6d, 0x79, 0xd7, 0x78, 0x34, 0x6f, 0xe9, 0x51, 0xe4, 0xce,
-	0x2b, 0x08, 0xc5, 0x6f, 0xf3, 0xbf, 0x8a, 0x50, 0xfa, 0x88, 0xda, 0x1d, 0x1a, 0xe4, 0xa6, 0xfa,
-	0x4d, 0x58, 0x74, 0x7a, 0xb6, 0xeb, 0xb5, 0xdc, 0x8e, 0x8e, 0x99, 0x05, 0x1c, 0x1f, 0xa5, 0x3c,
-	0x40, 0xed, 0x72, 0xe4, 0x01, 0x71, 0xc2, 0x9b, 0x4b, 0x25, 0x3c, 0xc9, 0x5f, 0x3a, 0xc5, 0x3c,
-	0x26, 0x33, 0xfc, 0x96, 0xe1, 0xe4, 0x07, 0xf4, 0xd2, 0x65, 0x43, 0xae, 0xf2, 0xa0, 0x0a, 0x94,
-	0x6a, 0x08, 0xc4, 0x54, 0xf8, 0x1d, 0x58, 0x15, 0x81, 0xed, 0x71, 0xdb, 0x91, 0x66, 0x68, 0x05,
-	0x8c, 0x89, 0xda, 0x02, 0xe2, 0xad, 0x24, 0xe0, 0x16, 0x63, 0xb8, 0xc5, 0x3a, 0x7b, 0x29, 0xb4,
-	0x45, 0x44, 0xab, 0x68, 0x18, 0xa2, 0x20, 0x4b, 0xe6, 0x33, 0x6e, 0xf7, 0x15, 0x4e, 0x39, 0x64,
-	0xa9, 0x80, 0x88, 0xb4, 0x01, 0x25, 0x61, 0x07, 0x5d, 0x2a, 0xf4, 0x46, 0xe9, 0x91, 0x0c, 0x59,
-	0xa7, 0x67, 0xf7, 0xe5, 0x49, 0x41, 0x71, 0x8f, 0xca, 0x56, 0x0c, 0x90, 0x29, 0x3d, 0x11, 0x7f,
-	0x55, 0x35, 0xed, 0x87, 0x81, 0x45, 0x1e, 0xc1, 0xbc, 0x2f, 0x8f, 0x25, 0xdc, 0xac, 0x4a, 0x93,
-	0xa4, 0xdc, 0x05, 0x0f, 0x2c, 0x4b, 0x21, 0x90, 0xe7, 0xb0, 0xa2, 0xce, 0x06, 0x1e, 0x1e, 0x3a,
-	0xb8, 0x97, 0x95, 0xe6, 0x66, 0x7a, 0x4d, 0xe2, 0x54, 0xb2, 0x96, 0x71, 0x45, 0x7c, 0x4a, 0x6d,
-	0xc2, 0x62, 0xdb, 0xf6, 0x5a, 0x7d, 0x97, 0x8b, 0xda, 0x8a, 0x4a, 0xa2, 0x6d, 0xdb, 0xfb, 0xd8,
-	0xe5, 0xc2, 0xfc, 0x6b, 0x03, 0x2a, 0x1f, 0xd8, 0xc3, 0xbe, 0x4e, 0x04, 0xc9, 0xbd, 0x34, 0xd2,
-	0xd1, 0x9c, 0x34, 0x16, 0x3a, 0x8f, 0x8a, 0xf6, 0xc8, 0x58, 0xa7, 0x32, 0x27, 0xa6, 0xd5, 0x2e,
-	0x66, 0xd5, 0xde, 0x87, 0xb2, 0xa0, 0x5c, 0xb8, 0x03, 0xe6, 0x5d, 0xe3, 0x11, 0x53, 0x69, 0xae,
-	0xa7, 0xd4, 0x50, 0x0e, 0x68, 0xc5, 0x58, 0xa6, 0x03, 0xcb, 0x3f, 0x60, 0xc1, 0xc0, 0xee, 0x1f,
-	0x6b, 0x3e, 0x5f, 0x56, 0x44, 0x02, 0x73, 0x1d, 0x5b, 0xd8, 0x5a, 0x38, 0xfc, 0x36, 0x7f, 0x69,
-	0x40, 0x35, 0xa4, 0x7f, 0x10, 0x50, 0x9b, 0x1c, 0xc0, 0x8a, 0x3f, 0xf4, 0x5c, 0xde, 0x1b, 0x50,
-	0x4f, 0xb4, 0xec, 0x80, 0xda, 0x78, 0xca, 0x55, 0x9a, 0xb5, 0x94, 0xb8, 0x09, 0xcb, 0x59, 0xcb,
-	0xf1, 0x02, 0x24, 0xf1, 0x0c, 0x80, 0x89, 0x1e, 0x0d, 0xd4, 0xea, 0x42, 0x4e, 0x1e, 0x49, 0xeb,
-	0x65, 0x95, 0x11, 0x5d, 0xae, 0x35, 0xff, 0xae, 0x04, 0xab, 0xf1, 0x85, 0x68, 0xca, 0x05, 0xec,
-	0x2b, 0x8d, 0xca, 0xb1, 0xcc, 0x33, 0x3f, 0x21, 0xf3, 0x60, 0xec, 0x96, 0xa6, 0xc5, 0xee, 0x42,
-	0x4e, 0xec, 0x6e, 0x41, 0xd9, 0xa3, 0x23, 0xa1, 0x10, 0x54, 0x34, 0x2e, 0x4a, 0xc0, 0xc4, 0xc0,
-	0x2e, 0xcf, 0x16, 0xd8, 0x30, 0x43, 0x60, 0x57, 0xa6, 0x06, 0x76, 0x35, 0x15, 0xd8, 0x35, 0x58,
-	0x78, 0x39, 0xb4, 0xfb, 0xae, 0xb8, 0xd6, 0xa9, 0x34, 0x1c, 0xa6, 0x43, 0x7e, 0x79, 0x7a, 0xc8,
-	0xaf, 0x4c, 0x0c, 0xf9, 0xd5, 0x2f, 0x10, 0xf2, 0x6b, 0x5f, 0x26, 0xe4, 0x49, 0x2a, 0xe4, 0xc9,
-	0xf7, 0x12, 0xc6, 0x41, 0xdf, 0x5c, 0xcf, 0x23, 0x9e, 0x88, 0x86, 0xd8, 0x6e, 0xe8, 0xd8, 0xcb,
-	0x50, 0x10, 0xa3, 0xda, 0x2d, 0x24, 0x5a, 0x10, 0x23, 0x79, 0xf6, 0x05, 0xf6, 0x55, 0x4b, 0x8c,
-	0x6a, 0xb7, 0x73, 0x42, 0x24, 0x71, 0x7d, 0xb0, 0xe6, 0x03, 0xfb, 0x4a, 0x5d, 0xfe, 0xf0, 0xec,
-	0xda, 0x48, 0x9c, 0x5d, 0x9b, 0xb0, 0x28, 0x3d, 0xa9, 0x35, 0x14, 0x4e, 0xed, 0x8e, 0xb2, 0xba,
-	0x1c, 0xff, 0x48, 0x38, 0x38, 0x35, 0x6a, 0x39, 0x6c, 0xe8, 0x89, 0x5a, 0x4d, 0x05, 0xbc, 0x18,
-	0x1d, 0xca, 0xa1, 0xf9, 0x16, 0xdc, 0x8e, 0xea, 0x1b, 0x95, 0x39, 0xa6, 0x54, 0x0f, 0xbf, 0x98,
-	0x87, 0x8d, 0x2c, 0xf6, 0xff, 0xad, 0xd0, 0x4a, 0x5d, 0xf4, 0x4b, 0x99, 0x8b, 0xfe, 0xb7, 0x41,
-	0xf6, 0xff, 0x29, 0xc8, 0x92, 0xfe, 0xbc, 0x9e, 0xf2, 0x67, 0xf3, 0x0d, 0x58, 0xcb, 0x14, 0xbb,
-	0x67, 0x4d, 0x19, 0x54, 0xd1, 0xbd, 0xba, 0xe0, 0x76, 0xcc, 0x3f, 0x2b, 0x01, 0xc9, 0x9e, 0x00,
-	0x67, 0x4d, 0x59, 0xbd, 0x85, 0xdb, 0xad, 0x91, 0xa3, 0xb1, 0x74, 0x62, 0xb9, 0xd3, 0xda, 0x59,
-	0xf1, 0x7b, 0xdc, 0xef, 0x8a, 0x79, 0x7e, 0x27, 0x8d, 0xda, 0x97, 0xae, 0x8e, 0x61, 0x39, 0xa7,
-	0x8a, 0x5e, 0x84, 0x9c, 0xc8, 0xd8, 0xdc, 0x81, 0x8a, 0x6f, 0x3b, 0x17, 0x54, 0xa8, 0x79, 0x55,
-	0xe6, 0x80, 0x02, 0x21, 0x42, 0x18, 0x3e, 0xa5, 0x09, 0xe1, 0xb3, 0x30, 0x31, 0x7c, 0x16, 0x27,
-	0x85, 0x4f, 0x39, 0x15, 0x3e, 0xa9, 0xc0, 0x80, 0x6c, 0x60, 0x24, 0x6d, 0x5d, 0x49, 0xe7, 0x8e,
-	0x3c, 0x8f, 0xaf, 0xce, 0xe6, 0xf1, 0x4b, 0x33, 0x78, 0xfc, 0xf2, 0x54, 0x8f, 0x5f, 0x99, 0xe4,
-	0xf1, 0xab, 0x53, 0x3c, 0x7e, 0x6d, 0xba, 0xc7, 0x93, 0x89, 0x1e, 0xbf, 0x7e, 0x93, 0xc7, 0x7f,
-	0x17, 0xca, 0xb1, 0xaf, 0xdf, 0xba, 0xc9, 0xd7, 0x63, 0xdc, 0x94, 0x9b, 0xdf, 0x4e, 0xbb, 0xf9,
-	0x77, 0xa1, 0x1c, 0x2a, 0xcf, 0x31, 0x9f, 0x4f, 0x3d, 0x47, 0x62, 0xdc, 0x54, 0x52, 0xbf, 0x93,
-	0x4a, 0xea, 0xb2, 0xca, 0x95, 0x85, 0x25, 0xaf, 0xd5, 0x90, 0x97, 0x1a, 0x98, 0xbf, 0x0b, 0x70,
-	0x3a, 0xfa, 0x74, 0x28, 0x8e, 0x99, 0xeb, 0x89, 0xd9, 0xfb, 0x07, 0xe6, 0xe7, 0x05, 0xd8, 0xfc,
-	0x90, 0x8a, 0xd3, 0xd1, 0x7b, 0x94, 0x3b, 0x67, 0x34, 0x68, 0x33, 0x4e, 0xdf, 0x49, 0x26, 0xfe,
-	0x31, 0x3a, 0xe9, 0x68, 0x28, 0xdc, 0x10, 0x0d, 0xc5, 0xbc, 0x68, 0xc0, 0x0b, 0xd2, 0x5c, 0xe2,
-	0x82, 0x14, 0x3b, 0xf6, 0x7c, 0xca, 0xb1, 0x75, 0xc9, 0x56, 0x8a, 0x4b, 0xb6, 0xb7, 0x60, 0x8d,
-	0x0b, 0x3b, 0x10, 0xae, 0xd7, 0x6d, 0xf9, 0x81, 0xcb, 0x02, 0xe9, 0x30, 0x32, 0x80, 0x0c, 0x6b,
-	0x35, 0x9c, 0x38, 0xd6, 0x70, 0xf2, 0x26, 0xac, 0x08, 0x26, 0xec, 0x7e, 0x0b, 0xab, 0xca, 0x96,
-	0xdd, 0xa5, 0x18, 0x51, 0x45, 0x6b, 0x09, 0xc1, 0x58, 0x77, 0x1e, 0x74, 0xa9, 0xf9, 0x2f, 0x39,
-	0x46, 0xd8, 0xff, 0x0d, 0x33, 0x82, 0xcc, 0x05, 0xce, 0x30, 0x08, 0xe4, 0x85, 0x3e, 0xa2, 0x59,
-	0x46, 0x9a, 0x2b, 0x1a, 0x1e, 0x91, 0xdc, 0x87, 0x85, 0x0e, 0xf5, 0xa9, 0xd7, 0xe1, 0x35, 0xc8,
-	0xa9, 0xe7, 0x63, 0x47, 0xb4, 0x42, 0x3c, 0xf3, 0x6f, 0x0c, 0x6c, 0x4e, 0x7e, 0x1a, 0xf8, 0x3d,
-	0xdb, 0x53, 0x86, 0xfe, 0x5a, 0x0d, 0x9c, 0x10, 0x71, 0x6e, 0x46, 0x11, 0xff, 0xb6, 0x80, 0x27,
-	0xcf, 0xe9, 0xe8, 0x98, 0xb1, 0x7e, 0x24, 0x5c, 0x32, 0x12, 0x8d, 0x74, 0x24, 0xde, 0x87, 0x2a,
-	0x43, 0x7d, 0xf4, 0xb4, 0x92, 0xb2, 0xa2, 0x60, 0x0a, 0xc5, 0x84, 0x25, 0x31, 0x6a, 0x25, 0x34,
-	0x51, 0x07, 0x4c, 0x45, 0x8c, 0x8e, 0x23, 0x5d, 0x1e, 0xc0, 0xb2, 0xc4, 0x49, 0xa8, 0xa3, 0x2e,
-	0x47, 0x55, 0x31, 0x3a, 0x8e, 0x15, 0x7a, 0x0c, 0x6b, 0x9a, 0x59, 0x82, 0x9a, 0x72, 0x94, 0x15,
-	0x35, 0x11, 0x53, 0x7c, 0x1b, 0x48, 0x88, 0x9b, 0xa0, 0x5a, 0x42, 0xe4, 0x55, 0x8d, 0x1c, 0x53,
-	0x5e, 0x85, 0xa2, 0x18, 0x85, 0x3d, 0x1e, 0xf9, 0x29, 0x73, 0xb1, 0xc2, 0xe2, 0xd8, 0xe0, 0x29,
-	0x5b, 0xe1, 0xd0, 0xfc, 0xc7, 0xa2, 0x8e, 0x14, 0x69, 0xa3, 0xb1, 0x74, 0xf1, 0xad, 0xad, 0x12,
-	0xb6, 0x22, 0x07, 0x68, 0x8d, 0x0e, 0xe5, 0x4e, 0xd8, 0xf6, 0x7a, 0x33, 0xe5, 0x83, 0x13, 0xd3,
-	0xae, 0xb4, 0x9a, 0x84, 0x73, 0xf2, 0x61, 0x64, 0x35, 0x45, 0x46, 0x45, 0xdb, 0x83, 0x2c, 0x99,
-	0xbc, 0xa8, 0x0a, 0x6d, 0x8b, 0x84, 0x72, 0xf7, 0x6d, 0xff, 0xdb, 0x7d, 0xfb, 0x4a, 0xf6, 0x6d,
-	0xff, 0x6b, 0xdc, 0xb7, 0x3f, 0x32, 0x60, 0xeb, 0x50, 0x5e, 0x46, 0xbb, 0xc3, 0x80, 0x9e, 0xf8,
-	0xb6, 0x43, 0x5f, 0x50, 0xea, 0xc7, 0x85, 0x5c, 0x1d, 0x16, 0x1d, 0xdb, 0xb7, 0x1d, 0x99, 0xac,
-	0xd5, 0xa3, 0x49, 0x34, 0x96, 0x09, 0xdd, 0xb7, 0xaf, 0x99, 0xcc, 0xf9, 0x51, 0xef, 0xbc, 0x80,
-	0xaa, 0xae, 0x28, 0xf8, 0x41, 0x08, 0x26, 0xf7, 0x00, 0x7c, 0x9b, 0x73, 0xbf, 0x17, 0xd8, 0x9c,
-	0xea, 0xd6, 0x4f, 0x02, 0x62, 0xfe, 0x83, 0x01, 0xe5, 0x1f, 0xb3, 0xe0, 0x02, 0x25, 0x50, 0xa6,
-	0xeb, 0xb8, 0x9e, 0xdd, 0x47, 0x9e, 0x45, 0x2b, 0x1c, 0x66, 0x2e, 0x63, 0x85, 0xec, 0x65, 0x2c,
-	0xf5, 0x58, 0x61, 0x24, 0x1f, 0x2b, 0xd2, 0xef, 0x4a, 0x73, 0x99, 0x77, 0x25, 0x79, 0x77, 0xe1,
-	0xc2, 0x16, 0xca, 0x19, 0xca, 0x96, 0x1a, 0xa8, 0xdb, 0x3e, 0xeb, 0x46, 0xcf, 0x06, 0x86, 0x15,
-	0x8d, 0xcd, 0x27, 0xb0, 0x1a, 0x09, 0x1c, 0x1a, 0x6b, 0x13, 0x16, 0xb9, 0x1c, 0xb7, 0xa2, 0xb3,
-	0x66, 0x01, 0xc7, 0x47, 0x1d, 0xf3, 0x17, 0x06, 0xac, 0x25, 0xf0, 0x75, 0x5c, 0xbc, 0x0d, 0xf3,
-	0x88, 0x80, 0xd8, 0x95, 0xe6, 0x46, 0x6a, 0xff, 0x62, 0x74, 0x85, 0x24, 0x75, 0xa0, 0x41, 0xc0,
-	0x82, 0x96, 0xc3, 0x3a, 0xd1, 0x91, 0x85, 0x90, 0x43, 0xd6, 0xc1, 0x26, 0x90, 0x9a, 0x1e, 0x50,
-	0xce, 0xe5, 0x29, 0xac, 0x4c, 0x50, 0x45, 0xe0, 0x27, 0x0a, 0x66, 0xfe, 0xbd, 0x01, 0x24, 0x22,
-	0xcc, 0x23, 0x41, 0x76, 0xa0, 0xa2, 0x24, 0x4f, 0xc6, 0x28, 0x20, 0x48, 0xc5, 0xe0, 0x1e, 0x94,
-	0x70, 0xc4, 0x75, 0x27, 0x6d, 0x92, 0xa8, 0x1a, 0x2b, 0x23, 0x6b, 0xf1, 0x46, 0x59, 0xe7, 0x72,
-	0x64, 0xfd, 0x03, 0xa8, 0x1d, 0x38, 0xe2, 0x53, 0x2f, 0xe5, 0x96, 0x5a, 0xe0, 0x34, 0x7d, 0xe3,
-	0x46, 0xfa, 0x85, 0x1c, 0xfa, 0xff, 0x51, 0xc2, 0x2b, 0xc3, 0x61, 0xdf, 0xa5, 0x9e, 0x38, 0xc1,
-	0xce, 0x7c, 0x44, 0x3f, 0xd3, 0xe4, 0x2c, 0xc7, 0x35, 0xd2, 0x43, 0x58, 0xf6, 0x29, 0x0d, 0xf0,
-	0x3a, 0x4e, 0x3d, 0xd7, 0xeb, 0x22, 0xed, 0x45, 0x6b, 0x49, 0x42, 0x3f, 0x0e, 0x81, 0x92, 0x00,
-	0xbf, 0xf6, 0x1c, 0x39, 0x5f, 0xc4, 0xf9, 0x70, 0x28, 0xaf, 0x61, 0x03, 0x17, 0x17, 0xce, 0xe1,
-	0x84, 0x1e, 0x49, 0x99, 0xd5, 0x1e, 0x5c, 0x50, 0xea, 0xcb, 0xe9, 0x79, 0x9c, 0xae, 0xf2, 0x50,
-	0x7d, 0x89, 0x94, 0x2c, 0xeb, 0x4a, 0xe9, 0xb2, 0xee, 0x31, 0xac, 0xf5, 0x99, 0x63, 0xf7, 0x5b,
-	0x6d, 0xca, 0x45, 0x4b, 0xdf, 0xf4, 0x16, 0x54, 0x72, 0xc3, 0x89, 0xe7, 0x94, 0x0b, 0xf5, 0x6a,
-	0x2b, 0x71, 0x2f, 0x3c, 0x76, 0xe5, 0xa5, 0x70, 0x55, 0x31, 0xb8, 0x82, 0x13, 0x09, 0xdc, 0xdb,
-	0x50, 0xf2, 0x9b, 0xbe, 0x64, 0xa8, 0x7a, 0x15, 0xf3, 0x7e, 0xd3, 0x3f, 0xea, 0x90, 0x1f, 0x02,
-	0xa0, 0x1d, 0x94, 0xc7, 0x00, 0x3a, 0x70, 0x33, 0x9b, 0x80, 0xf2, 0x6c, 0xbb, 0x27, 0x97, 0xa1,
-	0x57, 0x1d, 0x79, 0xe7, 0xcc, 0x2a, 0x47, 0x43, 0x72, 0x08, 0xf3, 0x72, 0xa0, 0x9e, 0x5c, 0x2a,
-	0xcd, 0x27, 0x33, 0x53, 0x93, 0x66, 0xb7, 0xd4, 0xda, 0xfa, 0x4f, 0x60, 0x29, 0xc5, 0x00, 0xeb,
-	0x19, 0x79, 0x11, 0xd5, 0x5e, 0xa2, 0x06, 0x32, 0xb6, 0xd9, 0x50, 0xb4, 0xd9, 0xd0, 0xeb, 0xe8,
-	0x50, 0x8a, 0xc6, 0x72, 0xef, 0x5c, 0x4f, 0x4d, 0xe9, 0x77, 0x48, 0x3d, 0xac, 0x5b, 0xb0, 0x28,
-	0x89, 0x23, 0xdd, 0x4c, 0xcb, 0x20, 0x99, 0x7c, 0x0a, 0xe9, 0xe4, 0xb3, 0x0d, 0xe5, 0x8e, 0x1b,
-	0x50, 0xac, 0x75, 0xc3, 0xa6, 0x7c, 0x04, 0xa8, 0xff, 0xab, 0xa1, 0x88, 0x62, 0x41, 0x77, 0x94,
-	0x10, 0x4b, 0x75, 0xbc, 0x67, 0xb7, 0x02, 0x9a, 0x33, 0xd6, 0xe2, 0xc3, 0x58, 0x8b, 0xc2, 0x17,
-	0xa1, 0x14, 0xae, 0x96, 0xdb, 0x82, 0xad, 0x71, 0x7c, 0x00, 0xfe, 0xb5, 0xc9, 0xa8, 0xb5, 0xe6,
-	0xfb, 0x40, 0x7e, 0x38, 0x74, 0x35, 0xee, 0xac, 0x61, 0xbc, 0x0a, 0xc5, 0x01, 0xef, 0x86, 0x6f,
-	0xc4, 0x03, 0xde, 0x35, 0xbf, 0x07, 0x77, 0xe3, 0x1e, 0xa2, 0x74, 0xcf, 0xe7, 0xd7, 0xe1, 0x13,
-	0xaa, 0xca, 0xc1, 0xe9, 0x13, 0xc2, 0xc8, 0x9c, 0x10, 0xe6, 0x33, 0xb8, 0x37, 0x69, 0x7d, 0x1c,
-	0xf9, 0x2a, 0x1e, 0xd4, 0xc3, 0xfa, 0x9c, 0x15, 0x0e, 0xcd, 0xb7, 0xb1, 0x25, 0x74, 0xc8, 0x5c,
-	0xaf, 0x6d, 0x73, 0x7a, 0xd3, 0x2f, 0x1f, 0x7e, 0x65, 0x40, 0x35, 0xc4, 0xfd, 0x5f, 0x79, 0x3d,
-	0xce, 0x7b, 0x34, 0x37, 0xff, 0xa2, 0x08, 0xeb, 0x29, 0x25, 0xa6, 0x94, 0x48, 0xdf, 0xdc, 0xcb,
-	0xf2, 0x1b, 0xb0, 0xd4, 0x76, 0xbd, 0x8e, 0x2c, 0x37, 0x95, 0x89, 0xd4, 0x31, 0x5c, 0xd5, 0xc0,
-	0x33, 0xb4, 0x94, 0x7e, 0x7e, 0x2e, 0x4d, 0x7b, 0x7e, 0x7e, 0xa2, 0x9f, 0x9f, 0x17, 0x10, 0x29,
-	0xdd, 0x0a, 0x49, 0x6e, 0x86, 0x7e, 0x86, 0x4e, 0xbc, 0x02, 0x2f, 0xde, 0xf0, 0x0a, 0x5c, 0x9e,
-	0xf6, 0x0a, 0x0c, 0x89, 0x4e, 0xfa, 0x5d, 0x00, 0x55, 0x0e, 0x9f, 0x53, 0xca, 0xc3, 0x87, 0x49,
-	0x84, 0x7c, 0x40, 0x29, 0x4f, 0x3c, 0x09, 0x57, 0x93, 0x4f, 0xc2, 0xe6, 0x10, 0x6e, 0xbf, 0x3f,
-	0xf2, 0x59, 0x20, 0x5e, 0xd0, 0x6b, 0x2e, 0x58, 0x10, 0xf9, 0xd7, 0x16, 0x94, 0xaf, 0xec, 0x7e,
-	0x9f, 0x8a, 0xf8, 0x56, 0xb1, 0xa8, 0x00, 0x47, 0x9d, 0xcc, 0xbd, 0xaa, 0x90, 0xbd, 0x57, 0xc9,
-	0x73, 0x9d, 0x22, 0xd5, 0x96, 0x6f, 0xeb, 0xdf, 0xd3, 0x94, 0x2d, 0x50, 0xa0, 0x63, 0x5b, 0xf4,
-	0xcc, 0xa7, 0xb0, 0x91, 0x65, 0xab, 0x3d, 0xa2, 0x0e, 0x8b, 0x17, 0x1a, 0x16, 0xb2, 0x0d, 0xc7,
-	0xe6, 0x1f, 0x1b, 0x70, 0xfb, 0x68, 0x90, 0x27, 0xed, 0x0e, 0x54, 0xdc, 0x41, 0xcc, 0x50, 0x2d,
-	0x04, 0x05, 0x92, 0x0c, 0xe5, 0xf1, 0xc9, 0xfa, 0x9d, 0xd6, 0x98, 0xd4, 0x4b, 0xac, 0xdf, 0x39,
-	0x8e, 0x05, 0x7f, 0x08, 0xcb, 0x1e, 0xbd, 0x6a, 0x8d, 0x5d, 0x1a, 0x97, 0x3c, 0x7a, 0x15, 0xa3,
-	0x99, 0x14, 0x36, 0xb2, 0x72, 0x68, 0xf1, 0x63, 0x3b, 0x1b, 0xea, 0x94, 0xd5, 0x4f, 0xef, 0x29,
-	0x73, 0x16, 0x32, 0xe6, 0xdc, 0x80, 0x52, 0x40, 0x07, 0x76, 0x70, 0xa1, 0xb9, 0xe9, 0x91, 0xf9,
-	0x1e, 0x2c, 0xfd, 0x18, 0x71, 0x4e, 0x86, 0x83, 0x81, 0x1d, 0x5c, 0x4f, 0xdf, 0x94, 0x98, 0x4a,
-	0x21, 0x45, 0xe5, 0x05, 0x86, 0xde, 0x98, 0xa4, 0x4f, 0x61, 0x41, 0x2d, 0xe5, 0x3a, 0xe3, 0xd7,
-	0xd3, 0x77, 0xab, 0x24, 0x63, 0x2b, 0x44, 0x35, 0xdf, 0x85, 0xf5, 0x1f, 0x79, 0x32, 0x78, 0xd4,
-	0x7c, 0x68, 0xff, 0xb4, 0x43, 0x18, 0x63, 0x17, 0xed, 0x0f, 0xe0, 0x56, 0x7a, 0x59, 0x9c, 0xf5,
-	0xf8, 0xd0, 0x71, 0xd4, 0xcf, 0x89, 0xd4, 0x75, 0x45, 0x0d, 0x65, 0xda, 0xc2, 0x84, 0x1c, 0xfe,
-	0x9c, 0x08, 0x07, 0xe6, 0x7b, 0x40, 0x3e, 0xfe, 0xf2, 0x54, 0x7e, 0x0a, 0xb5, 0xc3, 0x9e, 0xed,
-	0x75, 0xe9, 0x71, 0xe0, 0x5e, 0xda, 0x82, 0xca, 0x9d, 0x0d, 0x35, 0x91, 0x85, 0xa1, 0x74, 0x94,
-	0xc0, 0xbd, 0x94, 0xf2, 0x6b, 0x5d, 0x2a, 0xd2, 0x4d, 0x34, 0x48, 0xa2, 0xa0, 0x93, 0x84, 0x28,
-	0x8a, 0x76, 0x45, 0xba, 0x88, 0x06, 0x99, 0xef, 0xc2, 0x66, 0x0e, 0x87, 0x9b, 0xc4, 0x35, 0x7f,
-	0x02, 0x77, 0xf4, 0x32, 0xcc, 0xa6, 0x49, 0xb9, 0x76, 0xa0, 0x82, 0x72, 0x0d, 0xdb, 0x09, 0xb1,
-	0x40, 0x8a, 0xa5, 0x20, 0x12, 0x01, 0xa5, 0xd2, 0x08, 0x3a, 0x28, 0xa5, 0x50, 0x0a, 0x62, 0x3e,
-	0x8d, 0xb4, 0x4e, 0x10, 0xbf, 0x49, 0xa4, 0xe6, 0x7f, 0x6e, 0x03, 0x1c, 0xf8, 0xee, 0x09, 0x0d,
-	0x2e, 0x5d, 0x87, 0x92, 0x36, 0x54, 0x93, 0x3f, 0xf1, 0x23, 0x1b, 0x7b, 0xea, 0xf7, 0x8b, 0x7b,
-	0x91, 0xe3, 0xbc, 0x3f, 0xf0, 0xc5, 0x75, 0xfd, 0x7e, 0xf6, 0xb4, 0x1e, 0xfb, 0x65, 0xa1, 0x79,
-	0xe7, 0xf3, 0x7f, 0xfe, 0xb7, 0x5f, 0x15, 0xd6, 0xc8, 0x4a, 0xe3, 0x72, 0xbf, 0x81, 0x09, 0x99,
-	0x37, 0xe4, 0xbd, 0x90, 0xb4, 0x61, 0x31, 0x3c, 0x2c, 0xc9, 0xf6, 0x18, 0x9d, 0xc4, 0x03, 0x4a,
-	0xfd, 0xee, 0x84, 0x59, 0xcd, 0x61, 0x13, 0x39, 0xac, 0x93, 0xb5, 0x04, 0x87, 0x57, 0x3d, 0x9b,
-	0xf7, 0x5e, 0x93, 0x5f, 0x1a, 0xea, 0xf7, 0x8e, 0xd9, 0xdf, 0x48, 0x92, 0x47, 0xb9, 0x24, 0x73,
-	0x7e, 0x7d, 0x59, 0xff, 0xce, 0x0c, 0x98, 0x5a, 0x90, 0x5d, 0x14, 0xa4, 0x4e, 0x6a, 0x09, 0x41,
-	0xa4, 0x1c, 0x8d, 0x57, 0xea, 0xd4, 0x7e, 0x4d, 0x5e, 0xc5, 0x2f, 0xff, 0x91, 0x28, 0x0f, 0x72,
-	0x19, 0x64, 0xc5, 0xb8, 0xc1, 0x06, 0x26, 0xb2, 0xde, 0x26, 0xf5, 0x24, 0x6b, 0x24, 0x90, 0x64,
-	0xbe, 0x9c, 0x7e, 0x21, 0x25, 0x66, 0xbe, 0x6e, 0xc9, 0xc7, 0xd6, 0xfa, 0x1b, 0x53, 0x71, 0xa6,
-	0x68, 0xae, 0xb6, 0xa0, 0xd1, 0x53, 0xac, 0xfe, 0xd2, 0x48, 0xbe, 0xcf, 0x26, 0xef, 0x46, 0xe4,
-	0xf1, 0x04, 0x0e, 0x39, 0x17, 0xb0, 0xfa, 0x5b, 0x33, 0xe1, 0x6a, 0xa9, 0xde, 0x44, 0xa9, 0x76,
-	0xc9, 0xbd, 0x84, 0x54, 0xfe, 0xb0, 0x7d, 0x41, 0xaf, 0x1b, 0xaf, 0xe2, 0x1b, 0xd0, 0x6b, 0x72,
-	0x0e, 0x10, 0x52, 0x3a, 0x6b, 0x92, 0x7b, 0xd3, 0x7c, 0xf1, 0xac, 0x59, 0xdf, 0x99, 0xba, 0x13,
-	0x67, 0xcd, 0xa4, 0xc7, 0x37, 0x23, 0x63, 0xb8, 0x9d, 0xd7, 0xe4, 0x2a, 0xde, 0x7d, 0x65, 0xbf,
-	0x19, 0xb8, 0xcd, 0x64, 0xfe, 0x7b, 0xc8, 0xb1, 0x46, 0x36, 0x32, 0x1c, 0x43, 0xe3, 0x5f, 0xc6,
-	0xcf, 0x8d, 0x61, 0xd7, 0x67, 0x06, 0xd6, 0x37, 0xb8, 0xdc, 0x7d, 0x64, 0xba, 0x45, 0x36, 0xb3,
-	0x4c, 0x2f, 0x15, 0x8b, 0xc6, 0x3e, 0xf9, 0x39, 0x54, 0x12, 0xd7, 0x41, 0x32, 0x66, 0xb9, 0xcc,
-	0x6d, 0xb7, 0xbe, 0x3b, 0x19, 0x41, 0x33, 0x7d, 0x8c, 0x4c, 0x1f, 0x10, 0x53, 0x6e, 0x69, 0xe2,
-	0x91, 0x8f, 0x37, 0x1c, 0x8d, 0x1a, 0xfb, 0x7b, 0x1b, 0xca, 0x51, 0xd3, 0x70, 0x62, 0x06, 0xbb,
-	0x37, 0xde, 0x1c, 0x4b, 0x36, 0xd0, 0xcd, 0xbb, 0xc8, 0xf0, 0x0e, 0xb9, 0x3d, 0xc6, 0xd0, 0x97,
-	0x64, 0x7f, 0x9e, 0x68, 0xba, 0x87, 0x8d, 0xd0, 0x89, 0xbc, 0xde, 0xcc, 0xe7, 0x95, 0x6d, 0xa0,
-	0x9a, 0xbf, 0x8d, 0x3c, 0xef, 0x93, 0x9d, 0x5c, 0x9e, 0x91, 0x7d, 0xdf, 0xc9, 0xe3, 0xbe, 0xff,
-	0x05, 0xb9, 0xef, 0xff, 0xba, 0xdc, 0xf7, 0xc9, 0x4f, 0x91, 0xfb, 0xa1, 0x6e, 0xd8, 0xa9, 0x9e,
-	0xcf, 0x44, 0xee, 0x3b, 0xf9, 0x2d, 0x9d, 0xe8, 0x6c, 0x32, 0x09, 0xb2, 0xad, 0x12, 0x90, 0x6c,
-	0x75, 0x9f, 0x67, 0x08, 0x6b, 0x51, 0xfb, 0x30, 0xe4, 0x93, 0x49, 0xdd, 0x53, 0xda, 0x8b, 0x37,
-	0xf3, 0xbc, 0x8d, 0x3c, 0x57, 0xcc, 0x04, 0xcf, 0x67, 0xc6, 0x63, 0xe2, 0x63, 0x9c, 0xa6, 0x14,
-	0x23, 0x77, 0x27, 0xb4, 0xa4, 0x34, 0xab, 0x7b, 0x93, 0xa6, 0xf3, 0xdc, 0x48, 0x71, 0x6a, 0xbc,
-	0x0a, 0xdb, 0x79, 0xaf, 0x09, 0x03, 0x72, 0xdc, 0x67, 0xb3, 0xda, 0xf2, 0x61, 0x8a, 0xd9, 0xa4,
-	0x2e, 0x96, 0x59, 0x47, 0x9e, 0xb7, 0xcc, 0x95, 0x04, 0x4f, 0xbf, 0xcf, 0x84, 0x54, 0xf1, 0x0f,
-	0x0d, 0x58, 0x1b, 0xe3, 0x78, 0x93, 0x92, 0x33, 0xf2, 0x7d, 0x88, 0x7c, 0x77, 0xcc, 0x7a, 0xae,
-	0xae, 0x91, 0x08, 0x0c, 0xc8, 0x27, 0xae, 0x47, 0xbf, 0x7e, 0x9d, 0x07, 0xae, 0x47, 0x43, 0x9d,
-	0xc7, 0x38, 0x7e, 0x33, 0x3a, 0x87, 0x22, 0x30, 0x20, 0x27, 0x82, 0xf9, 0x5f, 0xbf, 0xce, 0x5c,
-	0x30, 0x3f, 0xd4, 0x79, 0x8c, 0xe3, 0x37, 0xa3, 0x73, 0x28, 0xc2, 0x67, 0xf8, 0xef, 0x1f, 0xc9,
-	0x36, 0xce, 0x44, 0x85, 0x1f, 0xcc, 0xd2, 0xfc, 0x49, 0xdf, 0xf7, 0x1c, 0xc4, 0x68, 0xe8, 0xfa,
-	0xcb, 0x06, 0x88, 0xfb, 0x40, 0x33, 0xe6, 0xa2, 0xf1, 0xc6, 0x51, 0xda, 0xa2, 0x9a, 0xc3, 0xcb,
-	0xa1, 0x8b, 0x6e, 0x3b, 0x82, 0xe5, 0x74, 0x4d, 0x9b, 0xb9, 0x45, 0xe5, 0xd6, 0xd9, 0x99, 0x63,
-	0x3c, 0xbf, 0x28, 0x0e, 0x93, 0x84, 0x49, 0x24, 0x5b, 0x5d, 0x8a, 0x35, 0x54, 0x39, 0xad, 0x39,
-	0xa7, 0xcb, 0xd1, 0x0c, 0xe7, 0xdc, 0x9a, 0x39, 0xc3, 0x39, 0xbf, 0x9e, 0xcd, 0xe7, 0xac, 0xea,
-	0x6a, 0xc9, 0xf9, 0xf7, 0xf1, 0x1c, 0x8f, 0xd8, 0x4e, 0xb2, 0xeb, 0xd8, 0xf1, 0x3d, 0xc6, 0x67,
-	0x1d, 0xf9, 0x2c, 0x91, 0x4a, 0x82, 0x0f, 0x19, 0x42, 0x35, 0x59, 0x35, 0x92, 0x34, 0x99, 0x9c,
-	0x3a, 0x34, 0x53, 0x76, 0xe4, 0x95, 0x9c, 0xe1, 0x8d, 0xd4, 0xbc, 0x9d, 0xd4, 0x68, 0x88, 0x98,
-	0xae, 0xd7, 0x95, 0x4a, 0x51, 0x80, 0xb8, 0xc8, 0x9c, 0xd1, 0x57, 0xc6, 0xab, 0xd2, 0xf0, 0xee,
-	0x65, 0xae, 0x27, 0x19, 0x25, 0xd8, 0xfc, 0xa9, 0x01, 0x6b, 0x63, 0x45, 0x22, 0x49, 0x87, 0xd8,
-	0xa4, 0x32, 0x35, 0x73, 0x66, 0x4f, 0xac, 0x35, 0xcd, 0x47, 0x28, 0x84, 0x69, 0xde, 0x4d, 0x0a,
-	0x11, 0x56, 0xae, 0x0d, 0x47, 0xae, 0xd3, 0xe2, 0xfc, 0x89, 0x01, 0xab, 0xd9, 0xfa, 0x30, 0x53,
-	0x82, 0x4c, 0xa8, 0x4d, 0xeb, 0x0f, 0x6f, 0xc0, 0x4a, 0xdf, 0x1f, 0xcc, 0xed, 0x94, 0x2c, 0xaa,
-	0x3a, 0x4d, 0x8a, 0xf2, 0xfc, 0xf3, 0xc2, 0x9f, 0x1f, 0xfc, 0xb7, 0x41, 0x2c, 0x58, 0xfe, 0xe4,
-	0xe0, 0xe4, 0xe4, 0x89, 0x4c, 0xcd, 0xc1, 0xee, 0xc1, 0xf1, 0x91, 0xf9, 0x7b, 0x50, 0x95, 0x90,
-	0x5d, 0x3f, 0x60, 0x9f, 0x51, 0x47, 0x90, 0x5b, 0x3d, 0x21, 0x7c, 0xfe, 0xac, 0xd1, 0x18, 0xd8,
-	0x9c, 0x7b, 0x54, 0xec, 0xb1, 0xa0, 0xdb, 0xa8, 0xaf, 0x3b, 0xcc, 0x13, 0xb6, 0x23, 0xbe, 0x9f,
-	0x80, 0x3e, 0xfe, 0xad, 0x66, 0x71, 0x7f, 0xef, 0x9d, 0xc7, 0x86, 0xd1, 0x5c, 0xb5, 0x7d, 0xbf,
-	0xef, 0x3a, 0xd8, 0x4b, 0x6b, 0x7c, 0xc6, 0x99, 0xd7, 0xdc, 0x48, 0x42, 0x46, 0x4f, 0xce, 0x19,
-	0x7b, 0x32, 0x70, 0x07, 0xf4, 0xd9, 0x18, 0xe6, 0xb3, 0x09, 0x98, 0xd6, 0x16, 0x14, 0x9f, 0xbe,
-	0xf3, 0x94, 0xdc, 0x02, 0xf8, 0x01, 0x13, 0xbb, 0xe7, 0x6c, 0xe8, 0x75, 0xf6, 0x48, 0x09, 0xe6,
-	0xfe, 0xaa, 0x60, 0x2c, 0x04, 0x4f, 0x61, 0x3b, 0xad, 0xc7, 0xee, 0x7b, 0xcc, 0x19, 0x0e, 0xa8,
-	0xa7, 0xfe, 0x13, 0x30, 0x5f, 0x8b, 0x76, 0x09, 0x0d, 0xfa, 0x3b, 0xff, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x23, 0x4d, 0xda, 0x67, 0x85, 0x38, 0x00, 0x00,
+	// 6077 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0xdd, 0x6f, 0x23, 0xc9,
+	0x71, 0xf8, 0x6f, 0x48, 0x89, 0x22, 0x8b, 0x94, 0x28, 0xb5, 0x56, 0x5a, 0x2e, 0xb5, 0x1f, 0xda,
+	0xd9, 0xdd, 0xbb, 0xf5, 0xde, 0xad, 0xb4, 0x92, 0x6f, 0x6d, 0xdf, 0xfd, 0x00, 0xc3, 0xda, 0xdd,
+	0xfb, 0x50, 0xee, 0xd6, 0xa7, 0x1b, 0xc9, 0xeb, 0x00, 0x4e, 0x42, 0x8f, 0xc8, 0x16, 0x35, 0x5e,
+	0x72, 0x66, 0x6e, 0x7a, 0x28, 0x51, 0x3e, 0x6f, 0x60, 0x18, 0x71, 0xe0, 0x87, 0x38, 0x40, 0x6c,
+	0xc0, 0x40, 0x0c, 0x24, 0x70, 0x1e, 0xf2, 0x90, 0xe4, 0x21, 0x4f, 0x79, 0x0c, 0x90, 0xf7, 0x00,
+	0x01, 0x82, 0xfc, 0x03, 0x01, 0x9c, 0x97, 0x3c, 0xe7, 0xdd, 0x09, 0xba, 0xba, 0x7b, 0xa6, 0x7b,
+	0x38, 0xfc, 0xf0, 0x7d, 0x38, 0x09, 0x72, 0x4f, 0x62, 0xd7, 0x54, 0x77, 0x55, 0x57, 0x57, 0x55,
+	0x57, 0x57, 0x57, 0x0b, 0x2a, 0x6e, 0xe8, 0x6d, 0x85, 0x51, 0x10, 0x07, 0xa4, 0x1a, 0x85, 0x6d,
+	0xfc, 0x75, 0x3c, 0x38, 0x69, 0x5e, 0xed, 0x06, 0x41, 0xb7, 0x47, 0xb7, 0xdd, 0xd0, 0xdb, 0x76,
+	0x7d, 0x3f, 0x88, 0xdd, 0xd8, 0x0b, 0x7c, 0x26, 0x50, 0x9b, 0xaf, 0xe2, 0x9f, 0xf6, 0xfd, 0x2e,
+	0xf5, 0xef, 0xb3, 0x73, 0xb7, 0xdb, 0xa5, 0xd1, 0x76, 0x10, 0x22, 0x46, 0x0e, 0xf6, 0x86, 0x1c,
+	0x4b, 0x0d, 0xbe, 0x4d, 0xfb, 0x61, 0x7c, 0x21, 0x3e, 0xda, 0x0f, 0x61, 0xe3, 0x6d, 0x1a, 0x3f,
+	0xea, 0x05, 0xed, 0xe7, 0xef, 0xb8, 0xec, 0xf4, 0xd1, 0xc5, 0x3b, 0xd4, 0xeb, 0x9e, 0xc6, 0x0e,
+	0xfd, 0x70, 0x40, 0x59, 0x4c, 0xd6, 0xa1, 0x74, 0x8a, 0x80, 0x86, 0xb5, 0x69, 0xdd, 0x9d, 0x73,
+	0x64, 0xcb, 0xde, 0x85, 0xab, 0xf9, 0xdd, 0x58, 0x18, 0xf8, 0x8c, 0x12, 0x02, 0x73, 0xa7, 0x2e,
+	0x3b, 0xc5, 0x5e, 0x15, 0x07, 0x7f, 0xdb, 0x8f, 0xe0, 0x12, 0xef, 0x43, 0x99, 0xe8, 0x37, 0x09,
+	0x57, 0xa3, 0x5b, 0x30, 0xe8, 0x6e, 0x41, 0x43, 0x1f, 0x83, 0xd3, 0x9e, 0x48, 0xf3, 0x0e, 0xd4,
+	0x15, 0x9f, 0x6a, 0x4a, 0x79, 0x68, 0x3b, 0x70, 0x59, 0xa1, 0xcd, 0x2a, 0x81, 0xa7, 0x30, 0x7f,
+	0x10, 0x05, 0xc1, 0x09, 0xa9, 0x81, 0x35, 0x94, 0x83, 0x59, 0x43, 0x72, 0x19, 0x16, 0x86, 0xad,
+	0x30, 0xf2, 0xfa, 0x14, 0x39, 0xaf, 0x38, 0xa5, 0xe1, 0x01, 0x6f, 0x91, 0x6b, 0x00, 0xc7, 0x5e,
+	0xdc, 0xea, 0x51, 0xbf, 0x1b, 0x9f, 0x36, 0x8a, 0x9b, 0xd6, 0xdd, 0x45, 0xa7, 0x72, 0xec, 0xc5,
+	0xef, 0x21, 0xc0, 0xbe, 0x07, 0xb5, 0x83, 0xe0, 0xf1, 0xa1, 0xd7, 0xf5, 0xdd, 0x78, 0x10, 0x51,
+	0x3e, 0x6a, 0xa4, 0x46, 0x8d, 0x78, 0x8b, 0xc9, 0xf1, 0x2c, 0x66, 0x53, 0x58, 0x42, 0x56, 0xf7,
+	0xfd, 0x93, 0xe0, 0xad, 0x20, 0x3a, 0x1a, 0x8e, 0x63, 0x12, 0x89, 0x72, 0xcc, 0x16, 0xce, 0x58,
+	0x0c, 0x50, 0x39, 0x56, 0x92, 0x23, 0x57, 0xa1, 0x12, 0x7b, 0x7d, 0xca, 0x62, 0xb7, 0x1f, 0x22,
+	0x4b, 0x45, 0x27, 0x05, 0xd8, 0x6d, 0x28, 0x3e, 0xf3, 0x7c, 0x2e, 0xaf, 0x78, 0xe8, 0x75, 0x94,
+	0xbc, 0xf8, 0x6f, 0x0e, 0x3b, 0x0b, 0x06, 0x62, 0x71, 0x16, 0x1d, 0xfc, 0x4d, 0x9a, 0x50, 0x66,
+	0x5c, 0x66, 0x7e, 0x9b, 0xe2, 0x58, 0x73, 0x4e, 0xd2, 0x26, 0x0d, 0x58, 0x38, 0xf7, 0x62, 0x9f,
+	0x32, 0xd6, 0x98, 0xdb, 0x2c, 0xde, 0xad, 0x38, 0xaa, 0x69, 0x7f, 0x0d, 0x96, 0x8e, 0x82, 0xbd,
+	0x4e, 0x27, 0xa2, 0x8c, 0x89, 0xb9, 0x34, 0x60, 0xc1, 0x15, 0xed, 0x86, 0x25, 0x70, 0x65, 0x93,
+	0x5c, 0x82, 0xf9, 0x33, 0xb7, 0x37, 0x50, 0x92, 0x15, 0x0d, 0xfb, 0x04, 0x60, 0xdf, 0x0f, 0x07,
+	0x31, 0xdb, 0xf7, 0x8f, 0x86, 0xb9, 0xdc, 0x5e, 0x82, 0x79, 0xcf, 0xef, 0xd0, 0xa1, 0x64, 0x57,
+	0x34, 0x74, 0x3a, 0xc5, 0x31, 0x74, 0xe6, 0x74, 0x3a, 0xff, 0x6c, 0x01, 0x39, 0x6c, 0x47, 0x5e,
+	0x18, 0x1f, 0x0c, 0x8e, 0xdf, 0xa5, 0x17, 0x0e, 0x65, 0x83, 0x5e, 0x4c, 0x96, 0xa1, 0xe8, 0xb2,
+	0xbe, 0xa4, 0xc7, 0x7f, 0x72, 0xc8, 0xa9, 0x24, 0x56, 0x71, 0xf8, 0x4f, 0x72, 0x05, 0xca, 0x11,
+	0xfd, 0xb0, 0xc5, 0xbc, 0x2e, 0x93, 0x2b, 0xbf, 0x10, 0xd1, 0x0f, 0x0f, 0xbd, 0x2e, 0x43, 0x7e,
+	0x2f, 0x42, 0x45, 0x0a, 0x7f, 0x93, 0x5b, 0xb0, 0x78, 0x12, 0x05, 0xdf, 0xa5, 0x7e, 0x2b, 0xa4,
+	0x91, 0x17, 0x74, 0x1a, 0xf3, 0xd8, 0xa7, 0x26, 0x80, 0x07, 0x08, 0x23, 0x77, 0x60, 0x29, 0xa2,
+	0xe7, 0x6e, 0xd4, 0x69, 0xa9, 0x59, 0x94, 0x70, 0x88, 0x45, 0x01, 0x95, 0x22, 0xe5, 0x4b, 0x2c,
+	0xbf, 0x53, 0xd6, 0x58, 0xc0, 0x79, 0xa6, 0x00, 0xfb, 0x1c, 0xe6, 0x9e, 0xf1, 0xb5, 0x4b, 0x66,
+	0x6c, 0x69, 0x33, 0xe6, 0x5a, 0xe7, 0x4b, 0x99, 0x59, 0x3e, 0x79, 0x17, 0x56, 0x18, 0x4e, 0xbf,
+	0x15, 0x0e, 0x8e, 0x7b, 0x5e, 0xbb, 0xf5, 0x9c, 0x5e, 0xe0, 0x6c, 0xaa, 0xbb, 0x37, 0xb6, 0x34,
+	0xdf, 0xb5, 0x35, 0x2a, 0x24, 0xa7, 0xce, 0x14, 0xac, 0xe7, 0xb5, 0xdf, 0xa5, 0x17, 0xf6, 0xbf,
+	0x17, 0xa1, 0x7a, 0x34, 0x74, 0xdc, 0x73, 0x29, 0xc5, 0xbc, 0x65, 0x6b, 0xc0, 0xc2, 0x19, 0x8d,
+	0x98, 0x17, 0x28, 0x26, 0x54, 0x93, 0x6c, 0x40, 0x05, 0xb5, 0x9a, 0xeb, 0xaa, 0xd2, 0x35, 0x0e,
+	0x38, 0xe2, 0x86, 0xb6, 0x03, 0xf3, 0xa8, 0xe1, 0x28, 0xd2, 0xea, 0xee, 0x86, 0xc1, 0x9b, 0x69,
+	0x37, 0x8e, 0xc0, 0x24, 0x36, 0x14, 0xcf, 0x3c, 0xbf, 0x31, 0xbf, 0x59, 0xbc, 0x5b, 0xdd, 0x5d,
+	0x36, 0x3a, 0x3c, 0xf3, 0x7c, 0x87, 0x7f, 0x24, 0x77, 0xa4, 0xca, 0x97, 0x10, 0x69, 0xc5, 0x44,
+	0x0a, 0x06, 0xb1, 0xb4, 0x82, 0x9b, 0xc0, 0x97, 0xa9, 0x9f, 0x2c, 0x8a, 0x10, 0x79, 0x95, 0xc3,
+	0xd4, 0x92, 0xbc, 0x02, 0x85, 0x38, 0x68, 0x94, 0x37, 0x8b, 0x23, 0xdc, 0x99, 0x96, 0xe0, 0x14,
+	0xe2, 0x80, 0x6c, 0x43, 0xc9, 0x43, 0xed, 0x6e, 0x54, 0xb0, 0xc3, 0x65, 0xa3, 0x43, 0xaa, 0xf8,
+	0x8e, 0x44, 0xe3, 0x52, 0x0b, 0xdd, 0x8b, 0x5e, 0xe0, 0x76, 0x1a, 0x80, 0xc2, 0x54, 0x4d, 0x72,
+	0x1b, 0x16, 0xdb, 0x81, 0x7f, 0xe2, 0x45, 0x7d, 0xb1, 0x3d, 0x34, 0xaa, 0x28, 0x39, 0x13, 0xc8,
+	0x57, 0x82, 0x79, 0xdf, 0xa5, 0x8d, 0x9a, 0x30, 0x6d, 0xfe, 0x9b, 0x6b, 0xf4, 0x09, 0xa5, 0x8d,
+	0x45, 0xa1, 0xd1, 0x27, 0x94, 0x72, 0x87, 0xc3, 0x62, 0x37, 0x1e, 0xb0, 0xc6, 0xd2, 0xa6, 0x75,
+	0x77, 0xde, 0x91, 0xad, 0x44, 0x9d, 0xeb, 0x08, 0xc5, 0xdf, 0xf6, 0x7f, 0x14, 0xa1, 0xf4, 0x0e,
+	0x75, 0x3b, 0x34, 0xca, 0x75, 0xf5, 0x57, 0xa0, 0xdc, 0x3e, 0x75, 0x3d, 0xbf, 0xe5, 0x75, 0xa4,
+	0xcd, 0x2c, 0x60, 0x7b, 0xdf, 0xd0, 0x00, 0xb1, 0xca, 0xaa, 0xa9, 0x39, 0xbc, 0x39, 0xc3, 0xe1,
+	0x71, 0xfa, 0x5c, 0x29, 0xe6, 0xd1, 0x99, 0xe1, 0x6f, 0x6e, 0x4e, 0x61, 0x44, 0xcf, 0xbc, 0x60,
+	0xc0, 0x84, 0x1f, 0x14, 0x86, 0x52, 0x53, 0x40, 0x74, 0x85, 0x5f, 0x80, 0xe5, 0x38, 0x72, 0x7d,
+	0xe6, 0xb6, 0xb9, 0x18, 0x5a, 0x51, 0x10, 0xc4, 0x8d, 0x05, 0xc4, 0xab, 0x6b, 0x70, 0x27, 0x08,
+	0x70, 0x89, 0xa5, 0xf7, 0x12, 0x68, 0x65, 0x44, 0xab, 0x4a, 0x18, 0xa2, 0x20, 0xc9, 0x20, 0x0c,
+	0x98, 0xdb, 0x13, 0x38, 0x15, 0x45, 0x52, 0x00, 0x11, 0x69, 0x1d, 0x4a, 0xb1, 0x1b, 0x75, 0x69,
+	0x2c, 0x17, 0x4a, 0xb6, 0xb8, 0xc9, 0xb6, 0x4f, 0xdd, 0x1e, 0xdf, 0x29, 0x28, 0xae, 0x51, 0xc5,
+	0x49, 0x01, 0xdc, 0xa5, 0x6b, 0xf6, 0x57, 0x13, 0x9f, 0x43, 0x65, 0x58, 0xe4, 0x2e, 0xcc, 0x87,
+	0x7c, 0x5b, 0xc2, 0xc5, 0xaa, 0xee, 0x12, 0x43, 0x5d, 0x70, 0xc3, 0x72, 0x04, 0x02, 0x79, 0x04,
+	0x75, 0xb1, 0x37, 0x30, 0xb5, 0xe9, 0xe0, 0x5a, 0x56, 0x77, 0xaf, 0x98, 0x7d, 0xb4, 0x5d, 0xc9,
+	0x59, 0xc2, 0x1e, 0x49, 0x9b, 0xaf, 0xdd, 0xb1, 0xeb, 0xb7, 0x7a, 0x1e, 0x8b, 0x1b, 0x75, 0xe1,
+	0x44, 0x8f, 0x5d, 0xff, 0x3d, 0x8f, 0xc5, 0xf6, 0x9f, 0x5b, 0x50, 0x7d, 0xcb, 0x1d, 0xf4, 0xa4,
+	0x23, 0xd0, 0xd7, 0xd2, 0x32, 0xad, 0x59, 0x17, 0x16, 0x2a, 0x8f, 0xb0, 0xf6, 0x44, 0x58, 0x47,
+	0x17, 0x61, 0x76, 0xda, 0xc5, 0xec, 0xb4, 0x77, 0xa0, 0x12, 0x53, 0x16, 0x7b, 0xfd, 0xc0, 0xbf,
+	0xc0, 0x2d, 0xa6, 0xba, 0xbb, 0x6a, 0x4c, 0x43, 0x28, 0xa0, 0x93, 0x62, 0xd9, 0x6d, 0x58, 0xfa,
+	0x7a, 0x10, 0xf5, 0xdd, 0xde, 0x81, 0xa4, 0xf3, 0x49, 0x59, 0x24, 0x30, 0xd7, 0x71, 0x63, 0x57,
+	0x32, 0x87, 0xbf, 0xed, 0x1f, 0x5b, 0x50, 0x53, 0xe3, 0xef, 0x45, 0xd4, 0x25, 0x7b, 0x50, 0x0f,
+	0x07, 0xbe, 0xc7, 0x4e, 0xfb, 0xd4, 0x8f, 0x5b, 0x6e, 0x44, 0x5d, 0xdc, 0xe5, 0xaa, 0xbb, 0x0d,
+	0x83, 0x5d, 0x4d, 0x72, 0xce, 0x52, 0xda, 0x01, 0x87, 0x78, 0x03, 0x20, 0x88, 0x4f, 0x69, 0x24,
+	0x7a, 0x17, 0x72, 0xfc, 0x88, 0x39, 0x2f, 0xa7, 0x82, 0xe8, 0xbc, 0xaf, 0xfd, 0x97, 0x25, 0x58,
+	0x4e, 0x03, 0xa2, 0x09, 0x01, 0xd8, 0xa7, 0x6a, 0x95, 0x23, 0x9e, 0x67, 0x7e, 0x8c, 0xe7, 0x41,
+	0xdb, 0x2d, 0x4d, 0xb2, 0xdd, 0x85, 0x1c, 0xdb, 0xdd, 0x80, 0x8a, 0x4f, 0x87, 0xb1, 0x40, 0x10,
+	0xd6, 0x58, 0xe6, 0x80, 0xb1, 0x86, 0x5d, 0x99, 0xcd, 0xb0, 0x61, 0x06, 0xc3, 0xae, 0x4e, 0x34,
+	0xec, 0x9a, 0x61, 0xd8, 0x0d, 0x58, 0xf8, 0x70, 0xe0, 0xf6, 0xbc, 0xf8, 0x42, 0xba, 0x52, 0xd5,
+	0x34, 0x4d, 0x7e, 0x69, 0xb2, 0xc9, 0xd7, 0xc7, 0x9a, 0xfc, 0xf2, 0xc7, 0x30, 0xf9, 0x95, 0x4f,
+	0x62, 0xf2, 0xc4, 0x30, 0x79, 0xf2, 0x55, 0x4d, 0x38, 0xa8, 0x9b, 0xab, 0x79, 0x83, 0x6b, 0xd6,
+	0x90, 0xca, 0x8d, 0xb7, 0xc8, 0x12, 0x14, 0xe2, 0x61, 0xe3, 0x12, 0x0e, 0x5a, 0x88, 0x87, 0x7c,
+	0xef, 0x8b, 0xdc, 0xf3, 0x56, 0x3c, 0x6c, 0xac, 0xe5, 0x98, 0x88, 0x16, 0x3e, 0x38, 0xf3, 0x91,
+	0x7b, 0x2e, 0x82, 0x3f, 0xdc, 0xbb, 0xd6, 0xb5, 0xbd, 0xeb, 0x0a, 0x94, 0xb9, 0x26, 0xb5, 0x06,
+	0x71, 0xbb, 0x71, 0x59, 0x48, 0x9d, 0xb7, 0xbf, 0x11, 0xb7, 0xf1, 0xd3, 0xb0, 0xd5, 0x0e, 0x06,
+	0x7e, 0xdc, 0x68, 0x08, 0x83, 0x8f, 0x87, 0x8f, 0x79, 0xd3, 0x7e, 0x05, 0xd6, 0x92, 0xf3, 0x8d,
+	0xf0, 0x1c, 0x13, 0x4e, 0x0f, 0x3f, 0x9c, 0x87, 0xf5, 0x2c, 0xf6, 0xff, 0x2c, 0xd3, 0x32, 0x02,
+	0xfd, 0x52, 0x26, 0xd0, 0xff, 0xdc, 0xc8, 0xfe, 0x37, 0x19, 0x99, 0xae, 0xcf, 0xab, 0x86, 0x3e,
+	0xdb, 0xb7, 0x60, 0x25, 0x73, 0xd8, 0x7d, 0xb6, 0xcb, 0x8d, 0x2a, 0x89, 0xab, 0x0b, 0x5e, 0xc7,
+	0xfe, 0xe3, 0x12, 0x90, 0xec, 0x0e, 0xf0, 0x6c, 0x97, 0x9f, 0xde, 0xd4, 0x72, 0x4b, 0xe4, 0xa4,
+	0xcd, 0x95, 0x98, 0xaf, 0xb4, 0x54, 0x56, 0xfc, 0x3d, 0xaa, 0x77, 0xc5, 0x3c, 0xbd, 0xe3, 0x42,
+	0xed, 0x71, 0x55, 0x47, 0xb3, 0x9c, 0x13, 0x87, 0x5e, 0x84, 0x1c, 0x72, 0xdb, 0xbc, 0x01, 0xd5,
+	0xd0, 0x6d, 0x3f, 0xa7, 0xb1, 0xf8, 0x2e, 0x8e, 0x39, 0x20, 0x40, 0x88, 0xa0, 0xcc, 0xa7, 0x34,
+	0xc6, 0x7c, 0x16, 0xc6, 0x9a, 0x4f, 0x79, 0x9c, 0xf9, 0x54, 0x0c, 0xf3, 0x31, 0x0c, 0x03, 0xb2,
+	0x86, 0xa1, 0xcb, 0xba, 0x6a, 0xfa, 0x8e, 0x3c, 0x8d, 0xaf, 0xcd, 0xa6, 0xf1, 0x8b, 0x33, 0x68,
+	0xfc, 0xd2, 0x44, 0x8d, 0xaf, 0x8f, 0xd3, 0xf8, 0xe5, 0x09, 0x1a, 0xbf, 0x32, 0x59, 0xe3, 0xc9,
+	0x58, 0x8d, 0x5f, 0x9d, 0xa6, 0xf1, 0x5f, 0x86, 0x4a, 0xaa, 0xeb, 0x97, 0xa6, 0xe9, 0x7a, 0x8a,
+	0x6b, 0xa8, 0xf9, 0x9a, 0xa9, 0xe6, 0x5f, 0x86, 0x8a, 0x9a, 0x3c, 0x6b, 0xac, 0xe7, 0x8d, 0xa9,
+	0xef, 0x23, 0x29, 0xae, 0xe1, 0xd4, 0x2f, 0x1b, 0x4e, 0x9d, 0x9f, 0x72, 0xf9, 0xc1, 0x92, 0x35,
+	0x1a, 0x48, 0x4b, 0x34, 0xec, 0x2f, 0x01, 0x1c, 0x0d, 0xdf, 0x1f, 0xc4, 0x07, 0x81, 0xe7, 0xc7,
+	0xb3, 0xe7, 0x0f, 0xec, 0x1f, 0x14, 0xe0, 0xca, 0xdb, 0x34, 0x3e, 0x1a, 0x3e, 0xa1, 0xac, 0xfd,
+	0x8c, 0x46, 0xc7, 0x01, 0xa3, 0x0f, 0x74, 0xc7, 0x3f, 0x32, 0x8e, 0x69, 0x0d, 0x85, 0x29, 0xd6,
+	0x50, 0xcc, 0xb3, 0x06, 0x0c, 0x90, 0xe6, 0xb4, 0x00, 0x29, 0x55, 0xec, 0x79, 0x43, 0xb1, 0xe5,
+	0x91, 0xad, 0x94, 0x1e, 0xd9, 0x5e, 0x81, 0x15, 0x16, 0xbb, 0x51, 0xec, 0xf9, 0x5d, 0x9e, 0xa0,
+	0x0a, 0x22, 0xae, 0x30, 0xdc, 0x80, 0x2c, 0x67, 0x59, 0x7d, 0x38, 0x90, 0x70, 0xf2, 0x12, 0xd4,
+	0xe3, 0x20, 0x76, 0x7b, 0x2d, 0x3c, 0x55, 0xb6, 0xdc, 0x2e, 0x45, 0x8b, 0x2a, 0x3a, 0x8b, 0x08,
+	0xc6, 0x73, 0xe7, 0x5e, 0x97, 0xda, 0xff, 0x34, 0x37, 0x2a, 0x84, 0x9d, 0xff, 0x63, 0x42, 0xe0,
+	0xbe, 0xa0, 0x3d, 0x88, 0x22, 0x1e, 0xd0, 0x27, 0x63, 0x56, 0x70, 0xcc, 0xba, 0x84, 0x27, 0x43,
+	0xee, 0xc0, 0x42, 0x87, 0x86, 0xd4, 0xef, 0xb0, 0x06, 0xe4, 0x9c, 0xe7, 0x53, 0x45, 0x74, 0x14,
+	0x1e, 0x4f, 0xf4, 0xb8, 0x7e, 0x9b, 0xb2, 0x38, 0x88, 0xa4, 0x5a, 0x57, 0x51, 0x28, 0x8b, 0x0a,
+	0x2a, 0x94, 0xfb, 0x16, 0x24, 0x80, 0x56, 0x72, 0x80, 0x9f, 0x73, 0x6a, 0x0a, 0x88, 0xc2, 0xd3,
+	0x91, 0x4e, 0x28, 0x65, 0xd2, 0x17, 0x25, 0x48, 0x6f, 0x51, 0xca, 0xf8, 0x74, 0x3a, 0x94, 0xb5,
+	0xa9, 0xdf, 0x71, 0xfd, 0x58, 0x92, 0x5c, 0x42, 0x92, 0xf5, 0x14, 0x2e, 0x88, 0xbe, 0x0c, 0x1a,
+	0x48, 0x90, 0xad, 0x23, 0xd9, 0xa5, 0x14, 0x8c, 0x84, 0x4d, 0x44, 0x24, 0x2d, 0x7c, 0x95, 0x86,
+	0xc8, 0x89, 0xdb, 0xbf, 0xb0, 0x30, 0x15, 0xfb, 0x7e, 0x14, 0x9e, 0xba, 0xbe, 0x50, 0xab, 0xcf,
+	0x54, 0x9d, 0xb4, 0x05, 0x99, 0x9b, 0x6d, 0x41, 0xec, 0xbf, 0x28, 0xe0, 0x3e, 0x7b, 0x34, 0x3c,
+	0x08, 0x82, 0x5e, 0xc2, 0x9c, 0xee, 0x77, 0x2c, 0xd3, 0xef, 0xdc, 0x84, 0x5a, 0x80, 0xf3, 0x91,
+	0x9f, 0x05, 0x97, 0x55, 0x01, 0x13, 0x28, 0x36, 0x2c, 0xc6, 0xc3, 0x96, 0x36, 0x13, 0xb1, 0x9d,
+	0x56, 0xe3, 0xe1, 0x41, 0x32, 0x97, 0xdb, 0xb0, 0xc4, 0x71, 0xb4, 0xe9, 0x88, 0x50, 0xb0, 0x16,
+	0x0f, 0x0f, 0xd2, 0x09, 0xdd, 0x83, 0x15, 0x49, 0x4c, 0x1b, 0x4d, 0x98, 0x45, 0x5d, 0x7c, 0x48,
+	0x47, 0x7c, 0x15, 0x88, 0xc2, 0xd5, 0x46, 0x2d, 0x21, 0xf2, 0xb2, 0x44, 0x4e, 0x47, 0x5e, 0x86,
+	0x62, 0x3c, 0x54, 0x19, 0x2d, 0xfe, 0x93, 0xef, 0x3c, 0x02, 0x8b, 0x61, 0x3a, 0xab, 0xe2, 0xa8,
+	0xa6, 0xfd, 0x77, 0x45, 0xb8, 0x92, 0xc8, 0x68, 0xc4, 0x39, 0x7e, 0x2e, 0x2b, 0x4d, 0x56, 0x64,
+	0x0f, 0xa5, 0xc1, 0xed, 0x40, 0x25, 0xf9, 0x5e, 0x32, 0x74, 0x70, 0xec, 0x26, 0xc3, 0xa5, 0xc6,
+	0xe1, 0x8c, 0xbc, 0x9d, 0x48, 0x4d, 0x0c, 0x23, 0x7c, 0xcb, 0xed, 0xec, 0x30, 0x79, 0x56, 0xa5,
+	0x64, 0x8b, 0x03, 0xe5, 0xae, 0xdb, 0xce, 0xe7, 0xeb, 0xf6, 0xa9, 0xac, 0xdb, 0xce, 0x67, 0xb8,
+	0x6e, 0x7f, 0x60, 0xc1, 0xc6, 0x63, 0x1e, 0x7a, 0x77, 0x07, 0x11, 0x3d, 0x0c, 0xdd, 0x36, 0x7d,
+	0x97, 0xd2, 0x30, 0x3d, 0xb6, 0x36, 0xa1, 0xdc, 0x76, 0x43, 0xb7, 0xcd, 0xb7, 0x26, 0x71, 0x45,
+	0x94, 0xb4, 0xb9, 0xbf, 0x0f, 0xdd, 0x8b, 0x80, 0xef, 0x70, 0xc9, 0x4d, 0x41, 0x01, 0xa7, 0x5a,
+	0x17, 0xf0, 0x3d, 0x05, 0x26, 0xd7, 0x01, 0x42, 0x97, 0xb1, 0xf0, 0x34, 0x72, 0x19, 0x95, 0x89,
+	0x2e, 0x0d, 0x62, 0xff, 0xad, 0x05, 0x95, 0x6f, 0x06, 0xd1, 0x73, 0xe4, 0x40, 0x88, 0xae, 0xe3,
+	0xf9, 0x6e, 0x0f, 0x69, 0x16, 0x1d, 0xd5, 0xcc, 0x84, 0x9e, 0x85, 0x6c, 0xe8, 0x69, 0x5c, 0xcd,
+	0x58, 0xfa, 0xd5, 0x8c, 0x79, 0x8b, 0x36, 0x97, 0xb9, 0x45, 0xe3, 0x91, 0x1a, 0x8b, 0xdd, 0x58,
+	0x28, 0x43, 0xc5, 0x11, 0x0d, 0x71, 0xb6, 0x09, 0xba, 0xc9, 0x25, 0x89, 0xe5, 0x24, 0x6d, 0xfb,
+	0x3e, 0x2c, 0x27, 0x0c, 0x2b, 0x61, 0x5d, 0x81, 0x32, 0xe3, 0xed, 0x56, 0xb2, 0xd7, 0x2c, 0x60,
+	0x7b, 0xbf, 0x63, 0xff, 0xd0, 0x82, 0x15, 0x0d, 0x5f, 0xda, 0xc5, 0xab, 0x30, 0x8f, 0x08, 0x88,
+	0x5d, 0xdd, 0x5d, 0x37, 0xd6, 0x2f, 0x45, 0x17, 0x48, 0x7c, 0x0e, 0x34, 0x8a, 0x70, 0x37, 0xef,
+	0x24, 0x5b, 0x16, 0x42, 0x1e, 0x07, 0x1d, 0xdc, 0xa3, 0xc5, 0xe7, 0x3e, 0x65, 0x8c, 0xc7, 0x1c,
+	0x42, 0x04, 0x35, 0x04, 0x3e, 0x15, 0x30, 0xfb, 0xaf, 0x2c, 0x20, 0xc9, 0xc0, 0x2c, 0x61, 0xe4,
+	0x06, 0x54, 0x05, 0xe7, 0xba, 0x8d, 0x02, 0x82, 0x84, 0x0d, 0x6e, 0x41, 0x09, 0x5b, 0x4c, 0xe6,
+	0x0d, 0xc7, 0xb1, 0x2a, 0xb1, 0x32, 0xbc, 0x16, 0xa7, 0xf2, 0x3a, 0x97, 0xc3, 0xeb, 0xef, 0x41,
+	0x63, 0xaf, 0x1d, 0xbf, 0xef, 0x1b, 0x6a, 0x29, 0x19, 0x36, 0xc7, 0xb7, 0xa6, 0x8e, 0x5f, 0xc8,
+	0x19, 0xff, 0x1f, 0x2d, 0x58, 0x3c, 0xe8, 0x05, 0xf1, 0x07, 0x03, 0x3a, 0xa0, 0xfb, 0x31, 0xed,
+	0x4f, 0x58, 0x40, 0x1e, 0x2a, 0x76, 0xe8, 0x99, 0xd7, 0x4e, 0xae, 0x67, 0x45, 0x8b, 0xab, 0x5c,
+	0x34, 0xf0, 0x7d, 0xcf, 0xef, 0xe2, 0x2c, 0xcb, 0x8e, 0x6a, 0xa2, 0xf6, 0x04, 0xcc, 0xe3, 0xc7,
+	0x39, 0xa9, 0x70, 0x49, 0xdb, 0xd0, 0xac, 0x79, 0x53, 0xb3, 0xf8, 0xd4, 0xce, 0x83, 0x41, 0xaf,
+	0xd3, 0xea, 0x7b, 0xbe, 0x70, 0x38, 0x65, 0xa7, 0x82, 0x90, 0xa7, 0x9e, 0x8f, 0x31, 0x6b, 0x9f,
+	0xf6, 0x83, 0x48, 0x84, 0x9f, 0x73, 0x8e, 0x6c, 0xd9, 0xbf, 0x0b, 0x2b, 0xc9, 0x64, 0x12, 0x31,
+	0xad, 0x43, 0x29, 0x74, 0x07, 0x8c, 0x8a, 0xe9, 0x94, 0x1d, 0xd9, 0x22, 0x0f, 0x60, 0xde, 0x8b,
+	0x69, 0x5f, 0xad, 0x66, 0xd3, 0x3c, 0x21, 0xe9, 0x32, 0x71, 0x04, 0xa2, 0xfd, 0x14, 0xd6, 0xbf,
+	0x11, 0x76, 0xdc, 0x98, 0x6a, 0x44, 0x92, 0x8b, 0x6e, 0x71, 0x68, 0x95, 0x22, 0x93, 0x2d, 0x9e,
+	0xf8, 0x51, 0xc2, 0x54, 0x7e, 0xa1, 0x2c, 0xa5, 0xc9, 0xec, 0x7d, 0x20, 0x8f, 0xa5, 0x1f, 0x39,
+	0xe8, 0xb9, 0x62, 0x89, 0xb3, 0x56, 0x6a, 0xe5, 0x58, 0xa9, 0xbe, 0x49, 0x88, 0x86, 0xfd, 0xfd,
+	0x02, 0xd4, 0xf5, 0xb1, 0x9e, 0x78, 0x11, 0x77, 0xc7, 0x1d, 0x4f, 0xdd, 0x83, 0xf3, 0x9f, 0x63,
+	0xd7, 0xef, 0x06, 0x54, 0x45, 0xac, 0x7e, 0x7c, 0x11, 0x53, 0x95, 0x8d, 0x00, 0x04, 0x3d, 0xba,
+	0x88, 0x05, 0x4f, 0x27, 0x11, 0xa5, 0xf2, 0xbb, 0xd8, 0x55, 0x2a, 0x1c, 0x22, 0x3e, 0xe3, 0x75,
+	0x2a, 0xa3, 0xd1, 0x19, 0xed, 0x48, 0x14, 0x99, 0x48, 0x53, 0x50, 0x81, 0xc6, 0x0f, 0xea, 0x3d,
+	0xd7, 0xf7, 0x13, 0x2c, 0xb1, 0x91, 0xd4, 0x24, 0x50, 0x20, 0x7d, 0x39, 0x31, 0xb2, 0x85, 0xcd,
+	0xe2, 0xc8, 0xf5, 0xe8, 0xa8, 0xbc, 0x94, 0xb5, 0x71, 0xf7, 0x79, 0x49, 0xff, 0x9c, 0xac, 0xff,
+	0x65, 0x58, 0xe0, 0x14, 0x52, 0x7d, 0x2e, 0xf1, 0xe6, 0x3e, 0x57, 0x80, 0xb9, 0x8e, 0x17, 0xa9,
+	0xf5, 0xbf, 0x3a, 0x96, 0xd0, 0x13, 0x2f, 0x72, 0x10, 0x73, 0x74, 0x06, 0xc5, 0x9c, 0x19, 0xbc,
+	0x0c, 0x75, 0xf7, 0xcc, 0xf5, 0x7a, 0xee, 0x71, 0xcf, 0x94, 0xd8, 0x52, 0x02, 0x46, 0x44, 0xfb,
+	0xf7, 0xa1, 0xb1, 0x17, 0x86, 0xbd, 0x0b, 0x93, 0x6b, 0xa1, 0x50, 0x63, 0x99, 0xfe, 0x14, 0x37,
+	0x9c, 0xaf, 0xc0, 0xfa, 0x23, 0xea, 0xb7, 0x4f, 0xfb, 0x6e, 0xf4, 0x1c, 0x73, 0x12, 0x4c, 0x51,
+	0xbf, 0x0e, 0x90, 0xa4, 0x3a, 0x98, 0xf2, 0x84, 0x29, 0xc4, 0xfe, 0xbe, 0xb8, 0x99, 0x09, 0x4e,
+	0xde, 0x73, 0x63, 0xea, 0xb7, 0x2f, 0x52, 0xad, 0xb4, 0x34, 0xad, 0x24, 0x6b, 0x50, 0x0a, 0x1f,
+	0x3e, 0x68, 0xf5, 0x45, 0xf9, 0x85, 0xe5, 0xcc, 0x87, 0x0f, 0x1f, 0x3c, 0x65, 0x08, 0x7e, 0xfd,
+	0x21, 0x07, 0x17, 0x25, 0xf8, 0xf5, 0x87, 0x0a, 0xfc, 0x7a, 0xab, 0x2f, 0xc4, 0x85, 0xe0, 0xd7,
+	0x05, 0xb8, 0xef, 0x0e, 0x5b, 0x7d, 0xa1, 0x54, 0x96, 0x33, 0xdf, 0x77, 0x87, 0x4f, 0x99, 0xfd,
+	0x37, 0x16, 0xac, 0xa0, 0x06, 0x18, 0x7c, 0x4c, 0x70, 0x5e, 0xd2, 0x1c, 0x0a, 0x86, 0x39, 0xa0,
+	0x2f, 0x54, 0x75, 0x05, 0xb2, 0x45, 0xbe, 0x08, 0x0b, 0x3d, 0x31, 0x5e, 0x63, 0x2e, 0x3f, 0x79,
+	0x92, 0x10, 0x74, 0x14, 0x26, 0xa6, 0x8b, 0x82, 0x28, 0x1a, 0x84, 0x31, 0x55, 0x35, 0x07, 0x29,
+	0xc0, 0xfe, 0x6d, 0xa8, 0x3f, 0xf1, 0x22, 0x83, 0xd5, 0x51, 0xf3, 0xd4, 0xe8, 0x16, 0x66, 0xa5,
+	0x6b, 0xff, 0xac, 0x00, 0x97, 0x47, 0x56, 0x51, 0x6a, 0xfe, 0x94, 0x65, 0xe4, 0xde, 0xe9, 0x78,
+	0xd0, 0xe9, 0xd2, 0x38, 0x5d, 0xa2, 0xb2, 0x00, 0x3c, 0x65, 0xe4, 0x2b, 0x00, 0xfc, 0x0b, 0x66,
+	0xa9, 0x58, 0xa3, 0x38, 0x8d, 0xa1, 0x4a, 0x97, 0xc6, 0x82, 0x3c, 0xf9, 0x52, 0x62, 0xc2, 0xe2,
+	0x54, 0x78, 0xdd, 0xe8, 0x35, 0xb2, 0x68, 0xc9, 0x7e, 0xa9, 0xec, 0x71, 0x3e, 0xc7, 0x1e, 0x33,
+	0xd2, 0x93, 0xf6, 0xd8, 0x84, 0xf2, 0xb9, 0x1b, 0xf1, 0x9d, 0x86, 0x61, 0x6d, 0x41, 0xc5, 0x49,
+	0xda, 0xf6, 0x6f, 0xc1, 0xda, 0xa1, 0xd7, 0x1f, 0x70, 0x39, 0x3d, 0xf5, 0x38, 0x48, 0x29, 0x77,
+	0xbe, 0xae, 0x4e, 0xf4, 0xd4, 0x7f, 0x6d, 0xc1, 0x92, 0x1a, 0xac, 0x83, 0xd9, 0xdf, 0xb1, 0x55,
+	0x43, 0xba, 0x06, 0x16, 0x4c, 0x0d, 0xe4, 0x37, 0x2c, 0xbd, 0x20, 0x96, 0x4e, 0x03, 0x7f, 0x63,
+	0xd0, 0xd1, 0x0b, 0x62, 0xd6, 0x72, 0x4f, 0xa9, 0xdb, 0x91, 0x8e, 0x02, 0x10, 0xb4, 0xc7, 0x21,
+	0x7a, 0x82, 0x72, 0xde, 0x4c, 0x50, 0xa6, 0x29, 0xcd, 0x92, 0x9e, 0xd2, 0xb4, 0x7f, 0x65, 0xc1,
+	0x7a, 0x76, 0xe6, 0x52, 0x21, 0x6e, 0x42, 0x0d, 0x13, 0x35, 0x2d, 0x83, 0xf5, 0x2a, 0xc2, 0xde,
+	0x49, 0xaa, 0x9e, 0xa8, 0xdf, 0x69, 0x19, 0x05, 0x64, 0x15, 0xea, 0x77, 0xe4, 0xe7, 0x75, 0x28,
+	0x61, 0xba, 0x3d, 0xb1, 0x19, 0xd1, 0xe2, 0xf0, 0x64, 0xcd, 0x11, 0x2e, 0x5a, 0xe4, 0x3e, 0x14,
+	0xcf, 0x03, 0x55, 0x1d, 0x62, 0x5e, 0xb4, 0x9a, 0x02, 0x75, 0x38, 0x1e, 0x97, 0xde, 0xb9, 0xe7,
+	0xb7, 0x22, 0x1e, 0x86, 0x8a, 0x68, 0x73, 0xe1, 0xdc, 0xf3, 0x1d, 0x1e, 0x88, 0xde, 0x85, 0x65,
+	0x3a, 0x0c, 0x69, 0x3b, 0xa6, 0x1d, 0x5e, 0xda, 0xd3, 0xea, 0xb8, 0x2a, 0xf9, 0xb4, 0xa4, 0xe0,
+	0x07, 0x34, 0x7a, 0xe2, 0x5e, 0xd8, 0x0f, 0xf0, 0x46, 0xe9, 0xeb, 0x34, 0x3e, 0xcf, 0x06, 0xa7,
+	0x29, 0xf7, 0x96, 0xce, 0xbd, 0xfd, 0x8b, 0x22, 0x5c, 0x1e, 0xe9, 0xf2, 0x69, 0xca, 0x4c, 0x2e,
+	0x54, 0xd1, 0xc8, 0x3d, 0xdf, 0x82, 0x45, 0x5f, 0x50, 0x6c, 0x89, 0x08, 0x58, 0xf8, 0xbd, 0x9a,
+	0xaf, 0xb1, 0x41, 0x76, 0x61, 0x4d, 0x21, 0xc9, 0x85, 0x97, 0xc8, 0xc2, 0x1b, 0xae, 0xca, 0x8f,
+	0x1f, 0x88, 0x6f, 0xa2, 0xcf, 0xab, 0x40, 0xfa, 0xb8, 0xf0, 0x2d, 0x3d, 0xa0, 0x2d, 0xe1, 0x94,
+	0x97, 0xc5, 0x97, 0xc3, 0x34, 0xac, 0xbd, 0x09, 0x35, 0x1d, 0x5b, 0x86, 0x54, 0x55, 0x0d, 0x8f,
+	0x3c, 0x80, 0x4b, 0x12, 0xc5, 0xe4, 0xa1, 0x8c, 0x3c, 0x48, 0x62, 0x06, 0x0b, 0xfc, 0x30, 0x71,
+	0xea, 0x46, 0x54, 0xe6, 0xf2, 0x44, 0x83, 0xbc, 0x0e, 0x57, 0x92, 0x35, 0x64, 0xb4, 0x1d, 0xf8,
+	0x1d, 0xd6, 0x8a, 0x83, 0x96, 0x28, 0x39, 0x02, 0xc4, 0x5c, 0x57, 0x08, 0x87, 0xe2, 0xfb, 0x51,
+	0x80, 0xea, 0x61, 0xff, 0xc8, 0x82, 0xf5, 0x24, 0xc4, 0x3e, 0x8c, 0xdd, 0x98, 0xea, 0x27, 0xeb,
+	0x71, 0x4e, 0xbf, 0xc1, 0x33, 0x54, 0xcc, 0x8b, 0x68, 0x62, 0x8c, 0xb2, 0x89, 0xf9, 0x30, 0xb7,
+	0xab, 0xca, 0xd7, 0xf0, 0x37, 0x8f, 0x63, 0xcc, 0xbd, 0x55, 0x86, 0xe4, 0x8b, 0xc6, 0xce, 0x6a,
+	0x33, 0xd8, 0x10, 0x61, 0x60, 0x96, 0x9f, 0x69, 0x27, 0xa0, 0x84, 0x68, 0x61, 0x22, 0xd1, 0x62,
+	0x1e, 0xd1, 0x9f, 0xd4, 0x50, 0x45, 0x1f, 0xf7, 0x3c, 0xea, 0xc7, 0x87, 0x58, 0x30, 0x94, 0x08,
+	0x20, 0x53, 0x7b, 0x51, 0x49, 0xaf, 0x6e, 0xf8, 0xe0, 0x94, 0x46, 0x78, 0x4b, 0x40, 0x31, 0x40,
+	0x2f, 0x60, 0x0c, 0xbc, 0xc8, 0xa1, 0xef, 0x29, 0x20, 0x1f, 0x80, 0x5d, 0xf8, 0x6d, 0x2d, 0x80,
+	0x97, 0x4d, 0x8c, 0xb4, 0x71, 0x75, 0x51, 0x14, 0x65, 0x47, 0xb6, 0xb8, 0xee, 0x8a, 0x49, 0x3e,
+	0xa7, 0x34, 0xe4, 0x9f, 0xe7, 0xf1, 0x73, 0x8d, 0xa9, 0x73, 0x0a, 0x47, 0xd2, 0x6f, 0x9b, 0x4a,
+	0xe6, 0x6d, 0xd3, 0x3d, 0x58, 0xe9, 0x05, 0x6d, 0x1e, 0x72, 0x52, 0x96, 0x98, 0x96, 0xd0, 0xbc,
+	0x3a, 0x7e, 0xe0, 0x65, 0xaa, 0xd2, 0x7e, 0xee, 0xc1, 0xca, 0x73, 0x3f, 0x38, 0xf7, 0x0d, 0x5c,
+	0x71, 0x47, 0x55, 0xc7, 0x0f, 0x1a, 0x2e, 0x0f, 0x22, 0x76, 0x43, 0x4e, 0x50, 0x5c, 0xa1, 0xce,
+	0x87, 0xbb, 0xe1, 0x7e, 0x87, 0x7c, 0x00, 0x80, 0x72, 0x10, 0x96, 0x00, 0xb8, 0x99, 0xed, 0x66,
+	0x33, 0x05, 0x79, 0xb2, 0xdd, 0xe2, 0xdd, 0xd0, 0x4e, 0x78, 0xe1, 0x9b, 0x53, 0x49, 0x9a, 0xe4,
+	0x31, 0xcc, 0xf3, 0x86, 0xa8, 0x04, 0xab, 0xee, 0xde, 0x9f, 0x79, 0x34, 0x2e, 0x76, 0x47, 0xf4,
+	0x1d, 0x75, 0x01, 0xb5, 0x1c, 0x17, 0x90, 0x1c, 0x4c, 0x85, 0x45, 0x2d, 0x22, 0x8a, 0x38, 0x98,
+	0x1e, 0x4e, 0x37, 0xab, 0xa5, 0x49, 0x66, 0x45, 0x8e, 0x93, 0xc2, 0xc4, 0x33, 0xb7, 0xe7, 0x75,
+	0xf0, 0xea, 0x11, 0xd3, 0xd0, 0xd5, 0xdd, 0x87, 0x33, 0xcd, 0x48, 0xf4, 0x7e, 0x96, 0x74, 0x76,
+	0x96, 0xb3, 0x90, 0xe6, 0xb7, 0x60, 0xd1, 0x90, 0x22, 0xde, 0x25, 0xf1, 0xc3, 0x85, 0xda, 0x81,
+	0xb1, 0xc1, 0x37, 0xf3, 0x60, 0x10, 0x1f, 0x07, 0x03, 0xbf, 0x23, 0x0f, 0x37, 0x49, 0x9b, 0x2b,
+	0xa8, 0xe7, 0x8b, 0x4f, 0xb2, 0x06, 0x54, 0x36, 0x9b, 0x0e, 0x94, 0xf9, 0xe0, 0x38, 0x6e, 0xe6,
+	0xba, 0x56, 0x4f, 0x85, 0x14, 0xcc, 0x54, 0xc8, 0x55, 0xa8, 0x74, 0xbc, 0x88, 0x8a, 0x23, 0x9b,
+	0x2c, 0x88, 0x4a, 0x00, 0xcd, 0x5f, 0x5a, 0x50, 0x56, 0x2b, 0x45, 0xf6, 0x35, 0xb6, 0x44, 0xb5,
+	0xd1, 0xec, 0x4b, 0x8d, 0x3a, 0x93, 0xce, 0xe2, 0xed, 0x74, 0x16, 0x85, 0x8f, 0x33, 0x92, 0xea,
+	0xcd, 0x75, 0x0f, 0xcb, 0x92, 0x1a, 0xc5, 0x8f, 0x33, 0x8c, 0xe8, 0xdb, 0xfc, 0x87, 0x22, 0x8c,
+	0xac, 0x15, 0x16, 0x1b, 0x07, 0xd1, 0x73, 0xae, 0xd7, 0x32, 0x49, 0x29, 0x9b, 0xfc, 0xe0, 0x21,
+	0x55, 0x84, 0x76, 0x5a, 0xb2, 0xac, 0x52, 0x6c, 0x75, 0xf5, 0x04, 0x2e, 0x4a, 0x2a, 0xc9, 0x36,
+	0xac, 0xf2, 0xfb, 0xef, 0x2c, 0xb6, 0x88, 0x7b, 0x88, 0xfe, 0x49, 0x76, 0xb8, 0x07, 0x2b, 0xcc,
+	0xeb, 0xb6, 0xda, 0x6e, 0xfb, 0x94, 0xb6, 0xa8, 0x1f, 0x47, 0x5e, 0x72, 0x68, 0xaa, 0x33, 0xaf,
+	0xfb, 0x98, 0xc3, 0xdf, 0x14, 0x60, 0xb2, 0x03, 0x6b, 0x29, 0x2e, 0x3f, 0x19, 0x28, 0x7c, 0x71,
+	0xe6, 0x24, 0x0a, 0xff, 0xa9, 0x3b, 0x54, 0x5d, 0x6e, 0xc3, 0x52, 0xda, 0xe5, 0xd4, 0x8b, 0x93,
+	0x93, 0xa7, 0xc2, 0x7d, 0xc7, 0x8b, 0x19, 0x0f, 0x30, 0xb4, 0x81, 0x3d, 0x59, 0xf4, 0x8b, 0x07,
+	0xb7, 0x64, 0x4c, 0x84, 0x92, 0x57, 0x80, 0x18, 0xe3, 0x89, 0x78, 0x45, 0x6c, 0x86, 0x75, 0x6d,
+	0x4c, 0x8c, 0x5b, 0xb6, 0x60, 0xd5, 0x65, 0x6c, 0xd0, 0xa7, 0xc2, 0xc2, 0x5a, 0xc6, 0x55, 0xfa,
+	0x8a, 0xf8, 0x84, 0x0b, 0x90, 0x7a, 0x3b, 0x13, 0x9f, 0xdf, 0xe1, 0x8b, 0x42, 0x8f, 0xba, 0x8e,
+	0xcd, 0x8b, 0x67, 0xde, 0x04, 0xf2, 0xc1, 0xc0, 0x93, 0xcb, 0x3d, 0x6b, 0x5e, 0x68, 0x19, 0x8a,
+	0x7d, 0xd6, 0x55, 0x07, 0xa1, 0x3e, 0xeb, 0xda, 0x47, 0xbc, 0x60, 0xc7, 0xa7, 0x7c, 0x16, 0xe8,
+	0x15, 0xd8, 0xe4, 0x50, 0x79, 0x74, 0xc7, 0x2a, 0xe4, 0xed, 0x58, 0x18, 0x86, 0x99, 0xa3, 0xa6,
+	0x19, 0x19, 0x3e, 0x2b, 0xaa, 0x8a, 0xd4, 0x65, 0xcb, 0xfe, 0x5a, 0xfa, 0x92, 0xe0, 0x88, 0xf6,
+	0xc3, 0x9e, 0xb6, 0xa9, 0x8e, 0xd2, 0xb4, 0xf2, 0x68, 0xfe, 0xab, 0x05, 0xab, 0x46, 0xff, 0x83,
+	0xa0, 0xe7, 0xb5, 0x2f, 0xb0, 0xbe, 0x3e, 0xe6, 0x9c, 0x74, 0x2f, 0x64, 0xc7, 0xa4, 0xcd, 0xb5,
+	0x43, 0xd4, 0x9c, 0xf4, 0xcd, 0x9b, 0xb0, 0x1a, 0x42, 0x9f, 0xa6, 0xc9, 0x75, 0x89, 0xe5, 0x0e,
+	0xf5, 0xfb, 0x30, 0x89, 0xe5, 0x0e, 0x11, 0x6b, 0x0b, 0x56, 0x05, 0x96, 0xba, 0xcb, 0xd4, 0x8b,
+	0x37, 0x56, 0xf0, 0x93, 0xba, 0xce, 0x94, 0x37, 0x68, 0x97, 0xd2, 0x5c, 0x81, 0xe4, 0x48, 0xe8,
+	0x32, 0x97, 0xcb, 0x6a, 0xf2, 0xed, 0x30, 0xf9, 0x64, 0xff, 0xdc, 0x82, 0xba, 0x31, 0xc5, 0x31,
+	0x85, 0xfb, 0xf2, 0xfe, 0xb6, 0x90, 0xde, 0xdf, 0x6e, 0x40, 0xe5, 0x84, 0xd2, 0x56, 0x87, 0xf6,
+	0x64, 0xa1, 0x65, 0xd1, 0x29, 0x9f, 0x50, 0xfa, 0x84, 0xb7, 0x93, 0xf2, 0xaf, 0x39, 0xad, 0xfc,
+	0x8b, 0x07, 0xf5, 0x5e, 0x37, 0x08, 0x99, 0x2c, 0x09, 0x96, 0x2d, 0x11, 0x55, 0x89, 0x7b, 0x3f,
+	0x71, 0xea, 0x52, 0x4d, 0xfb, 0x3f, 0x8b, 0xe2, 0x8d, 0x89, 0xb9, 0x84, 0xda, 0xb2, 0x8f, 0x39,
+	0x32, 0xfd, 0xfa, 0x35, 0x5d, 0x23, 0x75, 0x57, 0x73, 0x39, 0x75, 0x57, 0x79, 0x15, 0xcd, 0x63,
+	0x8e, 0x4d, 0x66, 0xbd, 0xc7, 0x42, 0xb6, 0xde, 0x23, 0xaf, 0x64, 0xa5, 0x3c, 0x5b, 0xc9, 0x4a,
+	0x65, 0x86, 0x92, 0x15, 0xc8, 0x29, 0x59, 0xd9, 0x80, 0x8a, 0xc8, 0xca, 0xf1, 0x65, 0x14, 0x15,
+	0x34, 0x65, 0x04, 0xbc, 0x45, 0x69, 0x6e, 0xa5, 0xb9, 0x7e, 0xc3, 0xb4, 0x68, 0xde, 0x30, 0x6d,
+	0x89, 0xab, 0x99, 0xa5, 0x9c, 0x93, 0x75, 0x46, 0x97, 0xc4, 0xc5, 0xcd, 0x57, 0xa0, 0x14, 0xa2,
+	0xe5, 0xc8, 0x58, 0x60, 0x73, 0x7c, 0x17, 0x61, 0x61, 0x8e, 0xc4, 0xb7, 0xff, 0x3f, 0x5c, 0x3b,
+	0xa4, 0x71, 0x1e, 0x46, 0x7a, 0x9b, 0x32, 0xce, 0x14, 0xed, 0xf7, 0xe1, 0xaa, 0x34, 0x0f, 0x8f,
+	0xd1, 0x23, 0x4d, 0xae, 0x69, 0x01, 0xe1, 0x88, 0x9e, 0x1b, 0x5a, 0x5d, 0x30, 0xb5, 0xda, 0xf6,
+	0xe0, 0xda, 0x98, 0x01, 0x27, 0x5c, 0x8b, 0x4f, 0x1a, 0x91, 0x27, 0xe6, 0x3c, 0xbf, 0x15, 0x06,
+	0x41, 0x4f, 0x86, 0xca, 0x25, 0xcf, 0xe7, 0xf7, 0x7d, 0x76, 0x17, 0x56, 0x0f, 0xdd, 0x33, 0xfa,
+	0x94, 0xf6, 0xc3, 0x4f, 0xef, 0x6a, 0x9b, 0xc0, 0x5c, 0xe8, 0xca, 0x27, 0x4f, 0x15, 0x07, 0x7f,
+	0xdb, 0xbf, 0x2c, 0xa0, 0x9b, 0xc4, 0xdb, 0xf5, 0x43, 0x2a, 0xe2, 0xd2, 0x69, 0x26, 0xc6, 0x93,
+	0xca, 0x3c, 0x78, 0x16, 0x21, 0xa1, 0x7a, 0xcb, 0xa4, 0x5e, 0x82, 0x11, 0x1b, 0x6a, 0x9a, 0x16,
+	0x27, 0x69, 0x4d, 0x1d, 0x86, 0x76, 0x33, 0x0c, 0xf8, 0x3e, 0x2e, 0x8b, 0x28, 0x45, 0x8b, 0xcf,
+	0x42, 0xa8, 0xa9, 0xdb, 0xc7, 0x59, 0x88, 0x2c, 0x85, 0x48, 0x28, 0xef, 0xf5, 0xd5, 0x86, 0xc1,
+	0x62, 0xf7, 0x39, 0x3f, 0x3f, 0x4a, 0x24, 0xf9, 0xdc, 0x46, 0x42, 0x53, 0xb4, 0x63, 0xcf, 0xef,
+	0x68, 0x68, 0xc2, 0x0c, 0x17, 0x25, 0x54, 0xa2, 0xbd, 0x0c, 0x75, 0x46, 0x23, 0xcf, 0xed, 0x79,
+	0xdf, 0xe5, 0xf1, 0x2e, 0xb7, 0x82, 0xb2, 0xdc, 0xa6, 0x13, 0xb0, 0xaa, 0x9b, 0xe0, 0x9e, 0xa1,
+	0x95, 0x82, 0xa5, 0x2d, 0x2e, 0x71, 0xf0, 0x61, 0x02, 0x4d, 0xa4, 0x0c, 0x9a, 0x94, 0xbf, 0x0a,
+	0xd7, 0xd2, 0xb2, 0x54, 0x2e, 0xc3, 0x47, 0x17, 0xea, 0x55, 0x8e, 0xd0, 0x45, 0xf3, 0x1a, 0xce,
+	0xca, 0x5c, 0xc3, 0xd9, 0x6f, 0xc0, 0xf5, 0x71, 0xfd, 0xd3, 0x53, 0x9b, 0x58, 0x1d, 0xb1, 0x0d,
+	0xce, 0x39, 0xaa, 0x69, 0xbf, 0x8a, 0x55, 0x86, 0x8f, 0x03, 0xcf, 0x3f, 0x76, 0x19, 0x9d, 0xf6,
+	0x98, 0xee, 0xa7, 0x16, 0xd4, 0x14, 0xee, 0x7f, 0xcb, 0x83, 0xa4, 0xbc, 0x77, 0x58, 0xf6, 0xcf,
+	0x8a, 0xb0, 0x6a, 0x4c, 0x62, 0x82, 0xc1, 0xfd, 0xe6, 0x1e, 0x2b, 0xdd, 0x02, 0xa5, 0x4c, 0x2d,
+	0x21, 0x22, 0xa1, 0xad, 0x35, 0x09, 0x7c, 0x86, 0x92, 0x92, 0x2f, 0x9a, 0x4a, 0x93, 0x5e, 0x34,
+	0xdd, 0x97, 0x2f, 0x9a, 0xc4, 0x25, 0x85, 0x99, 0x17, 0xd5, 0x17, 0x43, 0xbe, 0x6c, 0xd2, 0x1e,
+	0x16, 0x95, 0xa7, 0x3c, 0x2c, 0xaa, 0x4c, 0x7a, 0x58, 0x04, 0x9a, 0xbb, 0xbf, 0x06, 0x90, 0xec,
+	0x0f, 0x4c, 0xbd, 0x75, 0x51, 0x1b, 0x04, 0xd3, 0x5e, 0x19, 0xd5, 0xf4, 0x57, 0x46, 0xf6, 0x00,
+	0xd6, 0xde, 0x1c, 0x86, 0x41, 0x14, 0xbf, 0x4b, 0x2f, 0x58, 0x1c, 0x44, 0x89, 0x7e, 0x6d, 0x40,
+	0xe5, 0x9c, 0x6f, 0x77, 0x71, 0x9a, 0xb8, 0x28, 0x0b, 0xc0, 0x7e, 0x27, 0x73, 0x97, 0x50, 0xc8,
+	0xde, 0x25, 0xf0, 0x33, 0x2a, 0xc5, 0x51, 0x5b, 0x9a, 0xbf, 0x02, 0x01, 0x3a, 0xe0, 0xf6, 0xf4,
+	0x1a, 0xac, 0x67, 0xc9, 0x4a, 0x8d, 0x68, 0x42, 0xf9, 0xb9, 0x84, 0x29, 0xb2, 0xaa, 0x6d, 0xff,
+	0xa1, 0x05, 0x6b, 0xfb, 0xfd, 0x3c, 0x6e, 0x6f, 0x40, 0xd5, 0xeb, 0xa7, 0x04, 0x45, 0x47, 0xf0,
+	0xfa, 0x8a, 0x20, 0xf7, 0x26, 0x41, 0xaf, 0xd3, 0x1a, 0xe1, 0x7a, 0x31, 0xe8, 0x75, 0x0e, 0x52,
+	0xc6, 0xef, 0xc0, 0x92, 0x4f, 0xcf, 0x5b, 0x23, 0x17, 0x25, 0x8b, 0x3e, 0x3d, 0x4f, 0xd1, 0x6c,
+	0x0a, 0xeb, 0xfb, 0xfd, 0x5c, 0xf6, 0x53, 0x39, 0xcb, 0xeb, 0x45, 0xd1, 0x32, 0xc5, 0x59, 0xc8,
+	0x88, 0x73, 0x1d, 0x4a, 0x11, 0xe5, 0x19, 0x7b, 0x95, 0x12, 0x14, 0x2d, 0xfb, 0x09, 0x2c, 0x7e,
+	0x13, 0x71, 0x0e, 0x07, 0xfd, 0xbe, 0x1b, 0x5d, 0x4c, 0x5e, 0x94, 0x74, 0x94, 0x82, 0x31, 0xca,
+	0xbb, 0x68, 0x7a, 0x23, 0x9c, 0xbe, 0x06, 0x0b, 0xa2, 0x2b, 0x6b, 0x58, 0x39, 0x57, 0x9e, 0x06,
+	0x61, 0x47, 0xa1, 0xda, 0x0f, 0x61, 0xf5, 0x1b, 0x3e, 0x37, 0x1e, 0xf1, 0x5d, 0xbb, 0x22, 0xd2,
+	0x64, 0x66, 0x8d, 0x5c, 0x2e, 0xbd, 0x05, 0x97, 0xcc, 0x6e, 0xa9, 0xd7, 0x63, 0x83, 0x76, 0x5b,
+	0x45, 0xf0, 0x65, 0x47, 0x35, 0xb9, 0xdb, 0xc2, 0x43, 0x8a, 0x7a, 0xa1, 0x8a, 0x0d, 0xfb, 0x09,
+	0x90, 0xf7, 0x3e, 0xf9, 0x28, 0xdf, 0x86, 0xc6, 0xe3, 0x53, 0xd7, 0xef, 0xd2, 0x83, 0xc8, 0x3b,
+	0xe3, 0x21, 0x89, 0xcb, 0x92, 0x43, 0x0e, 0xdf, 0x86, 0xb9, 0xa2, 0x44, 0xde, 0x59, 0xe8, 0xca,
+	0x01, 0x2b, 0x4e, 0x95, 0xab, 0x89, 0x04, 0x71, 0x14, 0x54, 0x12, 0x85, 0x22, 0xc6, 0xae, 0x72,
+	0x15, 0x91, 0x20, 0xfb, 0x21, 0x5c, 0xc9, 0xa1, 0x30, 0x8d, 0x5d, 0xfb, 0x5b, 0x70, 0x59, 0x76,
+	0x43, 0x6f, 0xaa, 0xf3, 0x75, 0x03, 0xaa, 0xc8, 0xd7, 0xe0, 0x58, 0x63, 0x0b, 0x38, 0x5b, 0x02,
+	0xc2, 0x11, 0x90, 0xab, 0xc1, 0xb1, 0xc6, 0x14, 0x70, 0xa6, 0x04, 0xc4, 0x7e, 0x0d, 0x1a, 0xa3,
+	0x83, 0x4f, 0x63, 0x69, 0xf7, 0xef, 0x5f, 0x05, 0xd8, 0x0b, 0xbd, 0x43, 0x1a, 0xe1, 0xe5, 0xf0,
+	0x31, 0xd4, 0xf4, 0x57, 0xe3, 0x64, 0x7d, 0x4b, 0x3c, 0x89, 0xdf, 0x4a, 0x14, 0xe7, 0x4d, 0xfe,
+	0x24, 0xbe, 0x79, 0x33, 0x9b, 0x84, 0x18, 0x79, 0xac, 0x6e, 0x5f, 0xfe, 0xc1, 0xbf, 0xfc, 0xdb,
+	0x4f, 0x0b, 0x2b, 0xa4, 0xbe, 0x7d, 0xb6, 0xb3, 0x2d, 0x52, 0xef, 0xdb, 0x3c, 0x08, 0x21, 0xc7,
+	0x50, 0x56, 0x9b, 0x25, 0xb9, 0x3a, 0x32, 0x8e, 0x56, 0x93, 0xdf, 0xbc, 0x36, 0xe6, 0xab, 0xa4,
+	0x70, 0x05, 0x29, 0xac, 0x92, 0x15, 0x8d, 0xc2, 0x47, 0x7c, 0xb7, 0x7f, 0x41, 0x7e, 0x6c, 0x89,
+	0x27, 0xf4, 0xd9, 0x67, 0xf7, 0xe4, 0x6e, 0xee, 0x90, 0x39, 0x0f, 0xfa, 0x9b, 0x5f, 0x98, 0x01,
+	0x53, 0x32, 0xb2, 0x89, 0x8c, 0x34, 0x49, 0x43, 0x63, 0x84, 0xf3, 0xb1, 0xfd, 0x91, 0xd8, 0xb5,
+	0x5f, 0x90, 0x8f, 0xd2, 0xc7, 0x64, 0x09, 0x2b, 0xb7, 0x73, 0x09, 0x64, 0xd9, 0x98, 0x22, 0x03,
+	0x1b, 0x49, 0x5f, 0x25, 0x4d, 0x9d, 0x34, 0x0e, 0xa0, 0x13, 0x5f, 0x32, 0x1f, 0xdd, 0x10, 0x3b,
+	0x7f, 0x6e, 0xfa, 0xfb, 0x9d, 0xe6, 0xad, 0x89, 0x38, 0x13, 0x66, 0x2e, 0x96, 0x60, 0xfb, 0x54,
+	0x90, 0xfa, 0x53, 0x4b, 0x7f, 0xf2, 0xa3, 0xc7, 0x46, 0xe4, 0xde, 0x18, 0x0a, 0x39, 0x01, 0x58,
+	0xf3, 0x95, 0x99, 0x70, 0x25, 0x57, 0x2f, 0x21, 0x57, 0x9b, 0xe4, 0xba, 0xc6, 0x55, 0x38, 0x38,
+	0x7e, 0x4e, 0x2f, 0xb6, 0x3f, 0x4a, 0x23, 0xa0, 0x17, 0xe4, 0x04, 0x40, 0x8d, 0xf4, 0x6c, 0x97,
+	0x5c, 0x9f, 0xa4, 0x8b, 0xcf, 0x76, 0x9b, 0x37, 0x26, 0xae, 0xc4, 0xb3, 0x5d, 0x5d, 0xe3, 0x77,
+	0x13, 0x61, 0x78, 0x9d, 0x17, 0xe4, 0x1c, 0x96, 0x4d, 0xf9, 0xcd, 0x40, 0x6d, 0x26, 0xf1, 0x5f,
+	0x47, 0x8a, 0x0d, 0xb2, 0x9e, 0xa1, 0xa8, 0x84, 0x7f, 0x96, 0xbe, 0x60, 0x51, 0xa5, 0x75, 0x33,
+	0x90, 0x9e, 0xa2, 0x72, 0x37, 0x91, 0xe8, 0x06, 0xb9, 0x92, 0x25, 0x7a, 0x26, 0x48, 0x6c, 0xef,
+	0x90, 0xef, 0x41, 0x55, 0x0b, 0x07, 0xc9, 0x88, 0xe4, 0x32, 0xd1, 0x6e, 0x73, 0x73, 0x3c, 0x82,
+	0x24, 0x7a, 0x0f, 0x89, 0xde, 0x26, 0x36, 0x5f, 0x52, 0xfd, 0xa8, 0xb2, 0xdd, 0x96, 0xa8, 0xa9,
+	0xbe, 0x5f, 0x70, 0x7d, 0xd7, 0x73, 0x51, 0x23, 0xfa, 0x9e, 0x93, 0xfe, 0x6a, 0xde, 0x9a, 0x88,
+	0x63, 0x0a, 0xdc, 0x5e, 0xd5, 0x34, 0xab, 0x2b, 0x51, 0xdf, 0xb0, 0xee, 0x91, 0xef, 0xa5, 0x2b,
+	0xad, 0x0e, 0xc4, 0x63, 0xec, 0x3c, 0x93, 0xf3, 0x6a, 0xde, 0x99, 0x82, 0x25, 0x19, 0xd8, 0x40,
+	0x06, 0xd6, 0x88, 0xce, 0x40, 0xac, 0x28, 0xfd, 0x98, 0x5f, 0x06, 0xe7, 0x9e, 0xc7, 0x33, 0xb6,
+	0x36, 0xf1, 0xd0, 0xde, 0x9c, 0x7a, 0xfe, 0xb7, 0xef, 0x20, 0x17, 0x37, 0xec, 0x66, 0x0e, 0x17,
+	0xdb, 0x22, 0x37, 0xc0, 0xa5, 0x71, 0x0c, 0x95, 0xa4, 0x44, 0x76, 0xec, 0x56, 0x72, 0x7d, 0xb4,
+	0x14, 0x54, 0x2f, 0x17, 0xb7, 0xaf, 0x21, 0xad, 0xcb, 0x64, 0x6d, 0x64, 0xe5, 0xf9, 0xd1, 0x9b,
+	0x7c, 0x4f, 0x2b, 0x31, 0x57, 0x65, 0xbf, 0x63, 0x69, 0xbd, 0x94, 0x4f, 0x2b, 0x5b, 0x2e, 0x6c,
+	0xbf, 0x8c, 0x34, 0x6f, 0x92, 0x1b, 0xb9, 0x34, 0x13, 0x45, 0x7f, 0x90, 0x47, 0x7d, 0xe7, 0x63,
+	0x52, 0xdf, 0xf9, 0x75, 0xa9, 0xef, 0x90, 0x3f, 0xb3, 0x60, 0x2d, 0x37, 0xe3, 0x41, 0xbe, 0x90,
+	0xa9, 0xdd, 0x18, 0x9f, 0x66, 0x69, 0xde, 0x9b, 0x05, 0x55, 0x72, 0x76, 0x1f, 0x39, 0x7b, 0xd9,
+	0x1e, 0xb5, 0xc2, 0x8f, 0xf8, 0xd9, 0xee, 0xc5, 0x76, 0x98, 0x74, 0xe7, 0xeb, 0xef, 0x43, 0x55,
+	0xcb, 0x92, 0x8c, 0x95, 0x8b, 0xa9, 0x6f, 0x39, 0x79, 0x15, 0x53, 0xdf, 0x46, 0x25, 0xc2, 0xdc,
+	0x33, 0xa4, 0x17, 0x42, 0x3d, 0x93, 0x2b, 0x19, 0x4b, 0xf3, 0xf6, 0xe8, 0x5a, 0x8c, 0x66, 0x58,
+	0xec, 0x1b, 0x48, 0xf7, 0x0a, 0xb9, 0x3c, 0x42, 0x37, 0x18, 0xc4, 0x8c, 0xc6, 0x64, 0x00, 0xb5,
+	0x27, 0x83, 0x7e, 0xa8, 0x3a, 0x7f, 0x42, 0x72, 0x72, 0xe1, 0xed, 0xab, 0x63, 0xc8, 0x6d, 0x77,
+	0x06, 0xfd, 0x90, 0x4f, 0xf4, 0xdb, 0xa8, 0x76, 0xaa, 0x94, 0x4c, 0xd6, 0x12, 0x8e, 0xa3, 0x7d,
+	0x23, 0xbf, 0x72, 0x35, 0x75, 0x6a, 0x04, 0xc9, 0xd6, 0x08, 0x70, 0xb2, 0xb2, 0x94, 0x63, 0x00,
+	0x2b, 0x49, 0x95, 0xb4, 0xa2, 0x93, 0x09, 0x9e, 0x26, 0x54, 0x51, 0x4f, 0xa7, 0xb9, 0x86, 0x34,
+	0xeb, 0xb6, 0x46, 0x93, 0x4f, 0xac, 0x83, 0xf1, 0x67, 0x52, 0x71, 0x39, 0xa3, 0xd3, 0x18, 0x29,
+	0x03, 0xb5, 0xaf, 0xe2, 0xf0, 0xeb, 0xe4, 0x52, 0x3a, 0xfc, 0x76, 0xd8, 0x0b, 0xe2, 0x0f, 0x71,
+	0xd4, 0x73, 0xa8, 0x67, 0x4a, 0x3b, 0x89, 0xe9, 0xfd, 0xf3, 0x0b, 0x3f, 0xa7, 0x52, 0x95, 0xea,
+	0x62, 0xe7, 0x52, 0xe5, 0xd3, 0xa3, 0xa8, 0xa0, 0x7a, 0x09, 0xe0, 0x8c, 0x11, 0x76, 0x5e, 0xad,
+	0xa3, 0x19, 0x61, 0x27, 0xe4, 0x5c, 0x9f, 0x9c, 0xc3, 0xca, 0x48, 0xad, 0x21, 0x31, 0x37, 0x98,
+	0x71, 0xb5, 0x88, 0xd3, 0x57, 0xae, 0x89, 0x54, 0x2f, 0xd9, 0x59, 0xaa, 0x7c, 0x7e, 0x2f, 0xa0,
+	0x9e, 0x29, 0x4f, 0xcb, 0x08, 0x36, 0xbf, 0x04, 0xb1, 0x79, 0x7b, 0x32, 0xd2, 0x04, 0xf1, 0x1e,
+	0x2b, 0x5c, 0x4e, 0xfe, 0x02, 0x96, 0xcc, 0x5a, 0xa8, 0xcc, 0xc6, 0x9f, 0x5b, 0x22, 0xd6, 0xbc,
+	0x35, 0x11, 0x27, 0x6f, 0xe3, 0x97, 0xb4, 0x99, 0x44, 0xe5, 0xa4, 0x87, 0x50, 0xcf, 0xd4, 0x14,
+	0x91, 0x91, 0x08, 0x2e, 0xa7, 0x48, 0xa9, 0x79, 0x7b, 0x32, 0x92, 0x29, 0x73, 0x42, 0x34, 0xea,
+	0xb2, 0x6a, 0x80, 0x84, 0x18, 0x72, 0x18, 0xbe, 0x80, 0x5c, 0xcb, 0x5f, 0xc4, 0x7c, 0x3d, 0x1e,
+	0xa9, 0xd2, 0x37, 0xb7, 0x5c, 0x49, 0xee, 0x23, 0x55, 0xe6, 0xf2, 0x82, 0x04, 0x40, 0xb8, 0xee,
+	0xcf, 0xe8, 0x7e, 0x32, 0x7a, 0x37, 0xa6, 0xbe, 0x7d, 0x8c, 0x5a, 0x05, 0x31, 0x17, 0xee, 0xf7,
+	0x2d, 0x58, 0x19, 0xa1, 0x38, 0x6d, 0x92, 0x33, 0xd2, 0x35, 0xb6, 0x96, 0x91, 0xb9, 0x26, 0x2c,
+	0x04, 0x40, 0x78, 0x31, 0xfa, 0x67, 0x3f, 0x67, 0x5e, 0x03, 0xaf, 0xe6, 0x3c, 0x42, 0xf1, 0x37,
+	0x33, 0x67, 0xc5, 0x42, 0x00, 0xe4, 0x30, 0x0e, 0xc2, 0xcf, 0x7e, 0xce, 0x2c, 0x0e, 0x42, 0x35,
+	0xe7, 0x11, 0x8a, 0xbf, 0x99, 0x39, 0x6b, 0x2c, 0xac, 0x65, 0xcd, 0x09, 0x0b, 0xbe, 0xa6, 0xb1,
+	0x71, 0x2b, 0xff, 0xb3, 0x51, 0xbc, 0x66, 0xdf, 0x42, 0x26, 0xae, 0x91, 0x8d, 0x71, 0x4c, 0x70,
+	0x42, 0x7f, 0x62, 0x41, 0x43, 0x6c, 0x40, 0x39, 0x5c, 0xdc, 0xcd, 0xd9, 0xa7, 0x72, 0x2b, 0xd3,
+	0x66, 0x63, 0x48, 0x9e, 0x94, 0xed, 0x49, 0x0c, 0x71, 0xb1, 0x7c, 0x47, 0x6c, 0x5c, 0x5a, 0xa5,
+	0xc9, 0xec, 0xa1, 0x4e, 0x5e, 0x7d, 0x8a, 0x99, 0xbb, 0x69, 0x23, 0xc6, 0xb6, 0xcc, 0xa5, 0xba,
+	0x00, 0x69, 0x9d, 0xc3, 0x8c, 0x51, 0xcd, 0x68, 0x61, 0x84, 0xa9, 0x68, 0x92, 0xc2, 0x87, 0x03,
+	0x2f, 0x16, 0xde, 0x7a, 0xc9, 0xcc, 0x4f, 0x67, 0x36, 0x8a, 0xdc, 0x9c, 0x79, 0xf3, 0xd6, 0x44,
+	0x1c, 0xd3, 0x77, 0xda, 0xe8, 0xaa, 0x65, 0x5a, 0x75, 0x5b, 0xa4, 0xc6, 0x25, 0xe5, 0xfd, 0xfe,
+	0x04, 0xca, 0xfb, 0xfd, 0xe9, 0x94, 0xf7, 0xfb, 0xb3, 0x53, 0xf6, 0xfa, 0x8a, 0xf2, 0xef, 0xe0,
+	0x99, 0x3c, 0x21, 0x3b, 0x5b, 0x30, 0x9e, 0x93, 0x59, 0xb6, 0x57, 0x91, 0xce, 0x22, 0xa9, 0x6a,
+	0x74, 0x78, 0x20, 0xac, 0x67, 0x80, 0x89, 0x39, 0x4c, 0x4e, 0x4e, 0xb9, 0x79, 0x73, 0x02, 0x86,
+	0x99, 0x5d, 0xb2, 0xd7, 0xf4, 0x19, 0x0d, 0x10, 0xd3, 0xf3, 0xbb, 0x22, 0xa0, 0x82, 0x34, 0x61,
+	0x3c, 0xa3, 0xae, 0x8c, 0x66, 0x98, 0xcd, 0xdd, 0x5d, 0x11, 0xd2, 0xc8, 0xfc, 0x91, 0x05, 0x2b,
+	0x23, 0x09, 0xdf, 0x4c, 0x44, 0x35, 0x2e, 0xe5, 0xdc, 0x7c, 0x69, 0x1a, 0x9a, 0x64, 0xe2, 0x2e,
+	0x32, 0x61, 0xdb, 0xd7, 0x74, 0x26, 0x54, 0x16, 0x7a, 0xbb, 0xcd, 0xfb, 0x49, 0x76, 0x7e, 0x64,
+	0xc1, 0x72, 0x36, 0xd7, 0x9b, 0x49, 0x33, 0x8c, 0xc9, 0x33, 0x37, 0xef, 0x4c, 0xc1, 0xca, 0x3b,
+	0x89, 0x24, 0xbc, 0x0c, 0x8e, 0xb3, 0xac, 0x3c, 0xfa, 0x41, 0xe1, 0x27, 0x7b, 0xbf, 0xb2, 0x88,
+	0x03, 0x4b, 0x4f, 0xf7, 0x0e, 0x0f, 0xef, 0xf3, 0x1d, 0x2b, 0xda, 0xdc, 0x3b, 0xd8, 0xb7, 0x5f,
+	0x87, 0x1a, 0x87, 0x6c, 0x86, 0x51, 0xf0, 0x1d, 0xda, 0x8e, 0xc9, 0xa5, 0xd3, 0x38, 0x0e, 0xd9,
+	0x1b, 0xdb, 0xdb, 0x7d, 0x97, 0x31, 0x9f, 0xc6, 0x5b, 0x41, 0xd4, 0xdd, 0x6e, 0xae, 0xb6, 0x03,
+	0x3f, 0x76, 0xdb, 0xf1, 0xd7, 0x34, 0xe8, 0xbd, 0xff, 0xb7, 0x5b, 0xdc, 0xd9, 0x7a, 0x70, 0xcf,
+	0xb2, 0x76, 0x97, 0xdd, 0x30, 0xec, 0x79, 0x6d, 0xbc, 0x17, 0xdb, 0xfe, 0x0e, 0x0b, 0xfc, 0xdd,
+	0x75, 0x1d, 0x32, 0xbc, 0x7f, 0x12, 0x04, 0xf7, 0xfb, 0x5e, 0x9f, 0xbe, 0x31, 0x82, 0xf9, 0xc6,
+	0x18, 0x4c, 0x67, 0x03, 0x8a, 0xaf, 0x3d, 0x78, 0x8d, 0x5c, 0x02, 0xf8, 0x7a, 0x10, 0x6f, 0x9e,
+	0xf0, 0x12, 0xbb, 0x2d, 0x52, 0x82, 0xb9, 0x9f, 0x17, 0xac, 0x85, 0xe8, 0x35, 0xb8, 0x6a, 0xce,
+	0x63, 0xf3, 0x49, 0xd0, 0x1e, 0xf4, 0xa9, 0x2f, 0xfe, 0x51, 0x6c, 0xfe, 0x2c, 0x8e, 0x4b, 0x28,
+	0xd0, 0x2f, 0xfe, 0xd7, 0x00, 0x50, 0x1b, 0x44, 0xd8, 0xa4, 0x56, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4394,11 +6675,25 @@ type ApiServiceClient interface {
 	GetBlockHeaderV2(ctx context.Context, in *GetBlockRequestV2, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error)
 	GetBlockVerbose1V2(ctx context.Context, in *GetBlockRequestV2, opts ...grpc.CallOption) (*GetBlockResponse, error)
 	GetCoinbase(ctx context.Context, in *GetCoinbaseRequest, opts ...grpc.CallOption) (*GetCoinbaseResponse, error)
+	GenerateBlocks(ctx context.Context, in *GenerateBlocksRequest, opts ...grpc.CallOption) (*GenerateBlocksResponse, error)
+	GetBlockTemplate(ctx context.Context, in *GetBlockTemplateRequest, opts ...grpc.CallOption) (*GetBlockTemplateResponse, error)
+	SetBlockTemplatePolicy(ctx context.Context, in *SetBlockTemplatePolicyRequest, opts ...grpc.CallOption) (*BlockTemplatePolicy, error)
 	GetTxPool(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetTxPoolResponse, error)
 	GetTxPoolVerbose0(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetTxPoolVerbose0Response, error)
 	GetTxPoolVerbose1(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetTxPoolVerbose1Response, error)
+	PrioritiseTransaction(ctx context.Context, in *PrioritiseTransactionRequest, opts ...grpc.CallOption) (*PrioritiseTransactionResponse, error)
+	SaveMempool(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SaveMempoolResponse, error)
+	GetTxOutSetInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetTxOutSetInfoResponse, error)
+	DumpTxOutSet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetTxOutSetInfoResponse, error)
 	GetCapacitySpaces(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WorkSpacesResponse, error)
 	ConfigureCapacity(ctx context.Context, in *ConfigureSpaceKeeperRequest, opts ...grpc.CallOption) (*WorkSpacesResponse, error)
+	GetPlotQueue(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PlotQueueResponse, error)
+	UpdatePlotQueue(ctx context.Context, in *UpdatePlotQueueRequest, opts ...grpc.CallOption) (*PlotQueueResponse, error)
+	GetCapacityPlan(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CapacityPlanResponse, error)
+	ApplyCapacityPlan(ctx context.Context, in *ApplyCapacityPlanRequest, opts ...grpc.CallOption) (*WorkSpacesResponse, error)
+	BenchmarkProofs(ctx context.Context, in *BenchmarkProofsRequest, opts ...grpc.CallOption) (*BenchmarkProofsResponse, error)
+	SimulateMining(ctx context.Context, in *SimulateMiningRequest, opts ...grpc.CallOption) (*SimulateMiningResponse, error)
+	GetNetworkSpace(ctx context.Context, in *GetNetworkSpaceRequest, opts ...grpc.CallOption) (*GetNetworkSpaceResponse, error)
 	GetCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*WorkSpaceResponse, error)
 	PlotCapacitySpaces(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
 	PlotCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
@@ -4406,6 +6701,8 @@ type ApiServiceClient interface {
 	MineCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
 	StopCapacitySpaces(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
 	StopCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
+	GetCapacitySpaceState(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*WorkSpaceStateResponse, error)
+	UpdateCapacitySpaceState(ctx context.Context, in *UpdateWorkSpaceStateRequest, opts ...grpc.CallOption) (*WorkSpaceStateResponse, error)
 	GetClientStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error)
 	QuitClient(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error)
 	ExportKeystore(ctx context.Context, in *ExportKeystoreRequest, opts ...grpc.CallOption) (*ExportKeystoreResponse, error)
@@ -4515,6 +6812,33 @@ func (c *apiServiceClient) GetCoinbase(ctx context.Context, in *GetCoinbaseReque
 	return out, nil
 }
 
+func (c *apiServiceClient) GenerateBlocks(ctx context.Context, in *GenerateBlocksRequest, opts ...grpc.CallOption) (*GenerateBlocksResponse, error) {
+	out := new(GenerateBlocksResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GenerateBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockTemplate(ctx context.Context, in *GetBlockTemplateRequest, opts ...grpc.CallOption) (*GetBlockTemplateResponse, error) {
+	out := new(GetBlockTemplateResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SetBlockTemplatePolicy(ctx context.Context, in *SetBlockTemplatePolicyRequest, opts ...grpc.CallOption) (*BlockTemplatePolicy, error) {
+	out := new(BlockTemplatePolicy)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/SetBlockTemplatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetTxPool(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetTxPoolResponse, error) {
 	out := new(GetTxPoolResponse)
 	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTxPool", in, out, opts...)
@@ -4542,6 +6866,42 @@ func (c *apiServiceClient) GetTxPoolVerbose1(ctx context.Context, in *empty.Empt
 	return out, nil
 }
 
+func (c *apiServiceClient) PrioritiseTransaction(ctx context.Context, in *PrioritiseTransactionRequest, opts ...grpc.CallOption) (*PrioritiseTransactionResponse, error) {
+	out := new(PrioritiseTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/PrioritiseTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SaveMempool(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SaveMempoolResponse, error) {
+	out := new(SaveMempoolResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/SaveMempool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxOutSetInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetTxOutSetInfoResponse, error) {
+	out := new(GetTxOutSetInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTxOutSetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) DumpTxOutSet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetTxOutSetInfoResponse, error) {
+	out := new(GetTxOutSetInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/DumpTxOutSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetCapacitySpaces(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WorkSpacesResponse, error) {
 	out := new(WorkSpacesResponse)
 	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetCapacitySpaces", in, out, opts...)
@@ -4560,6 +6920,69 @@ func (c *apiServiceClient) ConfigureCapacity(ctx context.Context, in *ConfigureS
 	return out, nil
 }
 
+func (c *apiServiceClient) GetPlotQueue(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PlotQueueResponse, error) {
+	out := new(PlotQueueResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetPlotQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UpdatePlotQueue(ctx context.Context, in *UpdatePlotQueueRequest, opts ...grpc.CallOption) (*PlotQueueResponse, error) {
+	out := new(PlotQueueResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/UpdatePlotQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetCapacityPlan(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CapacityPlanResponse, error) {
+	out := new(CapacityPlanResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetCapacityPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ApplyCapacityPlan(ctx context.Context, in *ApplyCapacityPlanRequest, opts ...grpc.CallOption) (*WorkSpacesResponse, error) {
+	out := new(WorkSpacesResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/ApplyCapacityPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BenchmarkProofs(ctx context.Context, in *BenchmarkProofsRequest, opts ...grpc.CallOption) (*BenchmarkProofsResponse, error) {
+	out := new(BenchmarkProofsResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/BenchmarkProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SimulateMining(ctx context.Context, in *SimulateMiningRequest, opts ...grpc.CallOption) (*SimulateMiningResponse, error) {
+	out := new(SimulateMiningResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/SimulateMining", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetNetworkSpace(ctx context.Context, in *GetNetworkSpaceRequest, opts ...grpc.CallOption) (*GetNetworkSpaceResponse, error) {
+	out := new(GetNetworkSpaceResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetNetworkSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*WorkSpaceResponse, error) {
 	out := new(WorkSpaceResponse)
 	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetCapacitySpace", in, out, opts...)
@@ -4596,27 +7019,45 @@ func (c *apiServiceClient) MineCapacitySpaces(ctx context.Context, in *empty.Emp
 	return out, nil
 }
 
-func (c *apiServiceClient) MineCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
+func (c *apiServiceClient) MineCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
+	out := new(ActOnSpaceKeeperResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/MineCapacitySpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) StopCapacitySpaces(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
+	out := new(ActOnSpaceKeeperResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/StopCapacitySpaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) StopCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
 	out := new(ActOnSpaceKeeperResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/MineCapacitySpace", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/StopCapacitySpace", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) StopCapacitySpaces(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
-	out := new(ActOnSpaceKeeperResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/StopCapacitySpaces", in, out, opts...)
+func (c *apiServiceClient) GetCapacitySpaceState(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*WorkSpaceStateResponse, error) {
+	out := new(WorkSpaceStateResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetCapacitySpaceState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) StopCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
-	out := new(ActOnSpaceKeeperResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/StopCapacitySpace", in, out, opts...)
+func (c *apiServiceClient) UpdateCapacitySpaceState(ctx context.Context, in *UpdateWorkSpaceStateRequest, opts ...grpc.CallOption) (*WorkSpaceStateResponse, error) {
+	out := new(WorkSpaceStateResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/UpdateCapacitySpaceState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4716,11 +7157,25 @@ type ApiServiceServer interface {
 	GetBlockHeaderV2(context.Context, *GetBlockRequestV2) (*GetBlockHeaderResponse, error)
 	GetBlockVerbose1V2(context.Context, *GetBlockRequestV2) (*GetBlockResponse, error)
 	GetCoinbase(context.Context, *GetCoinbaseRequest) (*GetCoinbaseResponse, error)
+	GenerateBlocks(context.Context, *GenerateBlocksRequest) (*GenerateBlocksResponse, error)
+	GetBlockTemplate(context.Context, *GetBlockTemplateRequest) (*GetBlockTemplateResponse, error)
+	SetBlockTemplatePolicy(context.Context, *SetBlockTemplatePolicyRequest) (*BlockTemplatePolicy, error)
 	GetTxPool(context.Context, *empty.Empty) (*GetTxPoolResponse, error)
 	GetTxPoolVerbose0(context.Context, *empty.Empty) (*GetTxPoolVerbose0Response, error)
 	GetTxPoolVerbose1(context.Context, *empty.Empty) (*GetTxPoolVerbose1Response, error)
+	PrioritiseTransaction(context.Context, *PrioritiseTransactionRequest) (*PrioritiseTransactionResponse, error)
+	SaveMempool(context.Context, *empty.Empty) (*SaveMempoolResponse, error)
+	GetTxOutSetInfo(context.Context, *empty.Empty) (*GetTxOutSetInfoResponse, error)
+	DumpTxOutSet(context.Context, *empty.Empty) (*GetTxOutSetInfoResponse, error)
 	GetCapacitySpaces(context.Context, *empty.Empty) (*WorkSpacesResponse, error)
 	ConfigureCapacity(context.Context, *ConfigureSpaceKeeperRequest) (*WorkSpacesResponse, error)
+	GetPlotQueue(context.Context, *empty.Empty) (*PlotQueueResponse, error)
+	UpdatePlotQueue(context.Context, *UpdatePlotQueueRequest) (*PlotQueueResponse, error)
+	GetCapacityPlan(context.Context, *empty.Empty) (*CapacityPlanResponse, error)
+	ApplyCapacityPlan(context.Context, *ApplyCapacityPlanRequest) (*WorkSpacesResponse, error)
+	BenchmarkProofs(context.Context, *BenchmarkProofsRequest) (*BenchmarkProofsResponse, error)
+	SimulateMining(context.Context, *SimulateMiningRequest) (*SimulateMiningResponse, error)
+	GetNetworkSpace(context.Context, *GetNetworkSpaceRequest) (*GetNetworkSpaceResponse, error)
 	GetCapacitySpace(context.Context, *WorkSpaceRequest) (*WorkSpaceResponse, error)
 	PlotCapacitySpaces(context.Context, *empty.Empty) (*ActOnSpaceKeeperResponse, error)
 	PlotCapacitySpace(context.Context, *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
@@ -4728,6 +7183,8 @@ type ApiServiceServer interface {
 	MineCapacitySpace(context.Context, *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
 	StopCapacitySpaces(context.Context, *empty.Empty) (*ActOnSpaceKeeperResponse, error)
 	StopCapacitySpace(context.Context, *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
+	GetCapacitySpaceState(context.Context, *WorkSpaceRequest) (*WorkSpaceStateResponse, error)
+	UpdateCapacitySpaceState(context.Context, *UpdateWorkSpaceStateRequest) (*WorkSpaceStateResponse, error)
 	GetClientStatus(context.Context, *empty.Empty) (*GetClientStatusResponse, error)
 	QuitClient(context.Context, *empty.Empty) (*QuitClientResponse, error)
 	ExportKeystore(context.Context, *ExportKeystoreRequest) (*ExportKeystoreResponse, error)
@@ -4923,6 +7380,60 @@ func _ApiService_GetCoinbase_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GenerateBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GenerateBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GenerateBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GenerateBlocks(ctx, req.(*GenerateBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockTemplate(ctx, req.(*GetBlockTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SetBlockTemplatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBlockTemplatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SetBlockTemplatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/SetBlockTemplatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SetBlockTemplatePolicy(ctx, req.(*SetBlockTemplatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetTxPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -4977,6 +7488,78 @@ func _ApiService_GetTxPoolVerbose1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_PrioritiseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrioritiseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).PrioritiseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/PrioritiseTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).PrioritiseTransaction(ctx, req.(*PrioritiseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SaveMempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SaveMempool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/SaveMempool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SaveMempool(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxOutSetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxOutSetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetTxOutSetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxOutSetInfo(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_DumpTxOutSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).DumpTxOutSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/DumpTxOutSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).DumpTxOutSet(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -5013,6 +7596,132 @@ func _ApiService_ConfigureCapacity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetPlotQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPlotQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetPlotQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPlotQueue(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_UpdatePlotQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlotQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UpdatePlotQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/UpdatePlotQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UpdatePlotQueue(ctx, req.(*UpdatePlotQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetCapacityPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetCapacityPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetCapacityPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetCapacityPlan(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_ApplyCapacityPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCapacityPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ApplyCapacityPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ApplyCapacityPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ApplyCapacityPlan(ctx, req.(*ApplyCapacityPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BenchmarkProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BenchmarkProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/BenchmarkProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BenchmarkProofs(ctx, req.(*BenchmarkProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SimulateMining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateMiningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SimulateMining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/SimulateMining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SimulateMining(ctx, req.(*SimulateMiningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetNetworkSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNetworkSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetNetworkSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNetworkSpace(ctx, req.(*GetNetworkSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WorkSpaceRequest)
 	if err := dec(in); err != nil {
@@ -5139,6 +7848,42 @@ func _ApiService_StopCapacitySpace_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetCapacitySpaceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetCapacitySpaceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetCapacitySpaceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetCapacitySpaceState(ctx, req.(*WorkSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_UpdateCapacitySpaceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkSpaceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UpdateCapacitySpaceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/UpdateCapacitySpaceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UpdateCapacitySpaceState(ctx, req.(*UpdateWorkSpaceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -5345,6 +8090,18 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCoinbase",
 			Handler:    _ApiService_GetCoinbase_Handler,
 		},
+		{
+			MethodName: "GenerateBlocks",
+			Handler:    _ApiService_GenerateBlocks_Handler,
+		},
+		{
+			MethodName: "GetBlockTemplate",
+			Handler:    _ApiService_GetBlockTemplate_Handler,
+		},
+		{
+			MethodName: "SetBlockTemplatePolicy",
+			Handler:    _ApiService_SetBlockTemplatePolicy_Handler,
+		},
 		{
 			MethodName: "GetTxPool",
 			Handler:    _ApiService_GetTxPool_Handler,
@@ -5357,6 +8114,22 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxPoolVerbose1",
 			Handler:    _ApiService_GetTxPoolVerbose1_Handler,
 		},
+		{
+			MethodName: "PrioritiseTransaction",
+			Handler:    _ApiService_PrioritiseTransaction_Handler,
+		},
+		{
+			MethodName: "SaveMempool",
+			Handler:    _ApiService_SaveMempool_Handler,
+		},
+		{
+			MethodName: "GetTxOutSetInfo",
+			Handler:    _ApiService_GetTxOutSetInfo_Handler,
+		},
+		{
+			MethodName: "DumpTxOutSet",
+			Handler:    _ApiService_DumpTxOutSet_Handler,
+		},
 		{
 			MethodName: "GetCapacitySpaces",
 			Handler:    _ApiService_GetCapacitySpaces_Handler,
@@ -5365,6 +8138,34 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConfigureCapacity",
 			Handler:    _ApiService_ConfigureCapacity_Handler,
 		},
+		{
+			MethodName: "GetPlotQueue",
+			Handler:    _ApiService_GetPlotQueue_Handler,
+		},
+		{
+			MethodName: "UpdatePlotQueue",
+			Handler:    _ApiService_UpdatePlotQueue_Handler,
+		},
+		{
+			MethodName: "GetCapacityPlan",
+			Handler:    _ApiService_GetCapacityPlan_Handler,
+		},
+		{
+			MethodName: "ApplyCapacityPlan",
+			Handler:    _ApiService_ApplyCapacityPlan_Handler,
+		},
+		{
+			MethodName: "BenchmarkProofs",
+			Handler:    _ApiService_BenchmarkProofs_Handler,
+		},
+		{
+			MethodName: "SimulateMining",
+			Handler:    _ApiService_SimulateMining_Handler,
+		},
+		{
+			MethodName: "GetNetworkSpace",
+			Handler:    _ApiService_GetNetworkSpace_Handler,
+		},
 		{
 			MethodName: "GetCapacitySpace",
 			Handler:    _ApiService_GetCapacitySpace_Handler,
@@ -5393,6 +8194,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopCapacitySpace",
 			Handler:    _ApiService_StopCapacitySpace_Handler,
 		},
+		{
+			MethodName: "GetCapacitySpaceState",
+			Handler:    _ApiService_GetCapacitySpaceState_Handler,
+		},
+		{
+			MethodName: "UpdateCapacitySpaceState",
+			Handler:    _ApiService_UpdateCapacitySpaceState_Handler,
+		},
 		{
 			MethodName: "GetClientStatus",
 			Handler:    _ApiService_GetClientStatus_Handler,
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"massnet.org/mass/config"
+	"massnet.org/mass/logging"
+)
+
+// rateLimiterSweepInterval is the interval to drop buckets of idle clients.
+const rateLimiterSweepInterval = time.Minute
+
+// expensiveRoutes match http paths of APIs returning full blocks, transaction pool
+// with transaction details, or scanning the utxo set, which are limited by a separate budget.
+var expensiveRoutes = []*regexp.Regexp{
+	regexp.MustCompile(`^/v1/blocks/[0-9a-fA-F]{64}$`),
+	regexp.MustCompile(`^/v1/blocks/height/[^/]+$`),
+	regexp.MustCompile(`^/v2/blocks/[^/]+/verbose/`),
+	regexp.MustCompile(`^/v1/transactions/pool/verbose/`),
+	regexp.MustCompile(`^/v1/transactions/outset`),
+}
+
+func isExpensiveRoute(path string) bool {
+	for _, route := range expensiveRoutes {
+		if route.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a token bucket rate limiter for each client, buckets are refilled
+// by rate tokens per second up to burst.
+type rateLimiter struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// newRateLimiter returns nil if rate is not positive, which means no limit.
+func newRateLimiter(rate float64, burst uint32) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst == 0 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Allow takes a token from bucket of key, and returns the time to wait
+// for next token if the bucket is empty.
+func (l *rateLimiter) Allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(key, now)
+	if wait := l.wait(b); wait > 0 {
+		return false, wait
+	}
+	b.tokens--
+	return true, 0
+}
+
+// bucket returns the bucket of key refilled till now.
+// bucket is not thread safe, should use lock in upper functions
+func (l *rateLimiter) bucket(key string, now time.Time) *tokenBucket {
+	if now.Sub(l.lastSweep) >= rateLimiterSweepInterval {
+		l.sweep(now)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(l.burst, b.tokens+elapsed*l.rate)
+		b.last = now
+	}
+	return b
+}
+
+// wait returns the time to wait for a token of b, zero if there is one.
+func (l *rateLimiter) wait(b *tokenBucket) time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+}
+
+// sweep drops buckets that would have been refilled, they are the same as new ones.
+// sweep is not thread safe, should use lock in upper functions
+func (l *rateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+func clientIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+func statusTooManyRequestsHandler(w http.ResponseWriter, req *http.Request, wait time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	runtime.OtherErrorHandler(w, req, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+}
+
+// rateLimits are general and expensive budgets of clients, either is nil if not limited.
+type rateLimits struct {
+	general   *rateLimiter
+	expensive *rateLimiter
+}
+
+// newRateLimits returns nil if neither budget is limited.
+func newRateLimits(cfg *config.Config) *rateLimits {
+	general := newRateLimiter(cfg.Network.API.APIRateLimit, cfg.Network.API.APIRateBurst)
+	expensive := newRateLimiter(cfg.Network.API.APIExpensiveRateLimit, cfg.Network.API.APIExpensiveRateBurst)
+	if general == nil && expensive == nil {
+		return nil
+	}
+	return &rateLimits{general: general, expensive: expensive}
+}
+
+// allow takes a general token for key, and an expensive one as well for expensive calls.
+// Tokens are taken only if all required budgets have one, so that a rejected call
+// never drains any budget.
+func (l *rateLimits) allow(key string, expensive bool, now time.Time) (bool, time.Duration) {
+	limiters := make([]*rateLimiter, 0, 2)
+	if l.general != nil {
+		limiters = append(limiters, l.general)
+	}
+	if expensive && l.expensive != nil {
+		limiters = append(limiters, l.expensive)
+	}
+	// limiters are always locked in the same order
+	buckets := make([]*tokenBucket, len(limiters))
+	var wait time.Duration
+	for i, limiter := range limiters {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		buckets[i] = limiter.bucket(key, now)
+		if w := limiter.wait(buckets[i]); w > wait {
+			wait = w
+		}
+	}
+	if wait > 0 {
+		return false, wait
+	}
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return true, 0
+}
+
+type rateLimitContextKey struct{}
+
+// rateLimitFunc takes tokens for a call of the client.
+type rateLimitFunc func(expensive bool) (bool, time.Duration)
+
+// rateLimitFromContext returns the rateLimitFunc of JSON-RPC requests, nil means no limit.
+func rateLimitFromContext(ctx context.Context) rateLimitFunc {
+	fn, _ := ctx.Value(rateLimitContextKey{}).(rateLimitFunc)
+	return fn
+}
+
+// rateLimitHandler limits requests of each client IP, requests to expensive routes
+// take tokens from both budgets. JSON-RPC requests are not limited here, as a batch
+// may contain many calls, instead each call is limited by the rateLimitFunc in context.
+func rateLimitHandler(h http.Handler, cfg *config.Config) http.Handler {
+	limits := newRateLimits(cfg)
+	if limits == nil {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ip := clientIP(req.RemoteAddr)
+		if isJSONRPCRequest(req) {
+			var fn rateLimitFunc = func(expensive bool) (bool, time.Duration) {
+				return limits.allow(ip, expensive, time.Now())
+			}
+			h.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), rateLimitContextKey{}, fn)))
+			return
+		}
+		expensive := isExpensiveRoute(req.URL.Path)
+		if ok, wait := limits.allow(ip, expensive, time.Now()); !ok {
+			logging.CPrint(logging.WARN, "too many requests", logging.LogFormat{"address": req.RemoteAddr, "path": req.URL.Path, "expensive": expensive})
+			statusTooManyRequestsHandler(w, req, wait)
+			return
+		}
+		h.ServeHTTP(w, req)
+	})
+}
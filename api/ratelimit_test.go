@@ -0,0 +1,183 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"massnet.org/mass/config"
+	configpb "massnet.org/mass/config/pb"
+)
+
+func newTestRateLimitConfig(rate float64, burst uint32, expensiveRate float64, expensiveBurst uint32) *config.Config {
+	return &config.Config{Config: &configpb.Config{Network: &configpb.NetworkConfig{API: &configpb.APIConfig{
+		APIRateLimit:          rate,
+		APIRateBurst:          burst,
+		APIExpensiveRateLimit: expensiveRate,
+		APIExpensiveRateBurst: expensiveBurst,
+	}}}}
+}
+
+func TestRateLimiter(t *testing.T) {
+	if l := newRateLimiter(0, 10); l != nil {
+		t.Error("zero rate should not be limited")
+	}
+	if l := newRateLimiter(-1, 10); l != nil {
+		t.Error("negative rate should not be limited")
+	}
+
+	l := newRateLimiter(2, 3)
+	now := time.Now()
+
+	// burst
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.Allow("a", now); !ok {
+			t.Fatalf("request %d in burst is limited", i)
+		}
+	}
+	ok, wait := l.Allow("a", now)
+	if ok || wait != 500*time.Millisecond {
+		t.Errorf("request beyond burst, allowed %v, wait %v", ok, wait)
+	}
+
+	// per-client isolation
+	if ok, _ := l.Allow("b", now); !ok {
+		t.Error("client b is limited by client a")
+	}
+
+	// refill
+	if ok, _ := l.Allow("a", now.Add(250*time.Millisecond)); ok {
+		t.Error("half a token is taken")
+	}
+	if ok, _ := l.Allow("a", now.Add(500*time.Millisecond)); !ok {
+		t.Error("refilled token is not taken")
+	}
+	later := now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.Allow("a", later); !ok {
+			t.Fatalf("request %d after refill is limited", i)
+		}
+	}
+	if ok, _ := l.Allow("a", later); ok {
+		t.Error("bucket is refilled beyond burst")
+	}
+
+	// idle clients are swept
+	l.Allow("b", later)
+	l.Allow("c", later.Add(rateLimiterSweepInterval))
+	if _, ok := l.buckets["b"]; ok {
+		t.Error("bucket of idle client is kept")
+	}
+	if _, ok := l.buckets["a"]; ok {
+		t.Error("refilled bucket is kept")
+	}
+}
+
+func TestRateLimitsAllow(t *testing.T) {
+	if l := newRateLimits(newTestRateLimitConfig(0, 0, 0, 0)); l != nil {
+		t.Error("rate limits should be off by default")
+	}
+
+	now := time.Now()
+	l := newRateLimits(newTestRateLimitConfig(1, 2, 1, 1))
+	if ok, _ := l.allow("a", true, now); !ok {
+		t.Fatal("expensive request is limited")
+	}
+	// a rejected expensive request takes no general token
+	if ok, wait := l.allow("a", true, now); ok || wait != time.Second {
+		t.Errorf("expensive request beyond burst, allowed %v, wait %v", ok, wait)
+	}
+	if ok, _ := l.allow("a", false, now); !ok {
+		t.Error("general token is taken by rejected expensive request")
+	}
+
+	// a rejected request takes no expensive token
+	l = newRateLimits(newTestRateLimitConfig(1, 1, 1, 2))
+	if ok, _ := l.allow("a", false, now); !ok {
+		t.Fatal("request is limited")
+	}
+	if ok, _ := l.allow("a", true, now); ok {
+		t.Error("expensive request beyond general burst is allowed")
+	}
+	if b := l.expensive.buckets["a"]; b.tokens != 2 {
+		t.Errorf("expensive token is taken by rejected request, %v left", b.tokens)
+	}
+
+	// expensive budget only
+	l = newRateLimits(newTestRateLimitConfig(-1, 0, 1, 1))
+	for i := 0; i < 10; i++ {
+		if ok, _ := l.allow("a", false, now); !ok {
+			t.Fatal("general request is limited without general budget")
+		}
+	}
+	if ok, _ := l.allow("a", true, now); !ok {
+		t.Error("expensive request is limited")
+	}
+	if ok, _ := l.allow("a", true, now); ok {
+		t.Error("expensive request beyond burst is allowed")
+	}
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	var served int
+	next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) { served++ })
+	cfg := newTestRateLimitConfig(0.001, 2, 0.001, 1)
+	h := corsHandler(rateLimitHandler(next, cfg), []string{"https://dashboard.example"}, []string{"GET", "POST"})
+
+	request := func(method, path, remoteAddr string, header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	// expensive route takes both budgets
+	if w := request(http.MethodGet, "/v1/transactions/pool/verbose/1", "1.1.1.1:1000", nil); w.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d", w.Code)
+	}
+	w := request(http.MethodGet, "/v1/transactions/pool/verbose/1", "1.1.1.1:1000", nil)
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "1000" {
+		t.Errorf("expensive request beyond burst, status %d, retry after %s", w.Code, w.Header().Get("Retry-After"))
+	}
+	if w := request(http.MethodGet, "/v1/blocks/best", "1.1.1.1:1001", nil); w.Code != http.StatusOK {
+		t.Errorf("cheap request limited, status %d", w.Code)
+	}
+	w = request(http.MethodGet, "/v1/blocks/best", "1.1.1.1:1002", map[string]string{"Origin": "https://dashboard.example"})
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Access-Control-Expose-Headers") != "Retry-After" {
+		t.Errorf("request beyond burst, status %d, headers %v", w.Code, w.Header())
+	}
+
+	// other clients are not affected
+	if w := request(http.MethodGet, "/v1/blocks/best", "2.2.2.2:1000", nil); w.Code != http.StatusOK {
+		t.Errorf("request of another client is limited, status %d", w.Code)
+	}
+
+	// preflight requests are answered before rate limiting
+	for i := 0; i < 3; i++ {
+		w := request(http.MethodOptions, "/v1/blocks/best", "1.1.1.1:1000", map[string]string{
+			"Origin":                        "https://dashboard.example",
+			"Access-Control-Request-Method": "GET",
+		})
+		if w.Code != http.StatusNoContent || w.Header().Get("Access-Control-Allow-Origin") != "https://dashboard.example" {
+			t.Errorf("preflight %d, status %d, headers %v", i, w.Code, w.Header())
+		}
+	}
+	if served != 3 {
+		t.Errorf("unexpected served requests %d", served)
+	}
+
+	// no limits by default
+	served = 0
+	h = rateLimitHandler(next, newTestRateLimitConfig(0, 0, 0, 0))
+	for i := 0; i < 10; i++ {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/transactions/pool/verbose/1", nil))
+	}
+	if served != 10 {
+		t.Errorf("requests are limited by default, served %d", served)
+	}
+}
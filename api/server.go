@@ -33,6 +33,9 @@ type Server struct {
 	syncManager *netsync.SyncManager
 	pocWallet   *wallet.PoCWallet
 	quitClient  func()
+	auth        *tokenAuth
+
+	spaceEstimates spaceEstimateCache
 }
 
 func NewServer(db database.Db, pocMiner pocminer.PoCMiner, spaceKeeper mining.SpaceKeeper, chain *blockchain.Blockchain,
@@ -42,6 +45,22 @@ func NewServer(db database.Db, pocMiner pocminer.PoCMiner, spaceKeeper mining.Sp
 		grpc.MaxRecvMsgSize(maxMsgSize),
 		grpc.MaxSendMsgSize(maxMsgSize),
 	}
+	auth, err := newTokenAuth(config.Network.API.APITokens)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "invalid api tokens", logging.LogFormat{"err": err})
+		return nil, err
+	}
+	if auth.Enabled() {
+		opts = append(opts, grpc.UnaryInterceptor(auth.UnaryServerInterceptor()), grpc.StreamInterceptor(auth.StreamServerInterceptor()))
+	}
+	if config.Network.API.APITLS {
+		creds, err := serverTLSCredentials(config)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to load api tls cert pair", logging.LogFormat{"err": err})
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
 	s := grpc.NewServer(opts...)
 	srv := &Server{
 		rpcServer:   s,
@@ -54,12 +73,13 @@ func NewServer(db database.Db, pocMiner pocminer.PoCMiner, spaceKeeper mining.Sp
 		syncManager: sm,
 		pocWallet:   pocWallet,
 		quitClient:  quitClient,
+		auth:        auth,
 	}
 	pb.RegisterApiServiceServer(s, srv)
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
 
-	logging.CPrint(logging.INFO, "new gRPC server")
+	logging.CPrint(logging.INFO, "new gRPC server", logging.LogFormat{"tls": config.Network.API.APITLS, "auth": auth.Enabled()})
 	return srv, nil
 }
 
@@ -82,7 +102,7 @@ func (s *Server) Stop() {
 
 func (s *Server) RunGateway() {
 	go func() {
-		if err := Run(s.config); err != nil {
+		if err := Run(s.config, s.jsonRPCHandler()); err != nil {
 			logging.CPrint(logging.ERROR, "failed to start gateway", logging.LogFormat{"port": s.config.Network.API.APIPortHttp, "error": err})
 		}
 	}()
@@ -5,34 +5,33 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/status"
 	pb "massnet.org/mass/api/proto"
 	"massnet.org/mass/config"
+	"massnet.org/mass/consensus/difficulty"
 	"massnet.org/mass/logging"
 	"massnet.org/mass/massutil"
 	"massnet.org/mass/poc"
 	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/engine/spacekeeper/capacity"
 	"massnet.org/mass/poc/wallet/keystore"
 	"massnet.org/mass/pocec"
+	"massnet.org/mass/wire"
 )
 
 func (s *Server) ConfigureCapacity(ctx context.Context, in *pb.ConfigureSpaceKeeperRequest) (*pb.WorkSpacesResponse, error) {
 	logging.CPrint(logging.INFO, "Received a request for ConfigureCapacity", logging.LogFormat{"capacity": in.Capacity, "payout_addresses": in.PayoutAddresses})
 
-	if len(in.PayoutAddresses) == 0 {
-		logging.CPrint(logging.ERROR, "coinbase_address is empty")
-		return nil, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
-	}
-	for _, addr := range in.PayoutAddresses {
-		err := checkAddressLen(addr)
-		if err != nil {
-			return nil, err
-		}
+	err := checkPayoutAddressesLen(in.PayoutAddresses)
+	if err != nil {
+		return nil, err
 	}
-	err := checkPassLen(in.Passphrase)
+	err = checkPassLen(in.Passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -47,40 +46,341 @@ func (s *Server) ConfigureCapacity(ctx context.Context, in *pb.ConfigureSpaceKee
 		return nil, status.New(ErrAPIMinerNotStopped, ErrCode[ErrAPIMinerNotStopped]).Err()
 	}
 
-	payoutAddresses, err := massutil.NewAddressesFromStringList(in.PayoutAddresses, &config.ChainParams)
+	if err = s.setPayoutAddresses(in.PayoutAddresses); err != nil {
+		return nil, err
+	}
+	if err := s.pocWallet.Unlock([]byte(in.Passphrase)); err != nil {
+		logging.CPrint(logging.ERROR, "fail to unlock poc wallet",
+			logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerWrongPassphrase, ErrCode[ErrAPIMinerWrongPassphrase]).Err()
+	}
+	_, err = s.spaceKeeper.ConfigureBySize(diskSize, false, false)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to configure spaceKeeper", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+	resultList, err := s.getCapacitySpaces(engine.SFAll)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "ConfigureCapacity completed")
+	return &pb.WorkSpacesResponse{SpaceCount: uint32(len(resultList)), Spaces: resultList}, nil
+}
+
+func checkPayoutAddressesLen(addrs []string) error {
+	if len(addrs) == 0 {
+		logging.CPrint(logging.ERROR, "coinbase_address is empty")
+		return status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+	}
+	for _, addr := range addrs {
+		err := checkAddressLen(addr)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) setPayoutAddresses(addrs []string) error {
+	payoutAddresses, err := massutil.NewAddressesFromStringList(addrs, &config.ChainParams)
 	if err != nil {
-		logging.CPrint(logging.ERROR, "fail to decode coinbase_address", logging.LogFormat{"addr_list": in.PayoutAddresses})
-		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
+		logging.CPrint(logging.ERROR, "fail to decode coinbase_address", logging.LogFormat{"addr_list": addrs})
+		return status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
 	}
 	if len(payoutAddresses) > config.MaxMiningPayoutAddresses {
 		logging.CPrint(logging.ERROR, "coinbase_address is more than allowed",
 			logging.LogFormat{"allowed": config.MaxMiningPayoutAddresses, "count": len(payoutAddresses)})
-		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
+		return status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
 	}
 	if err := s.pocMiner.SetPayoutAddresses(payoutAddresses); err != nil {
 		logging.CPrint(logging.ERROR, "missing miner payout address",
 			logging.LogFormat{"allowed": config.MaxMiningPayoutAddresses, "count": len(payoutAddresses)})
-		return nil, status.New(ErrAPIMinerNoAddress, ErrCode[ErrAPIMinerNoAddress]).Err()
+		return status.New(ErrAPIMinerNoAddress, ErrCode[ErrAPIMinerNoAddress]).Err()
+	}
+	return nil
+}
+
+// GetCapacityPlan proposes new spaces for each proof dir by its free disk space.
+func (s *Server) GetCapacityPlan(ctx context.Context, in *empty.Empty) (*pb.CapacityPlanResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetCapacityPlan")
+
+	plan, err := s.spaceKeeper.PlanCapacity()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to plan capacity", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerCapacityPlan, err.Error()).Err()
+	}
+	resp := &pb.CapacityPlanResponse{
+		PlanId:         plan.ID,
+		Dirs:           make([]*pb.CapacityPlanDir, len(plan.Dirs)),
+		PlannedBytes:   plan.PlannedBytes,
+		AvailableBytes: plan.AvailableBytes,
+	}
+	for i, dp := range plan.Dirs {
+		dir := &pb.CapacityPlanDir{
+			Dir:           dp.Dir,
+			Device:        dp.Device,
+			TotalBytes:    dp.TotalBytes,
+			FreeBytes:     dp.FreeBytes,
+			ReservedBytes: dp.ReservedBytes,
+			PlannedBytes:  dp.PlannedBytes,
+			Spaces:        make([]*pb.CapacityPlanSpaces, 0, len(dp.BitLengths)),
+		}
+		for _, bl := range poc.ValidBitLength() {
+			if count := dp.BitLengths[bl]; count > 0 {
+				dir.Spaces = append(dir.Spaces, &pb.CapacityPlanSpaces{BitLength: uint32(bl), Count: uint32(count)})
+			}
+		}
+		resp.Dirs[i] = dir
+	}
+
+	logging.CPrint(logging.INFO, "GetCapacityPlan completed", logging.LogFormat{"plan_id": resp.PlanId, "planned_bytes": resp.PlannedBytes})
+	return resp, nil
+}
+
+// ApplyCapacityPlan configures spaces by the plan reviewed from GetCapacityPlan.
+func (s *Server) ApplyCapacityPlan(ctx context.Context, in *pb.ApplyCapacityPlanRequest) (*pb.WorkSpacesResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for ApplyCapacityPlan", logging.LogFormat{"plan_id": in.PlanId, "payout_addresses": in.PayoutAddresses})
+
+	if in.PlanId == "" {
+		logging.CPrint(logging.ERROR, "plan_id is empty")
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	err := checkPayoutAddressesLen(in.PayoutAddresses)
+	if err != nil {
+		return nil, err
+	}
+	err = checkPassLen(in.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if s.spaceKeeper.Started() {
+		logging.CPrint(logging.ERROR, "cannot configure while capacity is running")
+		return nil, status.New(ErrAPIMinerNotStopped, ErrCode[ErrAPIMinerNotStopped]).Err()
+	}
+
+	if err = s.setPayoutAddresses(in.PayoutAddresses); err != nil {
+		return nil, err
 	}
 	if err := s.pocWallet.Unlock([]byte(in.Passphrase)); err != nil {
 		logging.CPrint(logging.ERROR, "fail to unlock poc wallet",
 			logging.LogFormat{"err": err})
 		return nil, status.New(ErrAPIMinerWrongPassphrase, ErrCode[ErrAPIMinerWrongPassphrase]).Err()
 	}
-	_, err = s.spaceKeeper.ConfigureBySize(diskSize, false, false)
-	if err != nil {
-		logging.CPrint(logging.ERROR, "fail to configure spaceKeeper", logging.LogFormat{"err": err})
-		return nil, status.New(ErrAPIMinerInternal, err.Error()).Err()
+	if _, err = s.spaceKeeper.ApplyCapacityPlan(in.PlanId, false, false); err != nil {
+		logging.CPrint(logging.ERROR, "fail to apply capacity plan", logging.LogFormat{"err": err, "plan_id": in.PlanId})
+		return nil, status.New(ErrAPIMinerCapacityPlan, err.Error()).Err()
 	}
 	resultList, err := s.getCapacitySpaces(engine.SFAll)
 	if err != nil {
 		return nil, err
 	}
 
-	logging.CPrint(logging.INFO, "ConfigureCapacity completed")
+	logging.CPrint(logging.INFO, "ApplyCapacityPlan completed", logging.LogFormat{"space_count": len(resultList)})
 	return &pb.WorkSpacesResponse{SpaceCount: uint32(len(resultList)), Spaces: resultList}, nil
 }
 
+// BenchmarkProofs measures the latency of looking up proofs from mining spaces.
+func (s *Server) BenchmarkProofs(ctx context.Context, in *pb.BenchmarkProofsRequest) (*pb.BenchmarkProofsResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for BenchmarkProofs", logging.LogFormat{"challenges": in.Challenges})
+
+	result, err := s.spaceKeeper.BenchmarkProofs(ctx, int(in.Challenges))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to benchmark proofs", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerBenchmark, err.Error()).Err()
+	}
+	var latency = func(stats capacity.LatencyStats) *pb.ProofLatency {
+		return &pb.ProofLatency{
+			Count: uint32(stats.Count),
+			P50Ms: durationMs(stats.P50),
+			P95Ms: durationMs(stats.P95),
+			P99Ms: durationMs(stats.P99),
+			MaxMs: durationMs(stats.Max),
+		}
+	}
+	resp := &pb.BenchmarkProofsResponse{
+		Challenges: uint32(result.Challenges),
+		BudgetMs:   durationMs(result.Budget),
+		GetProofs:  latency(result.GetProofs),
+		Spaces:     make([]*pb.SpaceProofLatency, len(result.Spaces)),
+		Dirs:       make([]*pb.DirProofLatency, len(result.Dirs)),
+		Warnings:   result.Warnings,
+	}
+	for i, space := range result.Spaces {
+		resp.Spaces[i] = &pb.SpaceProofLatency{
+			SpaceId:   space.SpaceID,
+			Dir:       space.Dir,
+			Errors:    uint32(space.Errors),
+			Corrupted: uint32(space.Corrupted),
+			Latency:   latency(space.LatencyStats),
+		}
+	}
+	for i, dir := range result.Dirs {
+		resp.Dirs[i] = &pb.DirProofLatency{
+			Dir:     dir.Dir,
+			Latency: latency(dir.LatencyStats),
+		}
+	}
+
+	logging.CPrint(logging.INFO, "BenchmarkProofs completed", logging.LogFormat{"challenges": resp.Challenges, "warnings": len(resp.Warnings)})
+	return resp, nil
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// SimulateMining replays recent blocks to find blocks that spaces would have won.
+func (s *Server) SimulateMining(ctx context.Context, in *pb.SimulateMiningRequest) (*pb.SimulateMiningResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for SimulateMining", logging.LogFormat{"count": in.Count, "space_ids": in.SpaceIds})
+
+	sids := make([]string, len(in.SpaceIds))
+	for i, id := range in.SpaceIds {
+		var err error
+		if sids[i], err = decodeAPISpaceID(id); err != nil {
+			return nil, status.New(ErrAPIMinerInvalidSpaceID, ErrCode[ErrAPIMinerInvalidSpaceID]).Err()
+		}
+	}
+	result, err := s.pocMiner.Simulate(int(in.Count), sids)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to simulate mining", logging.LogFormat{"err": err, "count": in.Count})
+		return nil, status.New(ErrAPIMinerSimulate, err.Error()).Err()
+	}
+	resp := &pb.SimulateMiningResponse{
+		StartHeight:    result.StartHeight,
+		EndHeight:      result.EndHeight,
+		Blocks:         uint32(result.Blocks),
+		Spaces:         uint32(result.Spaces),
+		Won:            make([]*pb.SimulatedBlock, len(result.Won)),
+		WinRate:        result.WinRate,
+		ExpectedPerDay: result.ExpectedPerDay,
+	}
+	for i, block := range result.Won {
+		resp.Won[i] = &pb.SimulatedBlock{
+			Height:     block.Height,
+			SpaceId:    block.SpaceID,
+			Slot:       block.Slot,
+			SlotsAhead: block.SlotsAhead,
+			Quality:    block.Quality.Text(16),
+			Target:     block.Target.Text(16),
+		}
+	}
+
+	logging.CPrint(logging.INFO, "SimulateMining completed", logging.LogFormat{"blocks": resp.Blocks, "won": len(resp.Won)})
+	return resp, nil
+}
+
+const (
+	defaultEstimateBlocks = 1000
+	maxEstimateBlocks     = 20000
+)
+
+// networkSpaceEstimate is the estimated network space with mining spaces of the node.
+type networkSpaceEstimate struct {
+	*difficulty.SpaceEstimate
+	miningCount        int
+	miningBytes        uint64
+	miningQualitySpace float64
+	share              float64
+	timeToBlock        time.Duration
+}
+
+// spaceEstimateCache caches the estimation on default count of blocks for the best block.
+type spaceEstimateCache struct {
+	sync.Mutex
+	best     wire.Hash
+	estimate *difficulty.SpaceEstimate
+}
+
+// GetNetworkSpace estimates the space of network from recent blocks, and the share and
+// expected time to block of mining spaces.
+func (s *Server) GetNetworkSpace(ctx context.Context, in *pb.GetNetworkSpaceRequest) (*pb.GetNetworkSpaceResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetNetworkSpace", logging.LogFormat{"blocks": in.Blocks})
+
+	if in.Blocks > maxEstimateBlocks {
+		logging.CPrint(logging.ERROR, "count of blocks is out of range",
+			logging.LogFormat{"blocks": in.Blocks, "max": maxEstimateBlocks})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	estimate, err := s.estimateNetworkSpace(int(in.Blocks))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to estimate network space", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerNetworkSpace, err.Error()).Err()
+	}
+	resp := &pb.GetNetworkSpaceResponse{
+		StartHeight:            estimate.StartHeight,
+		EndHeight:              estimate.EndHeight,
+		Target:                 estimate.Target.Text(16),
+		NetworkSpace:           estimate.Bytes,
+		NetworkQualitySpace:    estimate.QualitySpace,
+		MiningSpaceCount:       uint32(estimate.miningCount),
+		MiningSpace:            estimate.miningBytes,
+		MiningQualitySpace:     estimate.miningQualitySpace,
+		Share:                  estimate.share,
+		ExpectedSecondsToBlock: estimate.timeToBlock.Seconds(),
+	}
+
+	logging.CPrint(logging.INFO, "GetNetworkSpace completed", logging.LogFormat{"network_space": resp.NetworkSpace, "share": resp.Share})
+	return resp, nil
+}
+
+// estimateNetworkSpace estimates network space on the last count of blocks,
+// default count of blocks is used if count is 0.
+func (s *Server) estimateNetworkSpace(count int) (*networkSpaceEstimate, error) {
+	if count == 0 {
+		count = defaultEstimateBlocks
+	}
+	best := s.chain.BestBlockNode()
+	if uint64(count) > best.Height {
+		count = int(best.Height)
+	}
+
+	var spaceEstimate *difficulty.SpaceEstimate
+	if count == defaultEstimateBlocks {
+		s.spaceEstimates.Lock()
+		if s.spaceEstimates.best == *best.Hash {
+			spaceEstimate = s.spaceEstimates.estimate
+		}
+		s.spaceEstimates.Unlock()
+	}
+	if spaceEstimate == nil {
+		// parent of the first block is required for slot gap
+		headers := make([]*wire.BlockHeader, 0, count+1)
+		for height := best.Height - uint64(count); height <= best.Height; height++ {
+			header, err := s.chain.GetHeaderByHeight(height)
+			if err != nil {
+				return nil, err
+			}
+			headers = append(headers, header)
+		}
+		var err error
+		if spaceEstimate, err = difficulty.EstimateNetworkSpace(headers); err != nil {
+			return nil, err
+		}
+		if count == defaultEstimateBlocks {
+			s.spaceEstimates.Lock()
+			s.spaceEstimates.best, s.spaceEstimates.estimate = *best.Hash, spaceEstimate
+			s.spaceEstimates.Unlock()
+		}
+	}
+
+	estimate := &networkSpaceEstimate{SpaceEstimate: spaceEstimate}
+	if wsiList, err := s.getWorkSpaceInfos(engine.SFMining); err == nil {
+		for _, wsi := range wsiList {
+			estimate.miningCount++
+			estimate.miningBytes += uint64(poc.BitLengthDiskSize[wsi.BitLength])
+			estimate.miningQualitySpace += difficulty.QualitySpace(wsi.BitLength)
+		}
+	}
+	if spaceEstimate.QualitySpace > 0 {
+		estimate.share = estimate.miningQualitySpace / spaceEstimate.QualitySpace
+	}
+	if estimate.miningQualitySpace > 0 {
+		estimate.timeToBlock = difficulty.ExpectedTimeToWin(estimate.miningQualitySpace, spaceEstimate.Target)
+	}
+	return estimate, nil
+}
+
 func (s *Server) GetCapacitySpaces(ctx context.Context, in *empty.Empty) (*pb.WorkSpacesResponse, error) {
 	logging.CPrint(logging.INFO, "Received a request for GetCapacitySpaces")
 
@@ -251,6 +551,166 @@ func (s *Server) StopCapacitySpace(ctx context.Context, in *pb.WorkSpaceRequest)
 	return resp, nil
 }
 
+const (
+	maxSpaceTags   = 16
+	maxSpaceTagLen = 32
+)
+
+// GetCapacitySpaceState returns the desired state of space persisted across restarts.
+func (s *Server) GetCapacitySpaceState(ctx context.Context, in *pb.WorkSpaceRequest) (*pb.WorkSpaceStateResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetCapacitySpaceState", logging.LogFormat{"in": in.String()})
+	err := checkSpaceIDLen(in.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	wsi, err := s.getWorkSpaceInfo(in.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+	states, err := s.spaceKeeper.SpaceStates()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to get space states", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+	}
+	resp := spaceState2ProtoResponse(wsi.SpaceID, states[wsi.SpaceID])
+
+	logging.CPrint(logging.INFO, "GetCapacitySpaceState completed", logging.LogFormat{"resp": resp})
+	return resp, nil
+}
+
+// UpdateCapacitySpaceState replaces tags and preferred payout address of space,
+// desired state is updated by plot, mine and stop requests.
+func (s *Server) UpdateCapacitySpaceState(ctx context.Context, in *pb.UpdateWorkSpaceStateRequest) (*pb.WorkSpaceStateResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for UpdateCapacitySpaceState", logging.LogFormat{"in": in.String()})
+	err := checkSpaceIDLen(in.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.Tags) > maxSpaceTags {
+		logging.CPrint(logging.ERROR, "too many space tags", logging.LogFormat{"count": len(in.Tags), "allowed": maxSpaceTags})
+		return nil, status.New(ErrAPIMinerSpaceState, ErrCode[ErrAPIMinerSpaceState]).Err()
+	}
+	for _, tag := range in.Tags {
+		if len(tag) > maxSpaceTagLen {
+			logging.CPrint(logging.ERROR, "space tag is too long", logging.LogFormat{"tag": tag, "allowed": maxSpaceTagLen})
+			return nil, status.New(ErrAPIMinerSpaceState, ErrCode[ErrAPIMinerSpaceState]).Err()
+		}
+	}
+	if in.PayoutAddress != "" {
+		if err = checkAddressLen(in.PayoutAddress); err != nil {
+			return nil, err
+		}
+		if _, err = massutil.NewAddressesFromStringList([]string{in.PayoutAddress}, &config.ChainParams); err != nil {
+			logging.CPrint(logging.ERROR, "fail to decode payout_address", logging.LogFormat{"addr": in.PayoutAddress})
+			return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
+		}
+	}
+
+	wsi, err := s.getWorkSpaceInfo(in.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.spaceKeeper.SetSpaceLabels(wsi.SpaceID, in.Tags, in.PayoutAddress)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to update space state", logging.LogFormat{"err": err, "sid": wsi.SpaceID})
+		return nil, status.New(ErrAPIMinerSpaceState, err.Error()).Err()
+	}
+	resp := spaceState2ProtoResponse(wsi.SpaceID, state)
+
+	logging.CPrint(logging.INFO, "UpdateCapacitySpaceState completed", logging.LogFormat{"resp": resp})
+	return resp, nil
+}
+
+func spaceState2ProtoResponse(sid string, state capacity.SpaceState) *pb.WorkSpaceStateResponse {
+	tags := state.Tags
+	if tags == nil {
+		tags = make([]string, 0)
+	}
+	return &pb.WorkSpaceStateResponse{
+		SpaceId:       sid,
+		Desired:       state.Desired,
+		Tags:          tags,
+		PayoutAddress: state.PayoutAddress,
+	}
+}
+
+func (s *Server) GetPlotQueue(ctx context.Context, in *empty.Empty) (*pb.PlotQueueResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetPlotQueue")
+
+	resp, err := s.getPlotQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "GetPlotQueue completed", logging.LogFormat{"paused": resp.Paused, "count": len(resp.Items)})
+	return resp, nil
+}
+
+// UpdatePlotQueue pauses, resumes or reorders the plot queue by action.
+func (s *Server) UpdatePlotQueue(ctx context.Context, in *pb.UpdatePlotQueueRequest) (*pb.PlotQueueResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for UpdatePlotQueue", logging.LogFormat{"action": in.Action, "space_ids": in.SpaceIds})
+
+	var err error
+	switch in.Action {
+	case "pause":
+		err = s.spaceKeeper.PausePlotQueue()
+	case "resume":
+		err = s.spaceKeeper.ResumePlotQueue()
+	case "reorder":
+		if len(in.SpaceIds) == 0 {
+			logging.CPrint(logging.ERROR, "space_ids is empty for reorder")
+			return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+		}
+		sids := make([]string, len(in.SpaceIds))
+		for i, id := range in.SpaceIds {
+			if sids[i], err = decodeAPISpaceID(id); err != nil {
+				return nil, status.New(ErrAPIMinerInvalidSpaceID, ErrCode[ErrAPIMinerInvalidSpaceID]).Err()
+			}
+		}
+		err = s.spaceKeeper.ReorderPlotQueue(sids)
+	default:
+		logging.CPrint(logging.ERROR, "invalid plot queue action", logging.LogFormat{"action": in.Action})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to update plot queue", logging.LogFormat{"err": err, "action": in.Action})
+		return nil, status.New(ErrAPIMinerPlotQueue, err.Error()).Err()
+	}
+
+	resp, err := s.getPlotQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "UpdatePlotQueue completed", logging.LogFormat{"paused": resp.Paused, "count": len(resp.Items)})
+	return resp, nil
+}
+
+func (s *Server) getPlotQueue() (*pb.PlotQueueResponse, error) {
+	items, paused, err := s.spaceKeeper.PlotQueue()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to get plot queue", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+	}
+	resp := &pb.PlotQueueResponse{
+		Paused: paused,
+		Items:  make([]*pb.PlotQueueItem, len(items)),
+	}
+	for i, item := range items {
+		resp.Items[i] = &pb.PlotQueueItem{
+			SpaceId:   item.SpaceID,
+			Device:    item.Device,
+			Running:   item.Running,
+			Position:  uint32(item.Position),
+			Progress:  item.Progress,
+			WouldMine: item.WouldMining,
+			Memory:    item.Memory,
+		}
+	}
+	return resp, nil
+}
+
 func decodeAPISpaceID(id string) (string, error) {
 	data := strings.Split(id, "-")
 	if len(data) != 2 {
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"massnet.org/mass/config"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+)
+
+const (
+	tlsCertOrganization = "mass autogenerated cert"
+	tlsCertValidity     = 10 * 365 * 24 * time.Hour
+
+	// tlsServerName is included in autogenerated cert, for gateway to verify gRPC server
+	// listening on GRPCListenAddress.
+	tlsServerName = "localhost"
+)
+
+var ErrTLSCertPairIncomplete = errors.New("only one of api tls cert and key exists")
+
+func fileExists(name string) bool {
+	if _, err := os.Stat(name); err != nil {
+		return !os.IsNotExist(err)
+	}
+	return true
+}
+
+// ensureTLSCertPair generates a self-signed cert pair for api if neither
+// of the configured files exists.
+func ensureTLSCertPair(cfg *config.Config) error {
+	certFile, keyFile := cfg.Network.API.APITLSCert, cfg.Network.API.APITLSKey
+	certExists, keyExists := fileExists(certFile), fileExists(keyFile)
+	if certExists && keyExists {
+		return nil
+	}
+	if certExists || keyExists {
+		logging.CPrint(logging.ERROR, "only one of api tls cert and key exists", logging.LogFormat{"cert": certFile, "key": keyFile})
+		return ErrTLSCertPairIncomplete
+	}
+
+	cert, key, err := massutil.NewTLSCertPair(tlsCertOrganization, time.Now().Add(tlsCertValidity), nil)
+	if err != nil {
+		return err
+	}
+	for _, dir := range []string{filepath.Dir(certFile), filepath.Dir(keyFile)} {
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+	if err = ioutil.WriteFile(certFile, cert, 0644); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		os.Remove(certFile)
+		return err
+	}
+	logging.CPrint(logging.INFO, "generated api tls cert pair", logging.LogFormat{"cert": certFile, "key": keyFile})
+	return nil
+}
+
+func serverTLSCredentials(cfg *config.Config) (credentials.TransportCredentials, error) {
+	if err := ensureTLSCertPair(cfg); err != nil {
+		return nil, err
+	}
+	return credentials.NewServerTLSFromFile(cfg.Network.API.APITLSCert, cfg.Network.API.APITLSKey)
+}
+
+func clientTLSCredentials(cfg *config.Config) (credentials.TransportCredentials, error) {
+	return credentials.NewClientTLSFromFile(cfg.Network.API.APITLSCert, tlsServerName)
+}
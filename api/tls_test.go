@@ -0,0 +1,121 @@
+package api
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	pb "massnet.org/mass/api/proto"
+	"massnet.org/mass/config"
+	configpb "massnet.org/mass/config/pb"
+)
+
+func newTestTLSConfig(dir string) *config.Config {
+	return &config.Config{Config: &configpb.Config{Network: &configpb.NetworkConfig{API: &configpb.APIConfig{
+		APITLS:     true,
+		APITLSCert: filepath.Join(dir, "tls", "api.cert"),
+		APITLSKey:  filepath.Join(dir, "tls", "key", "api.key"),
+	}}}}
+}
+
+func TestEnsureTLSCertPair(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apitls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := newTestTLSConfig(dir)
+	certFile, keyFile := cfg.Network.API.APITLSCert, cfg.Network.API.APITLSKey
+
+	// generated into missing dirs
+	if err = ensureTLSCertPair(cfg); err != nil {
+		t.Fatal(err)
+	}
+	cert, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := fi.Mode().Perm(); perm != 0600 {
+		t.Errorf("unexpected permission of key, %v", perm)
+	}
+
+	// existing pair is kept
+	if err = ensureTLSCertPair(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if kept, err := ioutil.ReadFile(certFile); err != nil || !bytes.Equal(kept, cert) {
+		t.Errorf("existing cert is overwritten, %v", err)
+	}
+
+	// incomplete pair is never overwritten
+	if err = os.Remove(keyFile); err != nil {
+		t.Fatal(err)
+	}
+	if err = ensureTLSCertPair(cfg); err != ErrTLSCertPairIncomplete {
+		t.Errorf("expected ErrTLSCertPairIncomplete, got %v", err)
+	}
+	if fileExists(keyFile) {
+		t.Error("key is generated for existing cert")
+	}
+	if _, err = serverTLSCredentials(cfg); err != ErrTLSCertPairIncomplete {
+		t.Errorf("expected ErrTLSCertPairIncomplete on server credentials, got %v", err)
+	}
+}
+
+func TestTLSCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apitls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := newTestTLSConfig(dir)
+
+	serverCreds, err := serverTLSCredentials(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCreds, err := clientTLSCredentials(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := grpc.NewServer(grpc.Creds(serverCreds))
+	pb.RegisterApiServiceServer(srv, &authTestService{})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	// gateway verifies autogenerated cert of gRPC server
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(clientCreds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	resp, err := pb.NewApiServiceClient(conn).GetBestBlock(context.Background(), &empty.Empty{})
+	if err != nil || resp.Height != 100 {
+		t.Errorf("unexpected response over tls, %v, %v", resp, err)
+	}
+
+	// plaintext client is refused
+	insecure, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer insecure.Close()
+	if _, err = pb.NewApiServiceClient(insecure).GetBestBlock(context.Background(), &empty.Empty{}); err == nil {
+		t.Error("plaintext request is served over tls")
+	}
+}
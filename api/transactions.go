@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/hex"
 	"errors"
+	"path/filepath"
 	"reflect"
 	"sort"
 
@@ -20,12 +21,18 @@ import (
 )
 
 func (s *Server) GetCoinbase(ctx context.Context, in *pb.GetCoinbaseRequest) (*pb.GetCoinbaseResponse, error) {
-	block, err := s.chain.GetBlockByHeight(in.Height)
+	view, err := s.newChainView()
 	if err != nil {
 		return nil, err
 	}
+	defer view.Close()
 
-	currentHeight := s.chain.BestBlockHeight()
+	block, err := view.GetBlockByHeight(in.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	currentHeight := view.BestBlockHeight()
 
 	txs := block.Transactions()
 	if len(txs) <= 0 {
@@ -34,7 +41,7 @@ func (s *Server) GetCoinbase(ctx context.Context, in *pb.GetCoinbaseRequest) (*p
 	coinbase := txs[0]
 	msgtx := coinbase.MsgTx()
 
-	vins, bindingValue, err := s.showCoinbaseInputDetails(msgtx)
+	vins, bindingValue, err := s.showCoinbaseInputDetails(view, msgtx)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +127,105 @@ func (s *Server) GetTxPoolVerbose1(ctx context.Context, in *empty.Empty) (*pb.Ge
 	return resp, nil
 }
 
+func (s *Server) PrioritiseTransaction(ctx context.Context, in *pb.PrioritiseTransactionRequest) (*pb.PrioritiseTransactionResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for PrioritiseTransaction", logging.LogFormat{"txid": in.Txid, "fee_delta": in.FeeDelta})
+
+	if err := checkHashLen(in.Txid); err != nil {
+		return nil, err
+	}
+	txHash, err := wire.NewHashFromStr(in.Txid)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode the input string into hash", logging.LogFormat{"input string": in.Txid, "error": err})
+		return nil, status.New(ErrAPIShaHashFromStr, ErrCode[ErrAPIShaHashFromStr]).Err()
+	}
+
+	feeDelta := s.txMemPool.PrioritiseTransaction(txHash, in.FeeDelta)
+
+	logging.CPrint(logging.INFO, "PrioritiseTransaction completed", logging.LogFormat{"txid": in.Txid, "fee_delta": feeDelta})
+	return &pb.PrioritiseTransactionResponse{
+		Txid:     txHash.String(),
+		FeeDelta: feeDelta,
+		InPool:   s.txMemPool.IsTransactionInPool(txHash),
+	}, nil
+}
+
+func (s *Server) SaveMempool(ctx context.Context, in *empty.Empty) (*pb.SaveMempoolResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for SaveMempool")
+
+	path := filepath.Join(s.config.Db.DataDir, blockchain.MempoolFileName)
+	txCount, orphanCount, err := s.txMemPool.SaveMempool(path)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to save memory pool", logging.LogFormat{"path": path, "err": err})
+		return nil, status.New(ErrAPISaveMempool, ErrCode[ErrAPISaveMempool]).Err()
+	}
+
+	logging.CPrint(logging.INFO, "SaveMempool completed", logging.LogFormat{"tx_count": txCount, "orphan_count": orphanCount})
+	return &pb.SaveMempoolResponse{
+		TxCount:     uint32(txCount),
+		OrphanCount: uint32(orphanCount),
+		Path:        path,
+	}, nil
+}
+
+func (s *Server) GetTxOutSetInfo(ctx context.Context, in *empty.Empty) (*pb.GetTxOutSetInfoResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetTxOutSetInfo")
+
+	info, err := s.chain.FetchUtxoSetInfo()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to read utxo set", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPITxOutSet, ErrCode[ErrAPITxOutSet]).Err()
+	}
+	resp, err := marshalTxOutSetInfo(info)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "GetTxOutSetInfo completed", logging.LogFormat{"height": info.Height, "txouts": info.TxOuts})
+	return resp, nil
+}
+
+func (s *Server) DumpTxOutSet(ctx context.Context, in *empty.Empty) (*pb.GetTxOutSetInfoResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for DumpTxOutSet")
+
+	path := filepath.Join(s.config.Db.DataDir, blockchain.UtxoSetFileName)
+	info, err := s.chain.DumpUtxoSet(path)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to dump utxo set", logging.LogFormat{"path": path, "err": err})
+		return nil, status.New(ErrAPIDumpTxOutSet, ErrCode[ErrAPIDumpTxOutSet]).Err()
+	}
+	resp, err := marshalTxOutSetInfo(info)
+	if err != nil {
+		return nil, err
+	}
+	resp.Path = path
+
+	logging.CPrint(logging.INFO, "DumpTxOutSet completed", logging.LogFormat{"height": info.Height, "txouts": info.TxOuts})
+	return resp, nil
+}
+
+func marshalTxOutSetInfo(info *blockchain.UtxoSetInfo) (*pb.GetTxOutSetInfoResponse, error) {
+	amounts := make([]string, 3)
+	for i, amount := range []massutil.Amount{info.TotalAmount, info.StakingAmount, info.BindingAmount} {
+		str, err := AmountToString(amount.IntValue())
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to convert amount", logging.LogFormat{"amount": amount, "err": err})
+			return nil, status.New(ErrAPIFailedToMaxwell, ErrCode[ErrAPIFailedToMaxwell]).Err()
+		}
+		amounts[i] = str
+	}
+	return &pb.GetTxOutSetInfoResponse{
+		Height:         info.Height,
+		BestBlock:      info.BestHash.String(),
+		Transactions:   info.Transactions,
+		Txouts:         info.TxOuts,
+		TotalAmount:    amounts[0],
+		StakingAmount:  amounts[1],
+		BindingAmount:  amounts[2],
+		SerializedSize: info.SerializedSize,
+		HashSerialized: info.SerializedHash.String(),
+	}, nil
+}
+
 func (s *Server) marshalGetTxPoolResponse(resp reflect.Value, verbose int) error {
 	resp = reflect.Indirect(resp)
 	txs := s.txMemPool.TxDescs()
@@ -226,8 +332,22 @@ func (s *Server) marshalGetTxDescResponse(resp reflect.Value, txD *blockchain.Tx
 		for _, txIn := range txIns {
 			depends = append(depends, &pb.TxOutPoint{Txid: txIn.PreviousOutPoint.Hash.String(), Index: txIn.PreviousOutPoint.Index})
 		}
+		ancestorFees, err := massutil.NewAmountFromInt(txD.AncestorFees)
+		if err != nil {
+			return err
+		}
+		descendantFees, err := massutil.NewAmountFromInt(txD.DescendantFees)
+		if err != nil {
+			return err
+		}
 		resp.FieldByName("CurrentPriority").SetFloat(priority)
 		resp.FieldByName("Depends").Set(reflect.ValueOf(depends))
+		resp.FieldByName("AncestorCount").SetUint(uint64(txD.AncestorCount))
+		resp.FieldByName("AncestorSize").SetUint(uint64(txD.AncestorSize))
+		resp.FieldByName("AncestorFees").SetString(ancestorFees.String())
+		resp.FieldByName("DescendantCount").SetUint(uint64(txD.DescendantCount))
+		resp.FieldByName("DescendantSize").SetUint(uint64(txD.DescendantSize))
+		resp.FieldByName("DescendantFees").SetString(descendantFees.String())
 	}
 
 	return nil
@@ -248,7 +368,7 @@ func (s *Server) marshalGetOrphanTxDescResponse(resp reflect.Value, orphan *mass
 	return nil
 }
 
-func (s *Server) showCoinbaseInputDetails(mtx *wire.MsgTx) ([]*pb.Vin, int64, error) {
+func (s *Server) showCoinbaseInputDetails(view *chainView, mtx *wire.MsgTx) ([]*pb.Vin, int64, error) {
 	vinList := make([]*pb.Vin, len(mtx.TxIn))
 	var bindingValue int64
 	if blockchain.IsCoinBaseTx(mtx) {
@@ -267,7 +387,7 @@ func (s *Server) showCoinbaseInputDetails(mtx *wire.MsgTx) ([]*pb.Vin, int64, er
 				Sequence: txIn.Sequence,
 			}
 
-			originTx, err := s.chain.GetTransaction(&txIn.PreviousOutPoint.Hash)
+			originTx, err := view.GetTransaction(&txIn.PreviousOutPoint.Hash)
 			if err != nil {
 				return nil, 0, err
 			}
@@ -375,7 +495,7 @@ func (s *Server) showCoinbaseOutputDetails(mtx *wire.MsgTx, chainParams *config.
 	return voutList, totalFees.IntValue(), nil
 }
 
-func (s *Server) createTxRawResult(chainParams *config.Params, mtx *wire.MsgTx, txHash string,
+func (s *Server) createTxRawResult(view *chainView, chainParams *config.Params, mtx *wire.MsgTx, txHash string,
 	blkHeader *wire.BlockHeader, blkHash string, blkHeight uint64, chainHeight uint64) (*pb.TxRawResult, error) {
 
 	voutList, totalOutValue, err := createVoutList(mtx, chainParams, nil)
@@ -399,7 +519,7 @@ func (s *Server) createTxRawResult(chainParams *config.Params, mtx *wire.MsgTx,
 		mtx.Payload = make([]byte, 0)
 	}
 
-	vins, fromAddrs, inputs, totalInValue, err := s.createVinList(mtx, chainParams)
+	vins, fromAddrs, inputs, totalInValue, err := s.createVinList(view, mtx, chainParams)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to create vin list", logging.LogFormat{"error": err})
 		return nil, err
@@ -410,11 +530,11 @@ func (s *Server) createTxRawResult(chainParams *config.Params, mtx *wire.MsgTx,
 		st := status.New(ErrAPIShaHashFromStr, ErrCode[ErrAPIShaHashFromStr])
 		return nil, st.Err()
 	}
-	code, err := s.getTxStatus(txid)
+	code, err := s.getTxStatus(view, txid)
 	if err != nil {
 		return nil, err
 	}
-	txType, err := s.getTxType(mtx)
+	txType, err := s.getTxType(view, mtx)
 	if err != nil {
 		return nil, err
 	}
@@ -455,7 +575,7 @@ func (s *Server) createTxRawResult(chainParams *config.Params, mtx *wire.MsgTx,
 // |-----------------------------------------------------|
 // | Type Code |    1    |    2    |     3    |     4    |
 //   ----------------------------------------------------
-func (s *Server) getTxType(tx *wire.MsgTx) (int32, error) {
+func (s *Server) getTxType(view *chainView, tx *wire.MsgTx) (int32, error) {
 	if blockchain.IsCoinBaseTx(tx) {
 		return 4, nil
 	}
@@ -470,7 +590,7 @@ func (s *Server) getTxType(tx *wire.MsgTx) (int32, error) {
 	for _, txIn := range tx.TxIn {
 		hash := txIn.PreviousOutPoint.Hash
 		index := txIn.PreviousOutPoint.Index
-		tx, err := s.chain.GetTransaction(&hash)
+		tx, err := view.GetTransaction(&hash)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "No information available about transaction in db", logging.LogFormat{"err": err, "txid": hash.String()})
 			st := status.New(ErrAPINoTxInfo, ErrCode[ErrAPINoTxInfo])
@@ -570,7 +690,7 @@ func createVoutList(mtx *wire.MsgTx, chainParams *config.Params, filterAddrMap m
 	return voutList, totalOutValue, nil
 }
 
-func (s *Server) createVinList(mtx *wire.MsgTx, chainParams *config.Params) ([]*pb.Vin, []string, []*pb.InputsInTx, int64, error) {
+func (s *Server) createVinList(view *chainView, mtx *wire.MsgTx, chainParams *config.Params) ([]*pb.Vin, []string, []*pb.InputsInTx, int64, error) {
 	// Coinbase transactions only have a single txin by definition.
 	vinList := make([]*pb.Vin, len(mtx.TxIn))
 	addrs := make([]string, 0)
@@ -606,7 +726,7 @@ func (s *Server) createVinList(mtx *wire.MsgTx, chainParams *config.Params) ([]*
 		}
 		vinList[i] = vinEntry
 
-		addrs, inValue, err := s.getTxInAddr(&txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index, chainParams)
+		addrs, inValue, err := s.getTxInAddr(view, &txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index, chainParams)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "No information available about transaction in db", logging.LogFormat{"err": err.Error(), "txid": txIn.PreviousOutPoint.Hash.String()})
 			st := status.New(ErrAPINoTxInfo, ErrCode[ErrAPINoTxInfo])
@@ -624,13 +744,13 @@ func (s *Server) createVinList(mtx *wire.MsgTx, chainParams *config.Params) ([]*
 	return vinList, addrs, inputs, totalInValue, nil
 }
 
-func (s *Server) getTxInAddr(txid *wire.Hash, index uint32, chainParams *config.Params) ([]string, int64, error) {
+func (s *Server) getTxInAddr(view *chainView, txid *wire.Hash, index uint32, chainParams *config.Params) ([]string, int64, error) {
 	addrStrs := make([]string, 0)
 	var inValue int64
 	tx, err := s.txMemPool.FetchTransaction(txid)
 	var inmtx *wire.MsgTx
 	if err != nil {
-		txReply, err := s.chain.GetTransactionInDB(txid)
+		txReply, err := view.GetTransactionInDB(txid)
 		if err != nil || len(txReply) == 0 {
 			logging.CPrint(logging.ERROR, "No information available about transaction in db", logging.LogFormat{"err": err, "txid": txid.String()})
 			st := status.New(ErrAPINoTxInfo, ErrCode[ErrAPINoTxInfo])
@@ -651,8 +771,8 @@ func (s *Server) getTxInAddr(txid *wire.Hash, index uint32, chainParams *config.
 	return addrStrs, inValue, nil
 }
 
-func (s *Server) getTxStatus(txHash *wire.Hash) (code int32, err error) {
-	txList, err := s.chain.GetTransactionInDB(txHash)
+func (s *Server) getTxStatus(view *chainView, txHash *wire.Hash) (code int32, err error) {
+	txList, err := view.GetTransactionInDB(txHash)
 	if err != nil || len(txList) == 0 {
 		_, err := s.txMemPool.FetchTransaction(txHash)
 		if err != nil {
@@ -668,7 +788,7 @@ func (s *Server) getTxStatus(txHash *wire.Hash) (code int32, err error) {
 
 	lastTx := txList[len(txList)-1]
 	txHeight := lastTx.Height
-	bestHeight := s.chain.BestBlockHeight()
+	bestHeight := view.BestBlockHeight()
 	confirmations := 1 + bestHeight - txHeight
 	if confirmations < 0 {
 		code = -1
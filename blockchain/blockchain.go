@@ -6,6 +6,7 @@ import (
 
 	"github.com/golang/groupcache/lru"
 	"massnet.org/mass/config"
+	"massnet.org/mass/consensus"
 	"massnet.org/mass/database"
 	"massnet.org/mass/logging"
 	"massnet.org/mass/massutil"
@@ -56,23 +57,35 @@ type Blockchain struct {
 	dmd            *DoubleMiningDetector // double mining detector
 	processBlockCh chan *processBlockMsg
 	listeners      map[Listener]struct{}
+	templatePolicy TemplatePolicy
 
-	info      *chainInfo
-	errCache  *lru.Cache
-	sigCache  *txscript.SigCache
-	hashCache *txscript.HashCache
+	info            *chainInfo
+	errCache        *lru.Cache
+	sigCache        *txscript.SigCache
+	hashCache       *txscript.HashCache
+	scriptValidator *scriptValidator
+	assumeValid     *config.Checkpoint
+
+	assumeValidLock    sync.Mutex
+	assumeValidHeaders map[wire.Hash]uint64 // ancestors of assume-valid block to height
 }
 
-func NewBlockchain(db database.Db, dbPath string, server Server) (*Blockchain, error) {
+// NewBlockchain returns a Blockchain, DefaultScriptValidationConfig is used if svCfg is nil.
+func NewBlockchain(db database.Db, dbPath string, server Server, svCfg *ScriptValidationConfig) (*Blockchain, error) {
+	if svCfg == nil {
+		svCfg = DefaultScriptValidationConfig()
+	}
 	chain := &Blockchain{
 		db:             db,
 		blockTree:      NewBlockTree(),
 		dmd:            NewDoubleMiningDetector(db),
 		processBlockCh: make(chan *processBlockMsg, maxProcessBlockChSize),
 		errCache:       lru.New(blockErrCacheSize),
-		sigCache:       txscript.NewSigCache(sigCacheMaxSize),
+		sigCache:       txscript.NewSigCache(svCfg.SigCacheSize),
 		hashCache:      txscript.NewHashCache(hashCacheMaxSize),
 		listeners:      make(map[Listener]struct{}),
+		templatePolicy: DefaultTemplatePolicy(),
+		assumeValid:    svCfg.AssumeValid,
 	}
 	chain.cond.L = &sync.Mutex{}
 
@@ -112,6 +125,7 @@ func NewBlockchain(db database.Db, dbPath string, server Server) (*Blockchain, e
 		return nil, err
 	}
 
+	chain.scriptValidator = newScriptValidator(svCfg.Workers)
 	go chain.blockProcessor()
 
 	return chain, nil
@@ -272,6 +286,12 @@ func (chain *Blockchain) getPrevNodeFromNode(node *BlockNode) (*BlockNode, error
 	return prevBlockNode, nil
 }
 
+// Stop stops the script validation workers.  Blocks and transactions are
+// not validated after Stop.
+func (chain *Blockchain) Stop() {
+	chain.scriptValidator.Stop()
+}
+
 func (chain *Blockchain) blockProcessor() {
 	for msg := range chain.processBlockCh {
 		isOrphan, err := chain.processBlock(msg.block, msg.flags)
@@ -496,3 +516,43 @@ func (chain *Blockchain) FetchScriptHashRelatedBindingTx(scriptHash []byte, chai
 	defer chain.l.RUnlock()
 	return chain.db.FetchScriptHashRelatedBindingTx(scriptHash, chainParams)
 }
+
+// CheckPubKeyBinding returns the total value of mature binding outputs of pubKey
+// which could be spent by the next block, and whether the total value meets the
+// binding requirement of bitLength.  Like the block template, at most MaxBindingNum
+// outputs are counted.
+func (chain *Blockchain) CheckPubKeyBinding(pubKey *pocec.PublicKey, bitLength int) (massutil.Amount, bool, error) {
+	pkScriptHash, err := pkToScriptHash(pubKey.SerializeCompressed(), &config.ChainParams)
+	if err != nil {
+		return massutil.ZeroAmount(), false, err
+	}
+
+	chain.l.RLock()
+	defer chain.l.RUnlock()
+	nextBlockHeight := chain.blockTree.bestBlockNode().Height + 1
+	bindingTxListReply, err := chain.db.FetchScriptHashRelatedBindingTx(pkScriptHash, &config.ChainParams)
+	if err != nil {
+		return massutil.ZeroAmount(), false, err
+	}
+
+	totalBinding := massutil.ZeroAmount()
+	bindingNum := 0
+	for _, bindingTx := range bindingTxListReply {
+		maturity := consensus.TransactionMaturity
+		if bindingTx.IsCoinbase {
+			maturity = consensus.CoinbaseMaturity
+		}
+		if nextBlockHeight-bindingTx.Height < maturity {
+			continue
+		}
+		if totalBinding, err = totalBinding.AddInt(bindingTx.Value); err != nil {
+			return massutil.ZeroAmount(), false, err
+		}
+		if bindingNum++; bindingNum >= MaxBindingNum {
+			break
+		}
+	}
+
+	valueRequired, ok := bindingRequiredAmount[bitLength]
+	return totalBinding, ok && totalBinding.Cmp(valueRequired) >= 0, nil
+}
@@ -25,6 +25,7 @@ func newBlockChain() (*Blockchain, func(), error) {
 		return nil, nil, err
 	}
 	return bc, func() {
+		bc.Stop()
 		db.Close()
 		teardown()
 	}, nil
@@ -79,6 +79,7 @@ func newReorgTestChain(genesis *massutil.Block, name string) (*Blockchain, func(
 	}
 
 	return bc, func() {
+		bc.Stop()
 		db.Close()
 		os.RemoveAll(path)
 		os.RemoveAll(cachePath)
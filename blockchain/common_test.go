@@ -1,9 +1,7 @@
 package blockchain
 
 import (
-	"bufio"
 	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"math"
 	"os"
@@ -14,12 +12,12 @@ import (
 	"massnet.org/mass/consensus"
 	"massnet.org/mass/database"
 	"massnet.org/mass/database/ldb"
+	"massnet.org/mass/database/ldb/dbtest"
 	"massnet.org/mass/database/memdb"
 	"massnet.org/mass/errors"
 	"massnet.org/mass/logging"
 	"massnet.org/mass/massutil"
 	"massnet.org/mass/txscript"
-	"massnet.org/mass/wire"
 )
 
 var (
@@ -28,7 +26,8 @@ var (
 	logpath = "./" + dataDir + "/testlog"
 	// dbType             = "leveldb"
 
-	blks200 []*massutil.Block
+	blks200  []*massutil.Block
+	mockBlks *dbtest.MockBlocks
 )
 
 // testDbRoot is the root directory used to create all test databases.
@@ -130,7 +129,7 @@ func loadTestBlksIntoTestChainDb(db database.Db, numBlks int) error {
 }
 
 func newTestBlockchain(db database.Db, blkCachePath string) (*Blockchain, error) {
-	chain, err := NewBlockchain(db, blkCachePath, nil)
+	chain, err := NewBlockchain(db, blkCachePath, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -139,25 +138,11 @@ func newTestBlockchain(db database.Db, blkCachePath string) (*Blockchain, error)
 }
 
 func init() {
-	f, err := os.Open("./data/mockBlks.dat")
-	if err != nil {
+	var err error
+	if mockBlks, err = dbtest.LoadMockBlocks("./data/mockBlks.dat"); err != nil {
 		panic(err)
 	}
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		buf, err := hex.DecodeString(scanner.Text())
-		if err != nil {
-			panic(err)
-		}
-
-		blk, err := massutil.NewBlockFromBytes(buf, wire.Packet)
-		if err != nil {
-			panic(err)
-		}
-		blks200 = append(blks200, blk)
-	}
+	blks200 = mockBlks.Blocks()
 }
 
 // the 1st block is genesis
@@ -177,6 +162,12 @@ func loadTopNBlk(n int) ([]*massutil.Block, error) {
 	return blks200[:n], nil
 }
 
+// loadFreshTopNBlk returns the first n blocks decoded again, which are not
+// affected by tests modifying blocks returned by loadTopNBlk.
+func loadFreshTopNBlk(n int) ([]*massutil.Block, error) {
+	return mockBlks.FreshTopN(n)
+}
+
 func newWitnessScriptAddress(pubkeys []*btcec.PublicKey, nrequired int,
 	addressClass uint16, net *config.Params) ([]byte, massutil.Address, error) {
 
@@ -8,6 +8,9 @@ var (
 	errConnectMainChain        = errors.New("connectBlock must be called with a block that extends the main chain")
 	errDisconnectMainChain     = errors.New("disconnectBlock must be called with the block at the end of the main chain")
 	errWaitForOldBlockHeight   = errors.New("blockWaiter wait for old block height")
+	errReindexInterrupted      = errors.New("reindexing is interrupted")
+	errReindexNotConnected     = errors.New("stored block is not connected to main chain on reindexing")
+	errScriptValidatorStopped  = errors.New("script validator is stopped")
 
 	// BlockTree
 	errExpandOrphanRootBlockNode = errors.New("can not expand orphan block on root of blockTree")
@@ -63,6 +66,22 @@ var (
 	ErrCoinbaseTx          = errors.New("transaction is an individual coinbase")
 	ErrProhibitionOrphanTx = errors.New("Do not accept orphan transactions")
 	ErrInvalidTxVersion    = errors.New("transaction version is invalid")
+	ErrTooLongMempoolChain = errors.New("transaction exceeds limits of in-pool ancestors or descendants")
+
+	// Mempool File
+	ErrMempoolFileVersion   = errors.New("unsupported memory pool file version")
+	ErrMempoolFileCorrupted = errors.New("memory pool file is corrupted")
+
+	// Replacement
+	ErrReplacementTooManyEvictions    = errors.New("replacement transaction evicts too many transactions")
+	ErrReplacementSpendsConflict      = errors.New("replacement transaction spends output of transaction it replaces")
+	ErrReplacementUnconfirmedInput    = errors.New("replacement transaction spends new unconfirmed output")
+	ErrReplacementInsufficientFeeRate = errors.New("replacement transaction has no higher fee rate than transaction it replaces")
+	ErrReplacementInsufficientFee     = errors.New("replacement transaction pays insufficient fee")
+
+	// Block Template
+	ErrUnknownTemplateStrategy = errors.New("unknown block template strategy")
+	ErrInvalidTemplatePolicy   = errors.New("invalid block template policy")
 
 	// Coinbase
 	ErrCoinbaseTxInWitness = errors.New("coinbaseTx txIn`s witness size must be 0")
@@ -125,3 +144,14 @@ var (
 	// Listener
 	errNilArgument = errors.New("nil argument")
 )
+
+// IsReplacementRejected returns whether err is caused by a replacement
+// transaction which fails to meet the replace-by-fee rules.
+func IsReplacementRejected(err error) bool {
+	switch err {
+	case ErrReplacementTooManyEvictions, ErrReplacementSpendsConflict, ErrReplacementUnconfirmedInput,
+		ErrReplacementInsufficientFeeRate, ErrReplacementInsufficientFee:
+		return true
+	}
+	return false
+}
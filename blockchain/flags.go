@@ -8,6 +8,10 @@ const (
 
 	BFNoPoCCheck
 
+	// BFReindex reconnects a stored block of the main chain, which exists in
+	// database while chain state is being rebuilt.
+	BFReindex
+
 	// BFNone is a convenience value to specifically indicate no flags.
 	BFNone BehaviorFlags = 0
 )
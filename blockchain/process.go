@@ -101,6 +101,12 @@ func (chain *Blockchain) processOrphans(hash *wire.Hash, flags BehaviorFlags) er
 func (chain *Blockchain) processBlock(block *massutil.Block, flags BehaviorFlags) (isOrphan bool, err error) {
 	var startProcessing = time.Now()
 
+	if flags.isFlagSet(BFReindex) {
+		// stored blocks have been checked when first accepted, and
+		// are skipped below as they exist in database
+		return false, chain.maybeAcceptBlock(block, flags&^BFReindex)
+	}
+
 	if flags.isFlagSet(BFNoPoCCheck) {
 		// Perform preliminary sanity checks on the block and its transactions.
 		err := checkBlockSanity(block, chain.info.chainID, config.ChainParams.PocLimit, flags)
@@ -0,0 +1,63 @@
+package blockchain
+
+import (
+	"massnet.org/mass/database"
+	"massnet.org/mass/logging"
+)
+
+// Reindex continues reindexing started by database.Db.StartReindex, by
+// reconnecting stored blocks above the best block through the block processor
+// until the reindex target is reached, so that it is serialized with blocks
+// submitted by ProcessBlock.  Derived state is committed along
+// with each block, so an interrupted reindex resumes from the best block the
+// next time.
+//
+// It returns nil immediately if the database is not being reindexed, and
+// errReindexInterrupted if interrupt is closed before completion.
+func (chain *Blockchain) Reindex(interrupt <-chan struct{}) error {
+	target, targetHeight, err := chain.db.FetchReindexTarget()
+	if err != nil {
+		if err == database.ErrNotReindexing {
+			return nil
+		}
+		return err
+	}
+
+	logging.CPrint(logging.INFO, "reindexing blocks", logging.LogFormat{
+		"current": chain.BestBlockHeight(), "target": targetHeight, "hash": target})
+	progress := NewBlockProgressLogger("Reindexed")
+	for height := chain.BestBlockHeight() + 1; height <= targetHeight; height++ {
+		select {
+		case <-interrupt:
+			logging.CPrint(logging.INFO, "reindexing interrupted", logging.LogFormat{"height": chain.BestBlockHeight()})
+			return errReindexInterrupted
+		default:
+		}
+
+		block, err := chain.GetBlockByHeight(height)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "fail to load stored block", logging.LogFormat{"height": height, "err": err})
+			return err
+		}
+		if _, err = chain.execProcessBlock(block, BFReindex); err != nil {
+			logging.CPrint(logging.ERROR, "fail to reconnect stored block",
+				logging.LogFormat{"height": height, "hash": block.Hash(), "err": err})
+			return err
+		}
+		if !chain.BestBlockHash().IsEqual(block.Hash()) {
+			return errReindexNotConnected
+		}
+		progress.LogBlockHeight(block)
+	}
+
+	if !chain.BestBlockHash().IsEqual(target) {
+		logging.CPrint(logging.ERROR, "reindexed best block mismatches target",
+			logging.LogFormat{"best": chain.BestBlockHash(), "target": target})
+		return errReindexNotConnected
+	}
+	if err = chain.db.FinishReindex(); err != nil {
+		return err
+	}
+	logging.CPrint(logging.INFO, "reindexing completed", logging.LogFormat{"height": targetHeight, "hash": target})
+	return nil
+}
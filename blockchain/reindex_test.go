@@ -0,0 +1,64 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass/database"
+)
+
+func TestBlockchain_Reindex(t *testing.T) {
+	bc, teardown, err := newBlockChain()
+	assert.Nil(t, err)
+	defer teardown()
+
+	blks, err := loadFreshTopNBlk(30)
+	assert.Nil(t, err)
+	for i := 1; i < 30; i++ {
+		_, err = bc.processBlock(blks[i], BFNone)
+		assert.Nil(t, err)
+	}
+	entries := bc.db.TestExportDbEntries()
+
+	// not reindexing
+	assert.Nil(t, bc.Reindex(nil))
+	_, _, err = bc.db.FetchReindexTarget()
+	assert.Equal(t, database.ErrNotReindexing, err)
+
+	assert.Nil(t, bc.db.StartReindex())
+	target, targetHeight, err := bc.db.FetchReindexTarget()
+	assert.Nil(t, err)
+	assert.Equal(t, blks[29].Hash(), target)
+	assert.Equal(t, uint64(29), targetHeight)
+	_, height, err := bc.db.NewestSha()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	// interrupted before any block is reconnected
+	bc1, err := newTestBlockchain(bc.db, dbpath)
+	assert.Nil(t, err)
+	defer bc1.Stop()
+	interrupt := make(chan struct{})
+	close(interrupt)
+	assert.Equal(t, errReindexInterrupted, bc1.Reindex(interrupt))
+	assert.Equal(t, uint64(0), bc1.BestBlockHeight())
+
+	// reindexing resumes from where it stopped
+	for i := 1; i <= 10; i++ {
+		block, err := bc1.GetBlockByHeight(uint64(i))
+		assert.Nil(t, err)
+		assert.Nil(t, bc1.maybeAcceptBlock(block, BFNone))
+	}
+	assert.Nil(t, bc1.db.StartReindex())
+	bc2, err := newTestBlockchain(bc.db, dbpath)
+	assert.Nil(t, err)
+	defer bc2.Stop()
+	assert.Equal(t, uint64(10), bc2.BestBlockHeight())
+	assert.Nil(t, bc2.Reindex(nil))
+	assert.Equal(t, uint64(29), bc2.BestBlockHeight())
+	assert.Equal(t, blks[29].Hash(), bc2.BestBlockHash())
+
+	_, _, err = bc2.db.FetchReindexTarget()
+	assert.Equal(t, database.ErrNotReindexing, err)
+	assert.Equal(t, entries, bc2.db.TestExportDbEntries())
+}
@@ -3,13 +3,51 @@ package blockchain
 import (
 	"math"
 	"runtime"
+	"sync"
+	"sync/atomic"
 
+	"massnet.org/mass/config"
 	"massnet.org/mass/logging"
 	"massnet.org/mass/massutil"
 	"massnet.org/mass/txscript"
 	"massnet.org/mass/wire"
 )
 
+const (
+	// prevalidateQueueSize is the max number of inputs waiting for prevalidation,
+	// inputs are dropped rather than blocking when the queue is full.
+	prevalidateQueueSize = 4096
+)
+
+// ScriptValidationConfig configures the shared script validation workers.
+type ScriptValidationConfig struct {
+	// Workers is the number of long-lived goroutines validating scripts.
+	Workers int
+	// SigCacheSize is the max number of entries of signature cache.
+	SigCacheSize uint
+	// AssumeValid is the block whose ancestors are assumed to have valid
+	// scripts, scripts are always validated if it is nil.
+	AssumeValid *config.Checkpoint
+}
+
+// DefaultScriptValidationConfig returns the default config which runs a worker
+// for each processor core.
+func DefaultScriptValidationConfig() *ScriptValidationConfig {
+	return &ScriptValidationConfig{
+		Workers:      runtime.NumCPU(),
+		SigCacheSize: sigCacheMaxSize,
+	}
+}
+
+// ScriptValidationStats is a snapshot of script validation workers and signature cache.
+type ScriptValidationStats struct {
+	Workers            int
+	ValidatedInputs    uint64
+	PrevalidatedInputs uint64
+	SigCache           txscript.SigCacheStats
+	AssumeValid        *config.Checkpoint
+}
+
 // txValidateItem holds a transaction along with which input to validate.
 type txValidateItem struct {
 	txInIndex int
@@ -18,133 +56,178 @@ type txValidateItem struct {
 	sigHashes *txscript.TxSigHashes
 }
 
-// txValidator provides a type which asynchronously validates transaction
-// inputs.  It provides several channels for communication and a processing
-// function that is intended to be in run multiple goroutines.
-type txValidator struct {
-	validateChan chan *txValidateItem
-	quitChan     chan struct{}
-	resultChan   chan error
-	txStore      TxStore
-	flags        txscript.ScriptFlags
-	sigCache     *txscript.SigCache
-	hashCache    *txscript.HashCache
+// txValidateBatch holds the context shared by inputs submitted by a single
+// Validate call.  Results are buffered so that workers never block on it.
+type txValidateBatch struct {
+	txStore  TxStore
+	flags    txscript.ScriptFlags
+	sigCache *txscript.SigCache
+	results  chan error
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+func (b *txValidateBatch) cancel() {
+	b.quitOnce.Do(func() { close(b.quit) })
 }
 
-// sendResult sends the result of a script pair validation on the internal
-// result channel while respecting the quit channel.  The allows orderly
-// shutdown when the validation process is aborted early due to a validation
-// error in one of the other goroutines.
-func (v *txValidator) sendResult(result error) {
+func (b *txValidateBatch) canceled() bool {
 	select {
-	case v.resultChan <- result:
-	case <-v.quitChan:
+	case <-b.quit:
+		return true
+	default:
+		return false
 	}
 }
 
-// validateHandler consumes items to validate from the internal validate channel
-// and returns the result of the validation on the internal result channel. It
-// must be run as a goroutine.
-func (v *txValidator) validateHandler() {
-out:
+type txValidateJob struct {
+	item  *txValidateItem
+	batch *txValidateBatch
+}
+
+// scriptValidator is a pool of long-lived goroutines validating transaction
+// inputs, which is shared by block validation and transaction pool.  Inputs of
+// Validate are processed before the ones of Prevalidate.
+type scriptValidator struct {
+	workers       int
+	validateCh    chan *txValidateJob
+	prevalidateCh chan *txValidateJob
+	quit          chan struct{}
+	quitOnce      sync.Once
+	wg            sync.WaitGroup
+
+	validated    uint64 // atomic
+	prevalidated uint64 // atomic
+}
+
+// newScriptValidator starts workers goroutines, at least one worker is started.
+func newScriptValidator(workers int) *scriptValidator {
+	if workers <= 0 {
+		workers = 1
+	}
+	v := &scriptValidator{
+		workers:       workers,
+		validateCh:    make(chan *txValidateJob, workers),
+		prevalidateCh: make(chan *txValidateJob, prevalidateQueueSize),
+		quit:          make(chan struct{}),
+	}
+	v.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go v.validateHandler()
+	}
+	return v
+}
+
+// Stop stops all workers and waits for them to exit, Validate returns
+// errScriptValidatorStopped after Stop.
+func (v *scriptValidator) Stop() {
+	v.quitOnce.Do(func() { close(v.quit) })
+	v.wg.Wait()
+}
+
+// validateHandler consumes items to validate from validateCh, and from
+// prevalidateCh when there is nothing to validate.  It must be run as a goroutine.
+func (v *scriptValidator) validateHandler() {
+	defer v.wg.Done()
 	for {
+		var job *txValidateJob
 		select {
-		case txVI := <-v.validateChan:
-			// Ensure the referenced input transaction is available.
-			txIn := txVI.txIn
-			originTxHash := &txIn.PreviousOutPoint.Hash
-			originTx, exists := v.txStore[*originTxHash]
-			if !exists || originTx.Err != nil || originTx.Tx == nil {
-				logging.CPrint(logging.ERROR, "unable to find input transaction",
-					logging.LogFormat{"input transaction ": originTxHash, "transaction": txVI.tx.Hash()})
-				v.sendResult(ErrMissingTx)
-				break out
-			}
-			originMsgTx := originTx.Tx.MsgTx()
-
-			// Ensure the output index in the referenced transaction
-			// is available.
-			originTxIndex := txIn.PreviousOutPoint.Index
-			if originTxIndex >= uint32(len(originMsgTx.TxOut)) {
-				logging.CPrint(logging.ERROR, "out of bounds input index in referenced transaction",
-					logging.LogFormat{"input index": originTxIndex, "originTx": originTxHash, "transaction": txVI.tx.Hash()})
-				v.sendResult(ErrBadTxInput)
-				break out
+		case <-v.quit:
+			return
+		case job = <-v.validateCh:
+		default:
+			select {
+			case job = <-v.validateCh:
+			case job = <-v.prevalidateCh:
+			case <-v.quit:
+				return
 			}
+		}
 
-			// Create a new script engine for the script pair.
-			//sigScript := txIn.SignatureScript
-
-			//check witness length
-			witness := txIn.Witness
-			if len(witness) != 2 {
-				logging.CPrint(logging.ERROR, "Invalid witness length",
-					logging.LogFormat{"transaction": txVI.tx.Hash(), "index": txVI.txInIndex, "previousOutPoint": txIn.PreviousOutPoint,
-						"input witness length": len(witness), "required witness length": 2})
-				v.sendResult(ErrWitnessLength)
-				break out
-			}
+		if job.batch.canceled() {
+			continue
+		}
+		err := validateTxInput(job.item, job.batch.txStore, job.batch.flags, job.batch.sigCache)
+		if job.batch.results != nil {
+			job.batch.results <- err
+		}
+	}
+}
 
-			pkScript := originMsgTx.TxOut[originTxIndex].PkScript
-			inputAmount := originMsgTx.TxOut[originTxIndex].Value
-			//vm, err := txscript.NewEngine(pkScript, txVI.tx.MsgTx(),
-			//	txVI.txInIndex, v.flags, v.sigCache)
-			vm, err := txscript.NewEngine(pkScript, txVI.tx.MsgTx(),
-				txVI.txInIndex, v.flags, v.sigCache, txVI.sigHashes,
-				inputAmount)
-			if err != nil {
-				logging.CPrint(logging.ERROR, "failed to construct vm engine",
-					logging.LogFormat{"transaction": txVI.tx.Hash(), "index": txVI.txInIndex, "previousOutPoint": txIn.PreviousOutPoint,
-						"input witness": witness, "reference pkScript": pkScript, "err": err})
-				v.sendResult(ErrScriptMalformed)
-				break out
-			}
+// validateTxInput validates the script pair of item.
+func validateTxInput(txVI *txValidateItem, txStore TxStore, flags txscript.ScriptFlags, sigCache *txscript.SigCache) error {
+	// Ensure the referenced input transaction is available.
+	txIn := txVI.txIn
+	originTxHash := &txIn.PreviousOutPoint.Hash
+	originTx, exists := txStore[*originTxHash]
+	if !exists || originTx.Err != nil || originTx.Tx == nil {
+		logging.CPrint(logging.ERROR, "unable to find input transaction",
+			logging.LogFormat{"input transaction ": originTxHash, "transaction": txVI.tx.Hash()})
+		return ErrMissingTx
+	}
+	originMsgTx := originTx.Tx.MsgTx()
+
+	// Ensure the output index in the referenced transaction
+	// is available.
+	originTxIndex := txIn.PreviousOutPoint.Index
+	if originTxIndex >= uint32(len(originMsgTx.TxOut)) {
+		logging.CPrint(logging.ERROR, "out of bounds input index in referenced transaction",
+			logging.LogFormat{"input index": originTxIndex, "originTx": originTxHash, "transaction": txVI.tx.Hash()})
+		return ErrBadTxInput
+	}
 
-			// Execute the script pair.
-			if err := vm.Execute(); err != nil {
-				logging.CPrint(logging.ERROR, "failed to validate signature",
-					logging.LogFormat{"transaction": txVI.tx.Hash(), "index": txVI.txInIndex, "previousOutPoint": txIn.PreviousOutPoint,
-						"input witness": witness, "reference pkScript": pkScript, "err": err})
-				v.sendResult(ErrScriptValidation)
-				break out
-			}
+	//check witness length
+	witness := txIn.Witness
+	if len(witness) != 2 {
+		logging.CPrint(logging.ERROR, "Invalid witness length",
+			logging.LogFormat{"transaction": txVI.tx.Hash(), "index": txVI.txInIndex, "previousOutPoint": txIn.PreviousOutPoint,
+				"input witness length": len(witness), "required witness length": 2})
+		return ErrWitnessLength
+	}
 
-			// Validation succeeded.
-			v.sendResult(nil)
+	// Create a new script engine for the script pair.
+	pkScript := originMsgTx.TxOut[originTxIndex].PkScript
+	inputAmount := originMsgTx.TxOut[originTxIndex].Value
+	vm, err := txscript.NewEngine(pkScript, txVI.tx.MsgTx(),
+		txVI.txInIndex, flags, sigCache, txVI.sigHashes,
+		inputAmount)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to construct vm engine",
+			logging.LogFormat{"transaction": txVI.tx.Hash(), "index": txVI.txInIndex, "previousOutPoint": txIn.PreviousOutPoint,
+				"input witness": witness, "reference pkScript": pkScript, "err": err})
+		return ErrScriptMalformed
+	}
 
-		case <-v.quitChan:
-			break out
-		}
+	// Execute the script pair.
+	if err := vm.Execute(); err != nil {
+		logging.CPrint(logging.ERROR, "failed to validate signature",
+			logging.LogFormat{"transaction": txVI.tx.Hash(), "index": txVI.txInIndex, "previousOutPoint": txIn.PreviousOutPoint,
+				"input witness": witness, "reference pkScript": pkScript, "err": err})
+		return ErrScriptValidation
 	}
+
+	// Validation succeeded.
+	return nil
 }
 
 // Validate validates the scripts for all of the passed transaction inputs using
-// multiple goroutines.
-func (v *txValidator) Validate(items []*txValidateItem) error {
+// the shared workers.  Remaining inputs are skipped once any input fails.
+func (v *scriptValidator) Validate(items []*txValidateItem, txStore TxStore, flags txscript.ScriptFlags, sigCache *txscript.SigCache) error {
 	if len(items) == 0 {
 		return nil
 	}
-
-	// Limit the number of goroutines to do script validation based on the
-	// number of processor cores.  This help ensure the system stays
-	// reasonably responsive under heavy load.
-	maxGoRoutines := runtime.NumCPU() * 3
-	if maxGoRoutines <= 0 {
-		maxGoRoutines = 1
-	}
-	if maxGoRoutines > len(items) {
-		maxGoRoutines = len(items)
+	batch := &txValidateBatch{
+		txStore:  txStore,
+		flags:    flags,
+		sigCache: sigCache,
+		results:  make(chan error, len(items)),
+		quit:     make(chan struct{}),
 	}
+	defer batch.cancel()
 
-	// Start up validation handlers that are used to asynchronously
-	// validate each transaction input.
-	for i := 0; i < maxGoRoutines; i++ {
-		go v.validateHandler()
-	}
-
-	// Validate each of the inputs.  The quit channel is closed when any
-	// errors occur so all processing goroutines exit regardless of which
-	// input had the validation error.
+	// Validate each of the inputs.  The batch is canceled when any
+	// errors occur so all workers skip the remaining inputs regardless
+	// of which input had the validation error.
 	numInputs := len(items)
 	currentItem := 0
 	processedItems := 0
@@ -152,47 +235,57 @@ func (v *txValidator) Validate(items []*txValidateItem) error {
 		// Only send items while there are still items that need to
 		// be processed.  The select statement will never select a nil
 		// channel.
-		var validateChan chan *txValidateItem
-		var item *txValidateItem
+		var validateCh chan *txValidateJob
+		var job *txValidateJob
 		if currentItem < numInputs {
-			validateChan = v.validateChan
-			item = items[currentItem]
+			validateCh = v.validateCh
+			job = &txValidateJob{item: items[currentItem], batch: batch}
 		}
 
 		select {
-		case validateChan <- item:
+		case validateCh <- job:
 			currentItem++
 
-		case err := <-v.resultChan:
+		case err := <-batch.results:
 			processedItems++
 			if err != nil {
-				close(v.quitChan)
 				return err
 			}
+
+		case <-v.quit:
+			return errScriptValidatorStopped
 		}
 	}
 
-	close(v.quitChan)
+	atomic.AddUint64(&v.validated, uint64(numInputs))
 	return nil
 }
 
-// newTxValidator returns a new instance of txValidator to be used for
-// validating transaction scripts asynchronously.
-func newTxValidator(txStore TxStore, flags txscript.ScriptFlags, sigCache *txscript.SigCache, hashCache *txscript.HashCache) *txValidator {
-	return &txValidator{
-		validateChan: make(chan *txValidateItem),
-		quitChan:     make(chan struct{}),
-		resultChan:   make(chan error),
-		txStore:      txStore,
-		sigCache:     sigCache,
-		hashCache:    hashCache,
-		flags:        flags,
+// Prevalidate submits inputs to be validated when workers are idle, the results
+// are dropped, but valid signatures are added to sigCache so that later Validate
+// of the same inputs is cheap.  Inputs are dropped if the queue is full.
+func (v *scriptValidator) Prevalidate(items []*txValidateItem, txStore TxStore, flags txscript.ScriptFlags, sigCache *txscript.SigCache) int {
+	batch := &txValidateBatch{
+		txStore:  txStore,
+		flags:    flags,
+		sigCache: sigCache,
+		quit:     make(chan struct{}),
 	}
+	for i, item := range items {
+		select {
+		case v.prevalidateCh <- &txValidateJob{item: item, batch: batch}:
+		default:
+			atomic.AddUint64(&v.prevalidated, uint64(i))
+			return i
+		}
+	}
+	atomic.AddUint64(&v.prevalidated, uint64(len(items)))
+	return len(items)
 }
 
-// ValidateTransactionScripts validates the scripts for the passed transaction
-// using multiple goroutines.
-func ValidateTransactionScripts(tx *massutil.Tx, txStore TxStore, flags txscript.ScriptFlags, sigCache *txscript.SigCache, hashCache *txscript.HashCache) error {
+// validateTransactionScripts validates the scripts for the passed transaction
+// using the shared workers.
+func (v *scriptValidator) validateTransactionScripts(tx *massutil.Tx, txStore TxStore, flags txscript.ScriptFlags, sigCache *txscript.SigCache, hashCache *txscript.HashCache) error {
 	// Collect all of the transaction inputs and required information for
 	// validation.
 
@@ -223,17 +316,12 @@ func ValidateTransactionScripts(tx *massutil.Tx, txStore TxStore, flags txscript
 	}
 
 	// Validate all of the inputs.
-	validator := newTxValidator(txStore, flags, sigCache, hashCache)
-	if err := validator.Validate(txValItems); err != nil {
-		return err
-	}
-
-	return nil
+	return v.Validate(txValItems, txStore, flags, sigCache)
 }
 
 // checkBlockScripts executes and validates the scripts for all transactions in
 // the passed block.
-func checkBlockScripts(block *massutil.Block, txStore TxStore,
+func checkBlockScripts(validator *scriptValidator, block *massutil.Block, txStore TxStore,
 	scriptFlags txscript.ScriptFlags, sigCache *txscript.SigCache, hashCache *txscript.HashCache) error {
 
 	// Collect all of the transaction inputs and required information for
@@ -282,9 +370,8 @@ func checkBlockScripts(block *massutil.Block, txStore TxStore,
 	}
 
 	// Validate all of the inputs.
-	validator := newTxValidator(txStore, scriptFlags, sigCache, hashCache)
 	//start := time.Now()
-	if err := validator.Validate(txValItems); err != nil {
+	if err := validator.Validate(txValItems, txStore, scriptFlags, sigCache); err != nil {
 		return err
 	}
 
@@ -299,3 +386,125 @@ func checkBlockScripts(block *massutil.Block, txStore TxStore,
 
 	return nil
 }
+
+// PrevalidateBlocks validates scripts of inputs in blocks which spend transactions
+// already in main chain, so that they are validated in parallel among blocks while
+// blocks are connected one by one.  Inputs spending transactions of these blocks
+// depend on the blocks before, and are left to block connection.
+func (chain *Blockchain) PrevalidateBlocks(blocks []*massutil.Block) {
+	if len(blocks) < 2 {
+		return
+	}
+	inFlight := make(map[wire.Hash]struct{})
+	for _, block := range blocks {
+		for _, tx := range block.Transactions() {
+			inFlight[*tx.Hash()] = struct{}{}
+		}
+	}
+
+	txNeededSet := make(map[wire.Hash]struct{})
+	txValItems := make([]*txValidateItem, 0)
+	for _, block := range blocks {
+		if chain.isAssumedValid(block.Hash(), block.MsgBlock().Header.Height) {
+			continue
+		}
+		for _, tx := range block.Transactions()[1:] {
+			var sigHashes *txscript.TxSigHashes
+			for txInIdx, txIn := range tx.MsgTx().TxIn {
+				originHash := txIn.PreviousOutPoint.Hash
+				if _, ok := inFlight[originHash]; ok || txIn.PreviousOutPoint.Index == math.MaxUint32 {
+					continue
+				}
+				if sigHashes == nil {
+					sigHashes = txscript.NewTxSigHashes(tx.MsgTx())
+				}
+				txNeededSet[originHash] = struct{}{}
+				txValItems = append(txValItems, &txValidateItem{
+					txInIndex: txInIdx,
+					txIn:      txIn,
+					tx:        tx,
+					sigHashes: sigHashes,
+				})
+			}
+		}
+	}
+	if len(txValItems) == 0 {
+		return
+	}
+
+	// Spent transactions are included, the store is only used to find
+	// referenced scripts and amounts.
+	txStore := fetchTxStoreMain(chain.db, txNeededSet, true)
+	submitted := chain.scriptValidator.Prevalidate(txValItems, txStore, txscript.StandardVerifyFlags, chain.sigCache)
+	logging.CPrint(logging.DEBUG, "prevalidate block scripts",
+		logging.LogFormat{"blocks": len(blocks), "inputs": len(txValItems), "submitted": submitted})
+}
+
+// AddAssumeValidHeaders records headers synced headers-first, which must be
+// linked one by one and contain the assume-valid block, so that their blocks
+// are known to be ancestors of the assume-valid block before it is connected.
+// Headers after the assume-valid block, or not leading to it, are ignored.
+func (chain *Blockchain) AddAssumeValidHeaders(headers []*wire.BlockHeader) {
+	if chain.assumeValid == nil || len(headers) == 0 {
+		return
+	}
+	if headers[0].Height > chain.assumeValid.Height {
+		return
+	}
+	last := int(chain.assumeValid.Height - headers[0].Height)
+	if last >= len(headers) {
+		return
+	}
+	hash := headers[last].BlockHash()
+	if headers[last].Height != chain.assumeValid.Height || !hash.IsEqual(chain.assumeValid.Hash) {
+		return
+	}
+	ancestors := make(map[wire.Hash]uint64, last+1)
+	ancestors[hash] = headers[last].Height
+	for i := last - 1; i >= 0; i-- {
+		hash = headers[i].BlockHash()
+		if headers[i+1].Previous != hash || headers[i+1].Height != headers[i].Height+1 {
+			logging.CPrint(logging.WARN, "headers are not linked to assume-valid block",
+				logging.LogFormat{"height": headers[i].Height, "hash": hash})
+			return
+		}
+		ancestors[hash] = headers[i].Height
+	}
+
+	chain.assumeValidLock.Lock()
+	chain.assumeValidHeaders = ancestors
+	chain.assumeValidLock.Unlock()
+}
+
+// isAssumedValid returns true if scripts of block are assumed valid, that is
+// the block is the assume-valid block or one of its ancestors, given either
+// by block tree or by headers recorded by AddAssumeValidHeaders.  Blocks of
+// side chains, or of unknown ancestry, are fully validated.
+func (chain *Blockchain) isAssumedValid(hash *wire.Hash, height uint64) bool {
+	if chain.assumeValid == nil || height > chain.assumeValid.Height {
+		return false
+	}
+
+	chain.assumeValidLock.Lock()
+	defer chain.assumeValidLock.Unlock()
+	if avNode, exists := chain.blockTree.getBlockNode(chain.assumeValid.Hash); exists {
+		// Recorded headers are no longer needed once the assume-valid
+		// block is connected.
+		chain.assumeValidHeaders = nil
+		ancestor := avNode.Ancestor(height)
+		return ancestor != nil && ancestor.Hash.IsEqual(hash)
+	}
+	ancestorHeight, exists := chain.assumeValidHeaders[*hash]
+	return exists && ancestorHeight == height
+}
+
+// ScriptValidationStats returns stats of script validation workers and signature cache.
+func (chain *Blockchain) ScriptValidationStats() *ScriptValidationStats {
+	return &ScriptValidationStats{
+		Workers:            chain.scriptValidator.workers,
+		ValidatedInputs:    atomic.LoadUint64(&chain.scriptValidator.validated),
+		PrevalidatedInputs: atomic.LoadUint64(&chain.scriptValidator.prevalidated),
+		SigCache:           chain.sigCache.Stats(),
+		AssumeValid:        chain.assumeValid,
+	}
+}
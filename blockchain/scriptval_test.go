@@ -0,0 +1,154 @@
+package blockchain
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass/config"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/txscript"
+	"massnet.org/mass/wire"
+)
+
+// newTestValidateItems returns items spending output 0 of originTx, with
+// witness of given length.
+func newTestValidateItems(originTx *massutil.Tx, count, witnessLen int) []*txValidateItem {
+	items := make([]*txValidateItem, count)
+	for i := range items {
+		msgTx := wire.NewMsgTx()
+		txIn := wire.NewTxIn(wire.NewOutPoint(originTx.Hash(), 0), make([][]byte, witnessLen))
+		msgTx.AddTxIn(txIn)
+		msgTx.LockTime = uint64(i)
+		items[i] = &txValidateItem{txIn: txIn, tx: massutil.NewTx(msgTx)}
+	}
+	return items
+}
+
+func TestScriptValidator(t *testing.T) {
+	v := newScriptValidator(0)
+	defer v.Stop()
+	assert.Equal(t, 1, v.workers)
+
+	originMsgTx := wire.NewMsgTx()
+	originMsgTx.AddTxOut(wire.NewTxOut(1, nil))
+	originTx := massutil.NewTx(originMsgTx)
+	txStore := TxStore{*originTx.Hash(): &TxData{Tx: originTx, Hash: originTx.Hash()}}
+	sigCache := txscript.NewSigCache(10)
+
+	assert.Nil(t, v.Validate(nil, txStore, txscript.StandardVerifyFlags, sigCache))
+	assert.Equal(t, ErrMissingTx, v.Validate(newTestValidateItems(originTx, 3, 2), TxStore{}, txscript.StandardVerifyFlags, sigCache))
+	assert.Equal(t, ErrWitnessLength, v.Validate(newTestValidateItems(originTx, 3, 1), txStore, txscript.StandardVerifyFlags, sigCache))
+
+	// batches of different callers share workers
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = v.Validate(newTestValidateItems(originTx, 20, i%2), txStore, txscript.StandardVerifyFlags, sigCache)
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		assert.Equal(t, ErrWitnessLength, err, "batch %d", i)
+	}
+}
+
+func TestScriptValidatorPrevalidate(t *testing.T) {
+	// no worker consumes the queue
+	v := &scriptValidator{
+		workers:       1,
+		validateCh:    make(chan *txValidateJob),
+		prevalidateCh: make(chan *txValidateJob, 5),
+		quit:          make(chan struct{}),
+	}
+	originTx := massutil.NewTx(wire.NewMsgTx())
+	items := newTestValidateItems(originTx, 4, 2)
+
+	assert.Equal(t, 4, v.Prevalidate(items, TxStore{}, txscript.StandardVerifyFlags, nil))
+	assert.Equal(t, 1, v.Prevalidate(items, TxStore{}, txscript.StandardVerifyFlags, nil))
+	assert.Equal(t, uint64(5), v.prevalidated)
+}
+
+func TestIsAssumedValid(t *testing.T) {
+	// main chain 0 <- 1 <- 2 <- 3, side chain 1 <- 2'
+	tree := NewBlockTree()
+	var nodes []*BlockNode
+	for i := 0; i < 4; i++ {
+		node := &BlockNode{Hash: &wire.Hash{byte(i + 1)}, Height: uint64(i)}
+		if i > 0 {
+			node.Parent = nodes[i-1]
+		}
+		nodes = append(nodes, node)
+		tree.index[*node.Hash] = node
+	}
+	side := &BlockNode{Hash: &wire.Hash{0xff}, Height: 2, Parent: nodes[1]}
+	tree.index[*side.Hash] = side
+
+	chain := &Blockchain{blockTree: tree}
+	assert.False(t, chain.isAssumedValid(nodes[0].Hash, 0))
+
+	// assume-valid block not in block tree
+	chain.assumeValid = &config.Checkpoint{Height: 3, Hash: &wire.Hash{0xee}}
+	assert.False(t, chain.isAssumedValid(nodes[1].Hash, 1))
+
+	chain.assumeValid = &config.Checkpoint{Height: 2, Hash: nodes[2].Hash}
+	assert.True(t, chain.isAssumedValid(nodes[1].Hash, 1))
+	assert.True(t, chain.isAssumedValid(nodes[2].Hash, 2))
+	assert.False(t, chain.isAssumedValid(nodes[3].Hash, 3))
+	assert.False(t, chain.isAssumedValid(side.Hash, 2))
+
+	// side chain block below assume-valid block
+	chain.assumeValid = &config.Checkpoint{Height: 3, Hash: nodes[3].Hash}
+	assert.False(t, chain.isAssumedValid(side.Hash, 2))
+	assert.True(t, chain.isAssumedValid(nodes[2].Hash, 2))
+}
+
+func TestAddAssumeValidHeaders(t *testing.T) {
+	blks, err := loadTopNBlk(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers := make([]*wire.BlockHeader, len(blks))
+	for i, blk := range blks {
+		headers[i] = &blk.MsgBlock().Header
+	}
+	chain := &Blockchain{blockTree: NewBlockTree()}
+	chain.assumeValid = &config.Checkpoint{Height: headers[3].Height, Hash: blks[3].Hash()}
+
+	// not ending with assume-valid block
+	chain.AddAssumeValidHeaders(headers[:3])
+	assert.False(t, chain.isAssumedValid(blks[1].Hash(), headers[1].Height))
+
+	// not linked
+	chain.AddAssumeValidHeaders([]*wire.BlockHeader{headers[0], headers[1], headers[3]})
+	assert.False(t, chain.isAssumedValid(blks[1].Hash(), headers[1].Height))
+
+	chain.AddAssumeValidHeaders(headers)
+	for i, blk := range blks {
+		assert.True(t, chain.isAssumedValid(blk.Hash(), headers[i].Height))
+	}
+	assert.False(t, chain.isAssumedValid(blks[1].Hash(), headers[2].Height))
+	assert.False(t, chain.isAssumedValid(&wire.Hash{}, headers[2].Height))
+
+	// headers synced beyond assume-valid block
+	chain = &Blockchain{blockTree: NewBlockTree()}
+	chain.assumeValid = &config.Checkpoint{Height: headers[2].Height, Hash: blks[2].Hash()}
+	chain.AddAssumeValidHeaders(headers)
+	for i, blk := range blks[:3] {
+		assert.True(t, chain.isAssumedValid(blk.Hash(), headers[i].Height))
+	}
+	assert.False(t, chain.isAssumedValid(blks[3].Hash(), headers[3].Height))
+}
+
+func TestScriptValidatorStop(t *testing.T) {
+	v := newScriptValidator(2)
+	v.Stop()
+	v.Stop()
+
+	originTx := massutil.NewTx(wire.NewMsgTx())
+	items := newTestValidateItems(originTx, 8, 0)
+	assert.Equal(t, errScriptValidatorStopped, v.Validate(items, TxStore{}, txscript.StandardVerifyFlags, nil))
+}
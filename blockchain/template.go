@@ -67,11 +67,28 @@ type txPrioItem struct {
 	priority float64
 	feePerKB float64
 
+	// feeDelta is the fee delta assigned by PrioritiseTransaction, it only
+	// affects the selection of transactions, not the fee paid to miner.
+	feeDelta int64
+
+	// sigOps is the number of signature operations, it is set once the
+	// transaction is selected.
+	sigOps int64
+
 	// dependsOn holds a map of transaction hashes which this one depends
 	// on.  It will only be set when the transaction references other
 	// transactions in the memory pool and hence must come after them in
 	// a block.
 	dependsOn map[wire.Hash]struct{}
+
+	// ancestors and version are only used by TemplateStrategyAncestor.
+	ancestors map[wire.Hash]*txPrioItem
+	version   int
+}
+
+// modifiedFee returns the fee taking feeDelta into account.
+func (item *txPrioItem) modifiedFee() int64 {
+	return item.fee + item.feeDelta
 }
 
 // txPriorityQueueLessFunc describes a function that can be used as a compare
@@ -223,6 +240,7 @@ type PoCTemplate struct {
 type BlockTemplate struct {
 	Block              *wire.MsgBlock
 	TotalFee           massutil.Amount
+	Fees               []int64
 	SigOpCounts        []int64
 	Height             uint64
 	ValidPayAddress    bool
@@ -511,6 +529,13 @@ func logSkippedDeps(tx *massutil.Tx, deps *list.List) {
 // higher fee per kilobyte are preferred.  Finally, the block generation related
 // configuration options are all taken into account.
 //
+// How transactions are selected depends on the strategy of TemplatePolicy,
+// which could be changed by SetTemplatePolicy.  Fee deltas assigned by
+// PrioritiseTransaction are added to fees when selecting transactions.  The
+// default TemplateStrategyAncestor scores each transaction along with its
+// unconfirmed ancestors, so that a child is able to pay for its parents.  The
+// rest describes TemplateStrategyPriority.
+//
 // Transactions which only spend outputs from other transactions already in the
 // block chain are immediately added to a priority queue which either
 // prioritizes based on the priority (then fee per kilobyte) or the fee per
@@ -563,6 +588,8 @@ func (chain *Blockchain) NewBlockTemplate(payoutAddress massutil.Address, templa
 	// Get snapshot of chain/txPool
 	bestNode := chain.blockTree.bestBlockNode()
 	txs := chain.txPool.TxDescs()
+	feeDeltas := chain.txPool.FeeDeltas()
+	policy := chain.templatePolicy
 	punishments := chain.proposalPool.PunishmentProposals()
 	rewardAddress, err := chain.db.FetchRankStakingTx(bestNode.Height + 1)
 	if err != nil {
@@ -570,12 +597,12 @@ func (chain *Blockchain) NewBlockTemplate(payoutAddress massutil.Address, templa
 	}
 
 	// run newBlockTemplate as goroutine
-	go newBlockTemplate(chain, payoutAddress, templateCh, bestNode, txs, punishments, rewardAddress)
+	go newBlockTemplate(chain, &policy, payoutAddress, templateCh, bestNode, txs, feeDeltas, punishments, rewardAddress)
 	return nil
 }
 
-func newBlockTemplate(chain *Blockchain, payoutAddress massutil.Address, templateCh chan interface{},
-	bestNode *BlockNode, mempoolTxns []*TxDesc, proposals []*PunishmentProposal, rewardAddresses []database.Rank) {
+func newBlockTemplate(chain *Blockchain, policy *TemplatePolicy, payoutAddress massutil.Address, templateCh chan interface{},
+	bestNode *BlockNode, mempoolTxns []*TxDesc, feeDeltas map[wire.Hash]int64, proposals []*PunishmentProposal, rewardAddresses []database.Rank) {
 
 	nextBlockHeight := bestNode.Height + 1
 	challenge, err := calcNextChallenge(bestNode)
@@ -628,13 +655,9 @@ func newBlockTemplate(chain *Blockchain, payoutAddress massutil.Address, templat
 
 	numCoinbaseSigOps := int64(CountSigOps(coinbaseTx))
 
-	// Get the current memory pool transactions and create a priority queue
-	// to hold the transactions which are ready for inclusion into a block
-	// along with some priority related and fee metadata.  Reserve the same
-	// number of items that are in the memory pool for the priority queue.
-	// Also, choose the initial sort order for the priority queue based on
-	// whether or not there is an area allocated for high-priority
-	// transactions.
+	// Get the current memory pool transactions along with some priority
+	// related and fee metadata, which are then selected into the block by
+	// the strategy of policy.
 	depMap := make(map[wire.Hash]struct{})
 	for _, txDesc := range mempoolTxns {
 		txhash := txDesc.Tx.Hash()
@@ -642,9 +665,6 @@ func newBlockTemplate(chain *Blockchain, payoutAddress massutil.Address, templat
 			depMap[*txhash] = struct{}{}
 		}
 	}
-	sortedByFee := config.BlockPrioritySize == 0
-	priorityQueue := newTxPriorityQueue(len(mempoolTxns), sortedByFee)
-
 	// Create a slice to hold the transactions to be included in the
 	// generated block with reserved space.  Also create a transaction
 	// store to house all of the input transactions so multiple lookups
@@ -689,6 +709,7 @@ func newBlockTemplate(chain *Blockchain, payoutAddress massutil.Address, templat
 		totalProposalsSize += wire.HeaderSizePerPlaceHolder * 2
 	}
 
+	prioItems := make([]*txPrioItem, 0, len(mempoolTxns))
 	//mempoolLoop:
 	for _, txDesc := range mempoolTxns {
 		// A block can't have more than one coinbase or contain
@@ -731,145 +752,36 @@ func newBlockTemplate(chain *Blockchain, payoutAddress massutil.Address, templat
 		// kilobyte boundary.  This is beneficial since it provides an
 		// incentive to create smaller transactions.
 		txSize := tx.MsgTx().PlainSize()
-		prioItem.feePerKB = float64(txDesc.Fee.IntValue()) / (float64(txSize) / 1000)
 		prioItem.fee = txDesc.Fee.IntValue()
-
-		// Add the transaction to the priority queue to mark it ready
-		// for inclusion in the block unless it has dependencies.
-		if prioItem.dependsOn == nil {
-			heap.Push(priorityQueue, prioItem)
-		}
+		prioItem.feeDelta = feeDeltas[*tx.Hash()]
+		prioItem.feePerKB = float64(prioItem.modifiedFee()) / (float64(txSize) / 1000)
+		prioItems = append(prioItems, prioItem)
 	}
 
-	logging.CPrint(logging.TRACE, "Check the length of priority queue and dependent",
-		logging.LogFormat{"priority": priorityQueue.Len(), "dependent": len(dependers)})
-
 	// The starting block size is the size of the Block header plus the max
 	// possible transaction count size, plus the size of the coinbase
 	// transaction.
 	//modify: 360 is coinbase`s binding txIn
-	blockSize := uint32(blockHeaderOverhead+int64(len(punishProposals)*33)+int64(coinbaseTx.PlainSize())+int64(totalProposalsSize)) + bindingPayload
-	blockSigOps := numCoinbaseSigOps
-	totalFee := massutil.ZeroAmount()
+	selection := &txSelection{
+		txs:         make([]*txPrioItem, 0, len(prioItems)),
+		blockSize:   uint32(blockHeaderOverhead+int64(len(punishProposals)*33)+int64(coinbaseTx.PlainSize())+int64(totalProposalsSize)) + bindingPayload,
+		blockSigOps: numCoinbaseSigOps,
+		totalFee:    massutil.ZeroAmount(),
+	}
 
 	// Choose which transactions make it into the block.
-	for priorityQueue.Len() > 0 {
-		// Grab the highest priority (or highest fee per kilobyte
-		// depending on the sort order) transaction.
-		prioItem := heap.Pop(priorityQueue).(*txPrioItem)
-		tx := prioItem.tx
-
-		// Grab the list of transactions which depend on this one (if
-		// any) and remove the entry for this transaction as it will
-		// either be included or skipped, but in either case the deps
-		// are no longer needed.
-		deps := dependers[*tx.Hash()]
-		delete(dependers, *tx.Hash())
-		// Enforce maximum block size.  Also check for overflow.
-		txSize := uint32(tx.PlainSize())
-		blockPlusTxSize := blockSize + txSize
-		// Enforce maximum block size.  Also check for overflow.
-
-		if blockPlusTxSize < blockSize ||
-			blockPlusTxSize >= config.BlockMaxSize {
-			logging.CPrint(logging.TRACE, "Skipping tx because it would exceed the max block weight",
-				logging.LogFormat{"txid": tx.Hash().String()})
-			logSkippedDeps(tx, deps)
-			continue
-		}
-
-		// Enforce maximum signature operations per block.  Also check
-		// for overflow.
-		numSigOps := int64(CountSigOps(tx))
-		if blockSigOps+numSigOps < blockSigOps || blockSigOps+numSigOps > MaxSigOpsPerBlock {
-			logging.CPrint(logging.TRACE, "Skipping tx because it would exceed the maximum sigops per block",
-				logging.LogFormat{"txid": tx.Hash().String()})
-			logSkippedDeps(tx, deps)
-			continue
-		}
-
-		// Skip free transactions once the block is larger than the
-		// minimum block size.
-		if sortedByFee &&
-			prioItem.feePerKB < float64(consensus.MinRelayTxFee) &&
-			blockPlusTxSize >= config.BlockMinSize {
-
-			logging.CPrint(logging.TRACE, "Skipping tx with feePerKB < TxMinFreeFee and block weight >= minBlockSize",
-				logging.LogFormat{"txid": tx.Hash().String(), "feePerKB": prioItem.feePerKB, "TxMinFreeFee": consensus.MinRelayTxFee, "block weight": blockPlusTxSize, "minBlockSize": config.BlockMinSize})
-			logSkippedDeps(tx, deps)
-			continue
-		}
-
-		// Prioritize by fee per kilobyte once the block is larger than
-		// the priority size or there are no more high-priority
-		// transactions.
-		if !sortedByFee && (blockPlusTxSize >= config.BlockPrioritySize ||
-			prioItem.priority <= minHighPriority) {
-
-			logging.CPrint(logging.TRACE, "Switching to sort by fees per kilobyte since blockSize >= BlockPrioritySize || priority <= minHighPriority",
-				logging.LogFormat{
-					"block size":        blockPlusTxSize,
-					"BlockPrioritySize": config.BlockPrioritySize,
-					"priority":          fmt.Sprintf("%.2f", prioItem.priority),
-					"minHighPriority":   fmt.Sprintf("%.2f", minHighPriority)})
-
-			sortedByFee = true
-			priorityQueue.SetLessFunc(txPQByFee)
-
-			// Put the transaction back into the priority queue and
-			// skip it so it is re-priortized by fees if it won't
-			// fit into the high-priority section or the priority
-			// is too low.  Otherwise this transaction will be the
-			// final one in the high-priority section, so just fall
-			// though to the code below so it is added now.
-			if blockPlusTxSize > config.BlockPrioritySize ||
-				prioItem.priority < minHighPriority {
-
-				heap.Push(priorityQueue, prioItem)
-				continue
-			}
-		}
+	templateStrategies[policy.Strategy](policy, prioItems, dependers, selection)
 
-		temp, err := totalFee.AddInt(prioItem.fee)
-		if err != nil {
-			logging.CPrint(logging.ERROR, "calc total fee error",
-				logging.LogFormat{
-					"err":  err,
-					"txid": tx.Hash().String(),
-				})
-			continue
-		}
-
-		// Add the transaction to the block, increment counters, and
-		// save the fees and signature operation counts to the block
-		// template.
-		blockTxns = append(blockTxns, tx.MsgTx())
-		blockSize += txSize
-		blockSigOps += numSigOps
-		totalFee = temp
-		txSigOpCounts = append(txSigOpCounts, numSigOps)
-
-		logging.CPrint(logging.TRACE, "Adding tx",
-			logging.LogFormat{"txid": tx.Hash().String(),
-				"priority": fmt.Sprintf("%.2f", prioItem.priority),
-				"feePerKB": fmt.Sprintf("%.2f", prioItem.feePerKB)})
-
-		// Add transactions which depend on this one (and also do not
-		// have any other unsatisified dependencies) to the priority
-		// queue.
-		if deps != nil {
-			for e := deps.Front(); e != nil; e = e.Next() {
-				// Add the transaction to the priority queue if
-				// there are no more dependencies after this
-				// one.
-				item := e.Value.(*txPrioItem)
-				delete(item.dependsOn, *tx.Hash())
-				if len(item.dependsOn) == 0 {
-					heap.Push(priorityQueue, item)
-				}
-			}
-		}
+	// Add the selected transactions to the block, and save the fees and
+	// signature operation counts to the block template.
+	txFees := make([]int64, 0, len(selection.txs)+1)
+	txFees = append(txFees, -selection.totalFee.IntValue())
+	for _, prioItem := range selection.txs {
+		blockTxns = append(blockTxns, prioItem.tx.MsgTx())
+		txFees = append(txFees, prioItem.fee)
+		txSigOpCounts = append(txSigOpCounts, prioItem.sigOps)
 	}
+	blockSize, blockSigOps, totalFee := selection.blockSize, selection.blockSigOps, selection.totalFee
 
 	// Next, obtain the merkle root of a tree which consists of the
 	// wtxid of all transactions in the block. The coinbase
@@ -958,6 +870,7 @@ func newBlockTemplate(chain *Blockchain, payoutAddress massutil.Address, templat
 	templateCh <- &BlockTemplate{
 		Block:              msgBlock,
 		TotalFee:           totalFee,
+		Fees:               txFees,
 		SigOpCounts:        txSigOpCounts,
 		Height:             nextBlockHeight,
 		ValidPayAddress:    payoutAddress != nil,
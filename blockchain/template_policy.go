@@ -0,0 +1,514 @@
+package blockchain
+
+import (
+	"container/heap"
+	"container/list"
+	"fmt"
+	"sort"
+
+	"massnet.org/mass/config"
+	"massnet.org/mass/consensus"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/wire"
+)
+
+// Here lists the names of built-in template strategies.
+const (
+	// TemplateStrategyPriority fills the high-priority area with transactions
+	// sorted by priority, then sorts the rest by fee per kilobyte.
+	TemplateStrategyPriority = "priority"
+
+	// TemplateStrategyFeeRate sorts all transactions by fee per kilobyte,
+	// regardless of BlockPrioritySize.
+	TemplateStrategyFeeRate = "feerate"
+
+	// TemplateStrategyAncestor sorts transactions by the fee per kilobyte of
+	// the package made up of the transaction and its unconfirmed ancestors,
+	// so that a high-fee child is able to pull in its low-fee parents.
+	TemplateStrategyAncestor = "ancestor"
+
+	// DefaultTemplateStrategy is the strategy used if none is specified,
+	// which makes child-pays-for-parent work.
+	DefaultTemplateStrategy = TemplateStrategyAncestor
+)
+
+// TemplatePolicy houses the policy (configuration parameters) which is used
+// to control the generation of block templates.
+type TemplatePolicy struct {
+	// Strategy is the name of the registered strategy used to select
+	// transactions from the memory pool.
+	Strategy string
+
+	// BlockMinSize is the minimum block size to be used when generating a
+	// block template.
+	BlockMinSize uint32
+
+	// BlockMaxSize is the maximum block size to be used when generating a
+	// block template.
+	BlockMaxSize uint32
+
+	// BlockPrioritySize is the size in bytes for high-priority / low-fee
+	// transactions to be used when generating a block template.
+	BlockPrioritySize uint32
+}
+
+// DefaultTemplatePolicy returns the policy made up of the default strategy
+// and block size options.
+func DefaultTemplatePolicy() TemplatePolicy {
+	return TemplatePolicy{
+		Strategy:          DefaultTemplateStrategy,
+		BlockMinSize:      config.BlockMinSize,
+		BlockMaxSize:      config.BlockMaxSize,
+		BlockPrioritySize: config.BlockPrioritySize,
+	}
+}
+
+// txSelection houses the transactions selected by a template strategy along
+// with the statistics required to assemble the block.
+type txSelection struct {
+	txs         []*txPrioItem
+	blockSize   uint32
+	blockSigOps int64
+	totalFee    massutil.Amount
+}
+
+// add appends prioItem to selection, it returns false if total fee overflows.
+func (sel *txSelection) add(prioItem *txPrioItem, txSize uint32, numSigOps int64) bool {
+	totalFee, err := sel.totalFee.AddInt(prioItem.fee)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "calc total fee error",
+			logging.LogFormat{
+				"err":  err,
+				"txid": prioItem.tx.Hash().String(),
+			})
+		return false
+	}
+	prioItem.sigOps = numSigOps
+	sel.txs = append(sel.txs, prioItem)
+	sel.blockSize += txSize
+	sel.blockSigOps += numSigOps
+	sel.totalFee = totalFee
+	return true
+}
+
+// TemplateStrategyFunc selects transactions from items and appends them to
+// sel in an order that is valid for a block.  The dependencies between items
+// are described by both txPrioItem.dependsOn and dependers.
+type TemplateStrategyFunc func(policy *TemplatePolicy, items []*txPrioItem, dependers map[wire.Hash]*list.List, sel *txSelection)
+
+var templateStrategies = map[string]TemplateStrategyFunc{
+	TemplateStrategyPriority: selectTxsByPriority,
+	TemplateStrategyFeeRate:  selectTxsByFeeRate,
+	TemplateStrategyAncestor: selectTxsByAncestorFeeRate,
+}
+
+// RegisterTemplateStrategy adds a strategy to the available template
+// strategies, it returns an error if the name is already registered.
+func RegisterTemplateStrategy(name string, fn TemplateStrategyFunc) error {
+	if _, exists := templateStrategies[name]; exists {
+		return fmt.Errorf("template strategy %s is already registered", name)
+	}
+	templateStrategies[name] = fn
+	return nil
+}
+
+// TemplateStrategies returns the names of all registered template strategies
+// in ascending order.
+func TemplateStrategies() []string {
+	names := make([]string, 0, len(templateStrategies))
+	for name := range templateStrategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TemplatePolicy returns the policy currently used to generate block templates.
+func (chain *Blockchain) TemplatePolicy() TemplatePolicy {
+	chain.l.RLock()
+	defer chain.l.RUnlock()
+
+	return chain.templatePolicy
+}
+
+// SetTemplatePolicy replaces the policy used to generate block templates, it
+// takes effect from the next template.
+func (chain *Blockchain) SetTemplatePolicy(policy TemplatePolicy) error {
+	if _, exists := templateStrategies[policy.Strategy]; !exists {
+		return ErrUnknownTemplateStrategy
+	}
+	if policy.BlockMaxSize == 0 || policy.BlockMaxSize > wire.MaxBlockPayload ||
+		policy.BlockMinSize > policy.BlockMaxSize || policy.BlockPrioritySize > policy.BlockMaxSize {
+		return ErrInvalidTemplatePolicy
+	}
+
+	chain.l.Lock()
+	defer chain.l.Unlock()
+
+	chain.templatePolicy = policy
+	logging.CPrint(logging.INFO, "block template policy updated", logging.LogFormat{
+		"strategy":            policy.Strategy,
+		"block_min_size":      policy.BlockMinSize,
+		"block_max_size":      policy.BlockMaxSize,
+		"block_priority_size": policy.BlockPrioritySize,
+	})
+	return nil
+}
+
+// selectTxsByPriority implements TemplateStrategyPriority.
+func selectTxsByPriority(policy *TemplatePolicy, items []*txPrioItem, dependers map[wire.Hash]*list.List, sel *txSelection) {
+	selectTxsFromQueue(policy, items, dependers, sel, policy.BlockPrioritySize == 0)
+}
+
+// selectTxsByFeeRate implements TemplateStrategyFeeRate.
+func selectTxsByFeeRate(policy *TemplatePolicy, items []*txPrioItem, dependers map[wire.Hash]*list.List, sel *txSelection) {
+	selectTxsFromQueue(policy, items, dependers, sel, true)
+}
+
+// selectTxsFromQueue selects transactions one by one from a priority queue,
+// a transaction is not pushed into the queue until all the transactions it
+// depends on have been selected.
+func selectTxsFromQueue(policy *TemplatePolicy, items []*txPrioItem, dependers map[wire.Hash]*list.List, sel *txSelection, sortedByFee bool) {
+	priorityQueue := newTxPriorityQueue(len(items), sortedByFee)
+	for _, prioItem := range items {
+		// Add the transaction to the priority queue to mark it ready
+		// for inclusion in the block unless it has dependencies.
+		if prioItem.dependsOn == nil {
+			heap.Push(priorityQueue, prioItem)
+		}
+	}
+
+	logging.CPrint(logging.TRACE, "Check the length of priority queue and dependent",
+		logging.LogFormat{"priority": priorityQueue.Len(), "dependent": len(dependers)})
+
+	// Choose which transactions make it into the block.
+	for priorityQueue.Len() > 0 {
+		// Grab the highest priority (or highest fee per kilobyte
+		// depending on the sort order) transaction.
+		prioItem := heap.Pop(priorityQueue).(*txPrioItem)
+		tx := prioItem.tx
+
+		// Grab the list of transactions which depend on this one (if
+		// any) and remove the entry for this transaction as it will
+		// either be included or skipped, but in either case the deps
+		// are no longer needed.
+		deps := dependers[*tx.Hash()]
+		delete(dependers, *tx.Hash())
+		// Enforce maximum block size.  Also check for overflow.
+		txSize := uint32(tx.PlainSize())
+		blockPlusTxSize := sel.blockSize + txSize
+		// Enforce maximum block size.  Also check for overflow.
+
+		if blockPlusTxSize < sel.blockSize ||
+			blockPlusTxSize >= policy.BlockMaxSize {
+			logging.CPrint(logging.TRACE, "Skipping tx because it would exceed the max block weight",
+				logging.LogFormat{"txid": tx.Hash().String()})
+			logSkippedDeps(tx, deps)
+			continue
+		}
+
+		// Enforce maximum signature operations per block.  Also check
+		// for overflow.
+		numSigOps := int64(CountSigOps(tx))
+		if sel.blockSigOps+numSigOps < sel.blockSigOps || sel.blockSigOps+numSigOps > MaxSigOpsPerBlock {
+			logging.CPrint(logging.TRACE, "Skipping tx because it would exceed the maximum sigops per block",
+				logging.LogFormat{"txid": tx.Hash().String()})
+			logSkippedDeps(tx, deps)
+			continue
+		}
+
+		// Skip free transactions once the block is larger than the
+		// minimum block size.
+		if sortedByFee &&
+			prioItem.feePerKB < float64(consensus.MinRelayTxFee) &&
+			blockPlusTxSize >= policy.BlockMinSize {
+
+			logging.CPrint(logging.TRACE, "Skipping tx with feePerKB < TxMinFreeFee and block weight >= minBlockSize",
+				logging.LogFormat{"txid": tx.Hash().String(), "feePerKB": prioItem.feePerKB, "TxMinFreeFee": consensus.MinRelayTxFee, "block weight": blockPlusTxSize, "minBlockSize": policy.BlockMinSize})
+			logSkippedDeps(tx, deps)
+			continue
+		}
+
+		// Prioritize by fee per kilobyte once the block is larger than
+		// the priority size or there are no more high-priority
+		// transactions.
+		if !sortedByFee && (blockPlusTxSize >= policy.BlockPrioritySize ||
+			prioItem.priority <= minHighPriority) {
+
+			logging.CPrint(logging.TRACE, "Switching to sort by fees per kilobyte since blockSize >= BlockPrioritySize || priority <= minHighPriority",
+				logging.LogFormat{
+					"block size":        blockPlusTxSize,
+					"BlockPrioritySize": policy.BlockPrioritySize,
+					"priority":          fmt.Sprintf("%.2f", prioItem.priority),
+					"minHighPriority":   fmt.Sprintf("%.2f", minHighPriority)})
+
+			sortedByFee = true
+			priorityQueue.SetLessFunc(txPQByFee)
+
+			// Put the transaction back into the priority queue and
+			// skip it so it is re-priortized by fees if it won't
+			// fit into the high-priority section or the priority
+			// is too low.  Otherwise this transaction will be the
+			// final one in the high-priority section, so just fall
+			// though to the code below so it is added now.
+			if blockPlusTxSize > policy.BlockPrioritySize ||
+				prioItem.priority < minHighPriority {
+
+				heap.Push(priorityQueue, prioItem)
+				continue
+			}
+		}
+
+		// Add the transaction to the block, increment counters, and
+		// save the fees and signature operation counts to the block
+		// template.
+		if !sel.add(prioItem, txSize, numSigOps) {
+			continue
+		}
+
+		logging.CPrint(logging.TRACE, "Adding tx",
+			logging.LogFormat{"txid": tx.Hash().String(),
+				"priority": fmt.Sprintf("%.2f", prioItem.priority),
+				"feePerKB": fmt.Sprintf("%.2f", prioItem.feePerKB)})
+
+		// Add transactions which depend on this one (and also do not
+		// have any other unsatisified dependencies) to the priority
+		// queue.
+		if deps != nil {
+			for e := deps.Front(); e != nil; e = e.Next() {
+				// Add the transaction to the priority queue if
+				// there are no more dependencies after this
+				// one.
+				item := e.Value.(*txPrioItem)
+				delete(item.dependsOn, *tx.Hash())
+				if len(item.dependsOn) == 0 {
+					heap.Push(priorityQueue, item)
+				}
+			}
+		}
+	}
+}
+
+// pkgEntry is an entry of pkgQueue, it represents the package made up of item
+// and its unselected ancestors at the time the entry was pushed.
+type pkgEntry struct {
+	item     *txPrioItem
+	version  int
+	feePerKB float64
+}
+
+// pkgQueue implements heap.Interface, it pops the package with the highest fee
+// per kilobyte.
+type pkgQueue []*pkgEntry
+
+func (pq pkgQueue) Len() int { return len(pq) }
+
+func (pq pkgQueue) Less(i, j int) bool {
+	if pq[i].feePerKB == pq[j].feePerKB {
+		return len(pq[i].item.ancestors) < len(pq[j].item.ancestors)
+	}
+	return pq[i].feePerKB > pq[j].feePerKB
+}
+
+func (pq pkgQueue) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+
+func (pq *pkgQueue) Push(x interface{}) { *pq = append(*pq, x.(*pkgEntry)) }
+
+func (pq *pkgQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*pq = old[0 : n-1]
+	return entry
+}
+
+// selectTxsByAncestorFeeRate implements TemplateStrategyAncestor.
+//
+// Every transaction is scored by the modified fee per kilobyte of the package
+// made up of itself and its ancestors which are not selected yet.  Once a
+// package is selected, scores of the descendants of its transactions are
+// updated.  Outdated queue entries are skipped by comparing versions.
+func selectTxsByAncestorFeeRate(policy *TemplatePolicy, items []*txPrioItem, dependers map[wire.Hash]*list.List, sel *txSelection) {
+	itemIndex := make(map[wire.Hash]*txPrioItem, len(items))
+	for _, item := range items {
+		itemIndex[*item.tx.Hash()] = item
+	}
+	for _, item := range items {
+		fillAncestors(item, itemIndex)
+	}
+
+	selected := make(map[wire.Hash]struct{})
+	failed := make(map[wire.Hash]struct{})
+	queue := make(pkgQueue, 0, len(items))
+
+	// packageOf returns the unselected part of the package of item in
+	// ascending order of ancestor count, which is a valid order in block.
+	packageOf := func(item *txPrioItem) (pkg []*txPrioItem, fee int64, size int64, ok bool) {
+		pkg = make([]*txPrioItem, 0, len(item.ancestors)+1)
+		for hash, ancestor := range item.ancestors {
+			if _, exists := failed[hash]; exists {
+				return nil, 0, 0, false
+			}
+			if _, exists := selected[hash]; !exists {
+				pkg = append(pkg, ancestor)
+			}
+		}
+		pkg = append(pkg, item)
+		sort.SliceStable(pkg, func(i, j int) bool {
+			return len(pkg[i].ancestors) < len(pkg[j].ancestors)
+		})
+		for _, member := range pkg {
+			fee += member.modifiedFee()
+			size += int64(member.tx.PlainSize())
+		}
+		return pkg, fee, size, true
+	}
+
+	push := func(item *txPrioItem) {
+		_, fee, size, ok := packageOf(item)
+		if !ok {
+			failed[*item.tx.Hash()] = struct{}{}
+			return
+		}
+		item.version++
+		heap.Push(&queue, &pkgEntry{
+			item:     item,
+			version:  item.version,
+			feePerKB: float64(fee) / (float64(size) / 1000),
+		})
+	}
+
+	for _, item := range items {
+		push(item)
+	}
+
+	for queue.Len() > 0 {
+		entry := heap.Pop(&queue).(*pkgEntry)
+		item := entry.item
+		hash := *item.tx.Hash()
+		if entry.version != item.version {
+			continue
+		}
+		if _, exists := selected[hash]; exists {
+			continue
+		}
+		if _, exists := failed[hash]; exists {
+			continue
+		}
+
+		pkg, _, pkgSize, ok := packageOf(item)
+		if !ok {
+			failed[hash] = struct{}{}
+			continue
+		}
+
+		// Enforce maximum block size.  Also check for overflow.
+		blockPlusPkgSize := sel.blockSize + uint32(pkgSize)
+		if blockPlusPkgSize < sel.blockSize || blockPlusPkgSize >= policy.BlockMaxSize {
+			logging.CPrint(logging.TRACE, "Skipping tx because its package would exceed the max block weight",
+				logging.LogFormat{"txid": hash.String(), "package": len(pkg)})
+			failed[hash] = struct{}{}
+			continue
+		}
+
+		// Enforce maximum signature operations per block.  Also check
+		// for overflow.
+		pkgSigOps := make([]int64, len(pkg))
+		var totalSigOps int64
+		for i, member := range pkg {
+			pkgSigOps[i] = int64(CountSigOps(member.tx))
+			totalSigOps += pkgSigOps[i]
+		}
+		if sel.blockSigOps+totalSigOps < sel.blockSigOps || sel.blockSigOps+totalSigOps > MaxSigOpsPerBlock {
+			logging.CPrint(logging.TRACE, "Skipping tx because its package would exceed the maximum sigops per block",
+				logging.LogFormat{"txid": hash.String(), "package": len(pkg)})
+			failed[hash] = struct{}{}
+			continue
+		}
+
+		// Skip low-fee packages once the block is larger than the
+		// minimum block size.
+		if entry.feePerKB < float64(consensus.MinRelayTxFee) && blockPlusPkgSize >= policy.BlockMinSize {
+			logging.CPrint(logging.TRACE, "Skipping tx with package feePerKB < TxMinFreeFee and block weight >= minBlockSize",
+				logging.LogFormat{"txid": hash.String(), "feePerKB": entry.feePerKB, "TxMinFreeFee": consensus.MinRelayTxFee,
+					"block weight": blockPlusPkgSize, "minBlockSize": policy.BlockMinSize})
+			failed[hash] = struct{}{}
+			continue
+		}
+
+		// Enforce maximum total fee before adding any transaction, so
+		// that a package is never partially selected.
+		totalFee := sel.totalFee
+		var err error
+		for _, member := range pkg {
+			if totalFee, err = totalFee.AddInt(member.fee); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			logging.CPrint(logging.TRACE, "Skipping tx because its package would overflow the total fee",
+				logging.LogFormat{"txid": hash.String(), "package": len(pkg), "err": err})
+			for _, member := range pkg {
+				failed[*member.tx.Hash()] = struct{}{}
+			}
+			continue
+		}
+
+		for i, member := range pkg {
+			sel.add(member, uint32(member.tx.PlainSize()), pkgSigOps[i])
+			selected[*member.tx.Hash()] = struct{}{}
+			logging.CPrint(logging.TRACE, "Adding tx",
+				logging.LogFormat{"txid": member.tx.Hash().String(),
+					"feePerKB":        fmt.Sprintf("%.2f", member.feePerKB),
+					"packageFeePerKB": fmt.Sprintf("%.2f", entry.feePerKB)})
+		}
+
+		// Update scores of descendants, as their packages have shrunk.
+		visited := make(map[wire.Hash]struct{})
+		for _, member := range pkg {
+			walkDescendants(member, dependers, visited, func(descendant *txPrioItem) {
+				if _, exists := selected[*descendant.tx.Hash()]; !exists {
+					push(descendant)
+				}
+			})
+		}
+	}
+}
+
+// fillAncestors sets ancestors of item from its in-pool dependencies.
+func fillAncestors(item *txPrioItem, itemIndex map[wire.Hash]*txPrioItem) map[wire.Hash]*txPrioItem {
+	if item.ancestors != nil {
+		return item.ancestors
+	}
+	item.ancestors = make(map[wire.Hash]*txPrioItem)
+	for hash := range item.dependsOn {
+		parent, exists := itemIndex[hash]
+		if !exists {
+			continue
+		}
+		item.ancestors[hash] = parent
+		for ancestorHash, ancestor := range fillAncestors(parent, itemIndex) {
+			item.ancestors[ancestorHash] = ancestor
+		}
+	}
+	return item.ancestors
+}
+
+// walkDescendants calls fn for each descendant of item which is not visited.
+func walkDescendants(item *txPrioItem, dependers map[wire.Hash]*list.List, visited map[wire.Hash]struct{}, fn func(*txPrioItem)) {
+	deps := dependers[*item.tx.Hash()]
+	if deps == nil {
+		return
+	}
+	for e := deps.Front(); e != nil; e = e.Next() {
+		descendant := e.Value.(*txPrioItem)
+		if _, exists := visited[*descendant.tx.Hash()]; exists {
+			continue
+		}
+		visited[*descendant.tx.Hash()] = struct{}{}
+		fn(descendant)
+		walkDescendants(descendant, dependers, visited, fn)
+	}
+}
@@ -0,0 +1,174 @@
+package blockchain
+
+import (
+	"container/list"
+	"testing"
+
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/wire"
+)
+
+// newTestPrioItem returns a txPrioItem spending outputs of parents, with fee in
+// Maxwell, and an in-pool dependency on each parent.
+func newTestPrioItem(seq uint32, fee int64, parents ...*txPrioItem) *txPrioItem {
+	msgTx := wire.NewMsgTx()
+	if len(parents) == 0 {
+		// spend a distinct confirmed output
+		msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&wire.Hash{byte(seq)}, seq), [][]byte{{}}))
+	}
+	for _, parent := range parents {
+		msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(parent.tx.Hash(), 0), [][]byte{{}}))
+	}
+	msgTx.AddTxOut(wire.NewTxOut(int64(seq), []byte{0x51}))
+	item := &txPrioItem{tx: massutil.NewTx(msgTx), fee: fee}
+	item.feePerKB = float64(item.modifiedFee()) / (float64(msgTx.PlainSize()) / 1000)
+	for _, parent := range parents {
+		if item.dependsOn == nil {
+			item.dependsOn = make(map[wire.Hash]struct{})
+		}
+		item.dependsOn[*parent.tx.Hash()] = struct{}{}
+	}
+	return item
+}
+
+func testDependers(items []*txPrioItem) map[wire.Hash]*list.List {
+	dependers := make(map[wire.Hash]*list.List)
+	for _, item := range items {
+		for hash := range item.dependsOn {
+			if dependers[hash] == nil {
+				dependers[hash] = list.New()
+			}
+			dependers[hash].PushBack(item)
+		}
+	}
+	return dependers
+}
+
+func selectedHashes(sel *txSelection) []wire.Hash {
+	hashes := make([]wire.Hash, 0, len(sel.txs))
+	for _, item := range sel.txs {
+		hashes = append(hashes, *item.tx.Hash())
+	}
+	return hashes
+}
+
+func TestTemplateStrategies(t *testing.T) {
+	policy := DefaultTemplatePolicy()
+	policy.BlockPrioritySize = 0
+
+	// parent pays almost nothing, while its child pays a lot
+	newItems := func() []*txPrioItem {
+		parent := newTestPrioItem(1, 1)
+		child := newTestPrioItem(2, 100000, parent)
+		other := newTestPrioItem(3, 20000)
+		return []*txPrioItem{parent, child, other}
+	}
+
+	tests := []struct {
+		strategy string
+		expected []int
+	}{
+		// parent is skipped for low fee, which also skips child
+		{TemplateStrategyFeeRate, []int{2}},
+		// child pulls parent in before other
+		{TemplateStrategyAncestor, []int{0, 1, 2}},
+	}
+
+	for i, test := range tests {
+		items := newItems()
+		sel := &txSelection{totalFee: massutil.ZeroAmount()}
+		policy.Strategy = test.strategy
+		templateStrategies[test.strategy](&policy, items, testDependers(items), sel)
+
+		hashes := selectedHashes(sel)
+		if len(hashes) != len(test.expected) {
+			t.Fatalf("%d, %s: expected %d txs, got %d", i, test.strategy, len(test.expected), len(hashes))
+		}
+		var expectedFee int64
+		for j, idx := range test.expected {
+			if hashes[j] != *items[idx].tx.Hash() {
+				t.Errorf("%d, %s: mismatched tx at %d", i, test.strategy, j)
+			}
+			expectedFee += items[idx].fee
+		}
+		if sel.totalFee.IntValue() != expectedFee {
+			t.Errorf("%d, %s: expected total fee %d, got %d", i, test.strategy, expectedFee, sel.totalFee.IntValue())
+		}
+	}
+}
+
+func TestTemplateStrategyAncestorFeeOverflow(t *testing.T) {
+	policy := DefaultTemplatePolicy()
+	policy.BlockPrioritySize = 0
+
+	// package of parent and child overflows the total fee, while other fits
+	parent := newTestPrioItem(1, 1)
+	child := newTestPrioItem(2, 200000, parent)
+	other := newTestPrioItem(3, 20000)
+	items := []*txPrioItem{parent, child, other}
+	headroom, err := massutil.NewAmountFromInt(100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	startFee, err := massutil.MaxAmount().Sub(headroom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := &txSelection{totalFee: startFee}
+	selectTxsByAncestorFeeRate(&policy, items, testDependers(items), sel)
+
+	hashes := selectedHashes(sel)
+	if len(hashes) != 1 || hashes[0] != *other.tx.Hash() {
+		t.Fatalf("expected only other tx selected, got %d txs", len(hashes))
+	}
+	if expected, _ := startFee.AddInt(other.fee); sel.totalFee.Cmp(expected) != 0 {
+		t.Errorf("expected total fee %v, got %v", expected, sel.totalFee)
+	}
+}
+
+func TestTemplateStrategyFeeDelta(t *testing.T) {
+	policy := DefaultTemplatePolicy()
+	policy.Strategy = TemplateStrategyFeeRate
+
+	low := newTestPrioItem(1, 15000)
+	high := newTestPrioItem(2, 30000)
+	// a delta raises low above high, but the fee paid is unchanged
+	low.feeDelta = 100000
+	low.feePerKB = float64(low.modifiedFee()) / (float64(low.tx.PlainSize()) / 1000)
+	items := []*txPrioItem{high, low}
+
+	sel := &txSelection{totalFee: massutil.ZeroAmount()}
+	selectTxsByFeeRate(&policy, items, testDependers(items), sel)
+	hashes := selectedHashes(sel)
+	if len(hashes) != 2 || hashes[0] != *low.tx.Hash() {
+		t.Fatalf("expected prioritised tx to be selected first")
+	}
+	if sel.totalFee.IntValue() != 45000 {
+		t.Errorf("fee delta should not be paid, got total fee %d", sel.totalFee.IntValue())
+	}
+}
+
+func TestSetTemplatePolicy(t *testing.T) {
+	chain := &Blockchain{templatePolicy: DefaultTemplatePolicy()}
+
+	policy := DefaultTemplatePolicy()
+	policy.Strategy = "unknown"
+	if err := chain.SetTemplatePolicy(policy); err != ErrUnknownTemplateStrategy {
+		t.Errorf("expected ErrUnknownTemplateStrategy, got %v", err)
+	}
+
+	policy = DefaultTemplatePolicy()
+	policy.BlockMinSize = policy.BlockMaxSize + 1
+	if err := chain.SetTemplatePolicy(policy); err != ErrInvalidTemplatePolicy {
+		t.Errorf("expected ErrInvalidTemplatePolicy, got %v", err)
+	}
+
+	policy = DefaultTemplatePolicy()
+	policy.Strategy = TemplateStrategyFeeRate
+	if err := chain.SetTemplatePolicy(policy); err != nil {
+		t.Fatal(err)
+	}
+	if chain.TemplatePolicy().Strategy != TemplateStrategyFeeRate {
+		t.Errorf("template policy not updated")
+	}
+}
@@ -13,10 +13,11 @@ import (
 )
 
 func TestBlockchain_NewBlockTemplate(t *testing.T) {
-	bc, _, err := newBlockChain()
+	bc, teardown, err := newBlockChain()
 	if err != nil {
 		t.Fatal("err in newBlockChain", err)
 	}
+	defer teardown()
 
 	templateCh := make(chan interface{}, 2)
 
@@ -41,6 +41,30 @@ const (
 	// MaxTxMsgPayload is the maximum bytes a transaction payload can be in bytes.
 	// 200 bytes
 	maxTxPoolTxPayload = 200
+
+	// maxPoolAncestors is the maximum number of in-pool ancestors of a
+	// transaction, including itself.
+	maxPoolAncestors = 25
+
+	// maxPoolAncestorSize is the maximum total size in bytes of a
+	// transaction and its in-pool ancestors.
+	maxPoolAncestorSize = 101000
+
+	// maxPoolDescendants is the maximum number of in-pool descendants of
+	// any transaction, including itself.
+	maxPoolDescendants = 25
+
+	// maxPoolDescendantSize is the maximum total size in bytes of any
+	// transaction and its in-pool descendants.
+	maxPoolDescendantSize = 101000
+
+	// MaxRBFSequence is the maximum sequence number an input can use to
+	// signal that the transaction spending it could be replaced.
+	MaxRBFSequence = wire.MaxTxInSequenceNum - 2
+
+	// maxReplacementEvictions is the maximum number of transactions that
+	// can be evicted from the memory pool by a replacement transaction.
+	maxReplacementEvictions = 100
 )
 
 // TxDesc is a descriptor containing a transaction in the mempool and the
@@ -52,6 +76,15 @@ type TxDesc struct {
 	startingPriority float64         // Priority when added to the pool.
 	Fee              massutil.Amount // Transaction fees.
 	totalInputValue  massutil.Amount
+
+	// Package statistics of in-pool ancestors and descendants, both of
+	// which include the transaction itself.  Fees are in Maxwell.
+	AncestorCount   int
+	AncestorSize    int64
+	AncestorFees    int64
+	DescendantCount int
+	DescendantSize  int64
+	DescendantFees  int64
 }
 
 // TxPool is used as a source of transactions that need to be mined into
@@ -64,9 +97,10 @@ type TxPool struct {
 	errCache      *lru.Cache
 	addrindex     map[string]map[wire.Hash]struct{} // maps address to txs
 	outpoints     map[wire.OutPoint]*massutil.Tx
-	lastUpdated   time.Time // last time pool was updated
-	pennyTotal    float64   // exponentially decaying total for penny spends.
-	lastPennyUnix int64     // unix time of last ``penny spend''
+	feeDeltas     map[wire.Hash]int64 // fee deltas assigned by PrioritiseTransaction
+	lastUpdated   time.Time           // last time pool was updated
+	pennyTotal    float64             // exponentially decaying total for penny spends.
+	lastPennyUnix int64               // unix time of last ``penny spend''
 	chain         *Blockchain
 	sigCache      *txscript.SigCache
 	hashCache     *txscript.HashCache
@@ -161,6 +195,8 @@ func (tp *TxPool) removeTransaction(tx *massutil.Tx, removeRedeemers bool) {
 	// Remove the transaction and mark the referenced outpoints as unspent
 	// by the pool.
 	if txDesc, exists := tp.pool[*txHash]; exists {
+		ancestors, descendants := tp.calcAncestors(tx), tp.calcDescendants(tx)
+
 		if config.AddrIndex {
 			if err := tp.removeTransactionFromAddrIndex(tx); err != nil {
 				logging.CPrint(logging.ERROR, "fail on removeTransactionFromAddrIndex", logging.LogFormat{
@@ -175,6 +211,8 @@ func (tp *TxPool) removeTransaction(tx *massutil.Tx, removeRedeemers bool) {
 		}
 		delete(tp.pool, *txHash)
 		tp.lastUpdated = time.Now()
+
+		tp.updatePackageStats(txDesc, ancestors, descendants, false)
 	}
 }
 
@@ -287,7 +325,7 @@ func (tp *TxPool) addTransaction(tx *massutil.Tx, height uint64, startingPriorit
 		return ErrTxPoolNil
 	}
 
-	tp.pool[*tx.Hash()] = &TxDesc{
+	txDesc := &TxDesc{
 		Tx:               tx,
 		Added:            time.Now(),
 		Height:           height,
@@ -295,12 +333,21 @@ func (tp *TxPool) addTransaction(tx *massutil.Tx, height uint64, startingPriorit
 		Fee:              fee,
 		totalInputValue:  totalInputValue,
 	}
+	tp.pool[*tx.Hash()] = txDesc
 
 	for _, txIn := range tx.MsgTx().TxIn {
 		tp.outpoints[txIn.PreviousOutPoint] = tx
 	}
 	tp.lastUpdated = time.Now()
 
+	// Update package statistics of the transaction and related ones.  The
+	// transaction may have in-pool descendants if it is added back from a
+	// disconnected block.
+	ancestors, descendants := tp.calcAncestors(tx), tp.calcDescendants(tx)
+	txDesc.setAncestorStats(ancestors)
+	txDesc.setDescendantStats(descendants)
+	tp.updatePackageStats(txDesc, ancestors, descendants, true)
+
 	tp.NewTxCh <- tx
 
 	if config.AddrIndex {
@@ -391,6 +438,180 @@ func (tp *TxPool) indexScriptAddressToTx(pkScript []byte, tx *massutil.Tx) error
 	return nil
 }
 
+// poolParents returns descriptors of the in-pool transactions whose outputs
+// are spent by tx.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) poolParents(tx *massutil.Tx) []*TxDesc {
+	parents := make([]*TxDesc, 0)
+	seen := make(map[wire.Hash]struct{})
+	for _, txIn := range tx.MsgTx().TxIn {
+		hash := txIn.PreviousOutPoint.Hash
+		if _, exists := seen[hash]; exists {
+			continue
+		}
+		seen[hash] = struct{}{}
+		if parent, exists := tp.pool[hash]; exists {
+			parents = append(parents, parent)
+		}
+	}
+	return parents
+}
+
+// poolChildren returns descriptors of the in-pool transactions spending
+// outputs of tx.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) poolChildren(tx *massutil.Tx) []*TxDesc {
+	children := make([]*TxDesc, 0)
+	seen := make(map[wire.Hash]struct{})
+	for i := range tx.MsgTx().TxOut {
+		redeemer, exists := tp.outpoints[*wire.NewOutPoint(tx.Hash(), uint32(i))]
+		if !exists {
+			continue
+		}
+		hash := *redeemer.Hash()
+		if _, exists := seen[hash]; exists {
+			continue
+		}
+		seen[hash] = struct{}{}
+		if child, exists := tp.pool[hash]; exists {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// calcAncestors returns all in-pool ancestors of tx, excluding itself.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) calcAncestors(tx *massutil.Tx) map[wire.Hash]*TxDesc {
+	ancestors := make(map[wire.Hash]*TxDesc)
+	stack := tp.poolParents(tx)
+	for len(stack) > 0 {
+		desc := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, exists := ancestors[*desc.Tx.Hash()]; exists {
+			continue
+		}
+		ancestors[*desc.Tx.Hash()] = desc
+		stack = append(stack, tp.poolParents(desc.Tx)...)
+	}
+	return ancestors
+}
+
+// calcDescendants returns all in-pool descendants of tx, excluding itself.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) calcDescendants(tx *massutil.Tx) map[wire.Hash]*TxDesc {
+	descendants := make(map[wire.Hash]*TxDesc)
+	stack := tp.poolChildren(tx)
+	for len(stack) > 0 {
+		desc := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, exists := descendants[*desc.Tx.Hash()]; exists {
+			continue
+		}
+		descendants[*desc.Tx.Hash()] = desc
+		stack = append(stack, tp.poolChildren(desc.Tx)...)
+	}
+	return descendants
+}
+
+// setAncestorStats sets ancestor statistics of txD from its in-pool ancestors.
+func (txD *TxDesc) setAncestorStats(ancestors map[wire.Hash]*TxDesc) {
+	txD.AncestorCount = 1
+	txD.AncestorSize = int64(txD.Tx.PlainSize())
+	txD.AncestorFees = txD.Fee.IntValue()
+	for _, ancestor := range ancestors {
+		txD.AncestorCount++
+		txD.AncestorSize += int64(ancestor.Tx.PlainSize())
+		txD.AncestorFees += ancestor.Fee.IntValue()
+	}
+}
+
+// setDescendantStats sets descendant statistics of txD from its in-pool descendants.
+func (txD *TxDesc) setDescendantStats(descendants map[wire.Hash]*TxDesc) {
+	txD.DescendantCount = 1
+	txD.DescendantSize = int64(txD.Tx.PlainSize())
+	txD.DescendantFees = txD.Fee.IntValue()
+	for _, descendant := range descendants {
+		txD.DescendantCount++
+		txD.DescendantSize += int64(descendant.Tx.PlainSize())
+		txD.DescendantFees += descendant.Fee.IntValue()
+	}
+}
+
+// updatePackageStats updates package statistics of in-pool ancestors and
+// descendants of txDesc, which has just been added to or removed from pool.
+//
+// If txDesc has no in-pool ancestors or no in-pool descendants, which is the
+// case for new transactions, mined ones and those removed with redeemers,
+// each related transaction gains or loses exactly txDesc, so statistics are
+// updated incrementally.  Otherwise, e.g. for transactions added back from
+// disconnected blocks, paths through txDesc might not be the only ones
+// between its ancestors and descendants, so statistics are recalculated.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (tp *TxPool) updatePackageStats(txDesc *TxDesc, ancestors, descendants map[wire.Hash]*TxDesc, added bool) {
+	if len(ancestors) != 0 && len(descendants) != 0 {
+		for _, ancestor := range ancestors {
+			ancestor.setDescendantStats(tp.calcDescendants(ancestor.Tx))
+		}
+		for _, descendant := range descendants {
+			descendant.setAncestorStats(tp.calcAncestors(descendant.Tx))
+		}
+		return
+	}
+
+	count, size, fee := 1, int64(txDesc.Tx.PlainSize()), txDesc.Fee.IntValue()
+	if !added {
+		count, size, fee = -count, -size, -fee
+	}
+	for _, ancestor := range ancestors {
+		ancestor.DescendantCount += count
+		ancestor.DescendantSize += size
+		ancestor.DescendantFees += fee
+	}
+	for _, descendant := range descendants {
+		descendant.AncestorCount += count
+		descendant.AncestorSize += size
+		descendant.AncestorFees += fee
+	}
+}
+
+// checkPackageLimits checks whether adding tx to pool would exceed limits on
+// in-pool ancestors of tx or descendants of its ancestors.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) checkPackageLimits(tx *massutil.Tx) error {
+	txHash := tx.Hash()
+	txSize := int64(tx.PlainSize())
+	ancestors := tp.calcAncestors(tx)
+
+	ancestorSize := txSize
+	for _, ancestor := range ancestors {
+		ancestorSize += int64(ancestor.Tx.PlainSize())
+	}
+	if len(ancestors)+1 > maxPoolAncestors || ancestorSize > maxPoolAncestorSize {
+		logging.CPrint(logging.ERROR, "transaction has too many in-pool ancestors",
+			logging.LogFormat{"txHash": txHash, "count": len(ancestors) + 1, "size": ancestorSize,
+				"maxCount": maxPoolAncestors, "maxSize": maxPoolAncestorSize})
+		return ErrTooLongMempoolChain
+	}
+
+	for _, ancestor := range ancestors {
+		if ancestor.DescendantCount+1 > maxPoolDescendants ||
+			ancestor.DescendantSize+txSize > maxPoolDescendantSize {
+			logging.CPrint(logging.ERROR, "in-pool ancestor of transaction has too many descendants",
+				logging.LogFormat{"txHash": txHash, "ancestor": ancestor.Tx.Hash(), "count": ancestor.DescendantCount + 1,
+					"size": ancestor.DescendantSize + txSize, "maxCount": maxPoolDescendants, "maxSize": maxPoolDescendantSize})
+			return ErrTooLongMempoolChain
+		}
+	}
+	return nil
+}
+
 // TODO: should lazily update txD.totalInputValue, but that costs a lot.
 func (txD *TxDesc) StartingPriority() (float64, error) {
 	return txD.startingPriority, nil
@@ -435,6 +656,152 @@ func (tp *TxPool) checkPoolDoubleSpend(tx *massutil.Tx) error {
 	return nil
 }
 
+// signalsReplacement returns whether the in-pool transaction could be
+// replaced, which is signalled by any input with a sequence number not greater
+// than MaxRBFSequence, either of itself or of any of its in-pool ancestors.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) signalsReplacement(txDesc *TxDesc) bool {
+	signals := func(tx *massutil.Tx) bool {
+		for _, txIn := range tx.MsgTx().TxIn {
+			if txIn.Sequence <= MaxRBFSequence {
+				return true
+			}
+		}
+		return false
+	}
+
+	if signals(txDesc.Tx) {
+		return true
+	}
+	for _, ancestor := range tp.calcAncestors(txDesc.Tx) {
+		if signals(ancestor.Tx) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPoolReplaceable is similar to checkPoolDoubleSpend, but it allows the
+// passed transaction to spend coins already spent by transactions that signal
+// replaceability, and returns these conflicting transactions.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) checkPoolReplaceable(tx *massutil.Tx) (map[wire.Hash]*TxDesc, error) {
+	var conflicts map[wire.Hash]*TxDesc
+	for _, txIn := range tx.MsgTx().TxIn {
+		txR, exists := tp.outpoints[txIn.PreviousOutPoint]
+		if !exists {
+			continue
+		}
+		if _, exists = conflicts[*txR.Hash()]; exists {
+			continue
+		}
+		txDesc := tp.pool[*txR.Hash()]
+		if txDesc == nil || !tp.signalsReplacement(txDesc) {
+			logging.CPrint(logging.ERROR, "output already spent by non-replaceable transaction in the memory pool",
+				logging.LogFormat{
+					"output":      txIn.PreviousOutPoint,
+					"transcation": txR.Hash(),
+				})
+			return nil, ErrDoubleSpend
+		}
+		if conflicts == nil {
+			conflicts = make(map[wire.Hash]*TxDesc)
+		}
+		conflicts[*txR.Hash()] = txDesc
+	}
+
+	return conflicts, nil
+}
+
+// validateReplacement checks whether the passed transaction could replace the
+// conflicting transactions, along with their descendants.  The rules are:
+//
+//  1. The replacement must not evict more than maxReplacementEvictions
+//     transactions.
+//  2. The replacement must not spend outputs of any transaction it evicts.
+//  3. The replacement must not spend any unconfirmed output which is not
+//     spent by the conflicting transactions.
+//  4. The fee rate of the replacement must be higher than that of each
+//     conflicting transaction.
+//  5. The fee of the replacement must be at least the total fee of evicted
+//     transactions, plus the minimum relay fee for its own size.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) validateReplacement(tx *massutil.Tx, txFee massutil.Amount, conflicts map[wire.Hash]*TxDesc) error {
+	txHash := tx.Hash()
+	evicts := make(map[wire.Hash]*TxDesc)
+	for hash, conflict := range conflicts {
+		evicts[hash] = conflict
+		for descHash, descendant := range tp.calcDescendants(conflict.Tx) {
+			evicts[descHash] = descendant
+		}
+	}
+	if len(evicts) > maxReplacementEvictions {
+		logging.CPrint(logging.ERROR, "replacement transaction evicts too many transactions",
+			logging.LogFormat{"txHash": txHash, "evicts": len(evicts), "max": maxReplacementEvictions})
+		return ErrReplacementTooManyEvictions
+	}
+
+	conflictParents := make(map[wire.Hash]struct{})
+	for _, conflict := range conflicts {
+		for _, txIn := range conflict.Tx.MsgTx().TxIn {
+			conflictParents[txIn.PreviousOutPoint.Hash] = struct{}{}
+		}
+	}
+	for _, txIn := range tx.MsgTx().TxIn {
+		parentHash := txIn.PreviousOutPoint.Hash
+		if _, exists := evicts[parentHash]; exists {
+			logging.CPrint(logging.ERROR, "replacement transaction spends output of transaction it replaces",
+				logging.LogFormat{"txHash": txHash, "parent": parentHash})
+			return ErrReplacementSpendsConflict
+		}
+		if _, exists := tp.pool[parentHash]; !exists {
+			continue
+		}
+		if _, exists := conflictParents[parentHash]; !exists {
+			logging.CPrint(logging.ERROR, "replacement transaction spends new unconfirmed output",
+				logging.LogFormat{"txHash": txHash, "parent": parentHash})
+			return ErrReplacementUnconfirmedInput
+		}
+	}
+
+	txSize := int64(tx.PlainSize())
+	feePerKB := float64(txFee.IntValue()) * 1000 / float64(txSize)
+	for hash, conflict := range conflicts {
+		conflictFeePerKB := float64(conflict.Fee.IntValue()) * 1000 / float64(conflict.Tx.PlainSize())
+		if feePerKB <= conflictFeePerKB {
+			logging.CPrint(logging.ERROR, "replacement transaction has no higher fee rate than transaction it replaces",
+				logging.LogFormat{"txHash": txHash, "replaced": hash, "fee": txFee, "replacedFee": conflict.Fee})
+			return ErrReplacementInsufficientFeeRate
+		}
+	}
+
+	evictedFees := massutil.ZeroAmount()
+	for _, evict := range evicts {
+		var err error
+		if evictedFees, err = evictedFees.Add(evict.Fee); err != nil {
+			return err
+		}
+	}
+	relayFee, err := CalcMinRequiredTxRelayFee(txSize, massutil.MinRelayTxFee())
+	if err != nil {
+		return err
+	}
+	requiredFee, err := evictedFees.Add(relayFee)
+	if err != nil {
+		return err
+	}
+	if txFee.Cmp(requiredFee) < 0 {
+		logging.CPrint(logging.ERROR, "replacement transaction pays insufficient fee",
+			logging.LogFormat{"txHash": txHash, "fee": txFee, "requiredFee": requiredFee})
+		return ErrReplacementInsufficientFee
+	}
+
+	return nil
+}
+
 // FetchInputTransactions fetches the input transactions referenced by the
 // passed transaction.  First, it fetches from the main chain, then it tries to
 // fetch any missing inputs from the transaction pool.
@@ -610,7 +977,16 @@ func (tp *TxPool) maybeAcceptTransaction(tx *massutil.Tx, isNew, rateLimit bool)
 	// at this point.  There is a more in-depth check that happens later
 	// after fetching the referenced transaction inputs from the main chain
 	// which examines the actual spend data and prevents double spends.
-	err = tp.checkPoolDoubleSpend(tx)
+	//
+	// New transactions are allowed to replace conflicting transactions in
+	// the pool, as long as they signal replaceability, see validateReplacement
+	// for the rules of replace-by-fee.
+	var conflicts map[wire.Hash]*TxDesc
+	if isNew {
+		conflicts, err = tp.checkPoolReplaceable(tx)
+	} else {
+		err = tp.checkPoolDoubleSpend(tx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -629,6 +1005,15 @@ func (tp *TxPool) maybeAcceptTransaction(tx *massutil.Tx, isNew, rateLimit bool)
 		return missingParents, nil
 	}
 
+	// Don't allow the transaction to make in-pool chains too long.
+	// Transactions which are being added back to the memory pool from
+	// blocks that have been disconnected during a reorg are exempted.
+	if isNew {
+		if err = tp.checkPackageLimits(tx); err != nil {
+			return nil, err
+		}
+	}
+
 	// Perform several checks on the transaction inputs using the invariant
 	// rules in chain for what transactions are allowed into blocks.
 	// Also returns the fees associated with the transaction which will be
@@ -747,20 +1132,37 @@ func (tp *TxPool) maybeAcceptTransaction(tx *massutil.Tx, isNew, rateLimit bool)
 		}
 	}
 
+	// Make sure the replacement pays enough for the transactions it evicts.
+	if len(conflicts) > 0 {
+		if err = tp.validateReplacement(tx, txFee, conflicts); err != nil {
+			return nil, err
+		}
+	}
+
 	// Verify crypto signatures for each input and reject the transaction if
 	// any don't verify.
-	err = ValidateTransactionScripts(tx, txStore,
+	err = tp.chain.scriptValidator.validateTransactionScripts(tx, txStore,
 		txscript.StandardVerifyFlags, tp.sigCache,
 		tp.hashCache)
 	if err != nil {
 		return nil, err
 	}
 
-	// Add to transaction pool.
 	startingPriority, totalInputValue, err := currentPriority(tx, txStore, curHeight)
 	if err != nil {
 		return nil, err
 	}
+
+	// Evict the replaced transactions along with their descendants right
+	// before adding the replacement, once all checks have passed, so that
+	// the replaced transactions are kept if the replacement is rejected.
+	for _, conflict := range conflicts {
+		logging.CPrint(logging.INFO, "replacing transaction in the memory pool",
+			logging.LogFormat{"replaced": conflict.Tx.Hash(), "replacement": txHash})
+		tp.removeTransaction(conflict.Tx, true)
+	}
+
+	// Add to transaction pool.
 	err = tp.addTransaction(tx, curHeight, startingPriority, totalInputValue, txFee)
 	if err != nil {
 		return nil, err
@@ -949,7 +1351,9 @@ func (tp *TxPool) TxDescs() []*TxDesc {
 	descs := make([]*TxDesc, len(tp.pool))
 	i := 0
 	for _, desc := range tp.pool {
-		descs[i] = desc
+		// copy it since package statistics are updated in place
+		descCopy := *desc
+		descs[i] = &descCopy
 		i++
 	}
 
@@ -973,12 +1377,47 @@ func (tp *TxPool) LastUpdated() time.Time {
 	return tp.lastUpdated
 }
 
+// PrioritiseTransaction adds feeDelta to the fee delta of the transaction,
+// which is taken into account when selecting transactions for block
+// templates.  The transaction is not required to be in the pool, and the delta
+// is kept until the transaction is mined.  It returns the accumulated delta.
+//
+// This function is safe for concurrent access.
+func (tp *TxPool) PrioritiseTransaction(hash *wire.Hash, feeDelta int64) int64 {
+	tp.Lock()
+	defer tp.Unlock()
+
+	delta := tp.feeDeltas[*hash] + feeDelta
+	if delta == 0 {
+		delete(tp.feeDeltas, *hash)
+	} else {
+		tp.feeDeltas[*hash] = delta
+	}
+	tp.lastUpdated = time.Now()
+	return delta
+}
+
+// FeeDeltas returns a copy of the fee deltas assigned by PrioritiseTransaction.
+//
+// This function is safe for concurrent access.
+func (tp *TxPool) FeeDeltas() map[wire.Hash]int64 {
+	tp.RLock()
+	defer tp.RUnlock()
+
+	deltas := make(map[wire.Hash]int64, len(tp.feeDeltas))
+	for hash, delta := range tp.feeDeltas {
+		deltas[hash] = delta
+	}
+	return deltas
+}
+
 func (tp *TxPool) SyncAttachBlock(block *massutil.Block) {
 	tp.Lock()
 	defer tp.Unlock()
 
 	for _, tx := range block.Transactions()[1:] {
 		tp.removeTransaction(tx, false)
+		delete(tp.feeDeltas, *tx.Hash())
 		tp.removeDoubleSpends(tx)
 		tp.orphanTxPool.removeOrphan(tx.Hash())
 		tp.processOrphans(tx.Hash())
@@ -1010,6 +1449,7 @@ func NewTxPool(chain *Blockchain, sigCache *txscript.SigCache, hashCache *txscri
 		orphanTxPool: newOrphanTxPool(),
 		errCache:     lru.New(500),
 		outpoints:    make(map[wire.OutPoint]*massutil.Tx),
+		feeDeltas:    make(map[wire.Hash]int64),
 	}
 	if config.AddrIndex {
 		memPool.addrindex = make(map[string]map[wire.Hash]struct{})
@@ -0,0 +1,279 @@
+package blockchain
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/wire"
+)
+
+const (
+	// MempoolFileName is the name of the file the memory pool is saved to.
+	MempoolFileName = "mempool.dat"
+
+	// mempoolFileVersion is the version of the memory pool file format.
+	mempoolFileVersion uint32 = 1
+
+	// maxMempoolFileTxSize is the maximum size of a transaction read from
+	// the memory pool file, which prevents from allocating huge memory on
+	// corrupted files.
+	maxMempoolFileTxSize = wire.MaxBlockPayload
+)
+
+// savedTxDesc houses a transaction read from the memory pool file along with
+// the metadata of its TxDesc.
+type savedTxDesc struct {
+	tx               *massutil.Tx
+	added            time.Time
+	height           uint64
+	startingPriority float64
+}
+
+// SaveMempool writes transactions in the pool, along with their metadata, the
+// orphan transactions and fee deltas to the file at path.  Transactions are
+// written in an order so that parents come before their children.
+//
+// The file is written to a temporary file which is then renamed, so that an
+// existing file would never be left partially written.
+//
+// This function is safe for concurrent access.
+func (tp *TxPool) SaveMempool(path string) (txCount, orphanCount int, err error) {
+	tp.RLock()
+	descs := make([]*TxDesc, 0, len(tp.pool))
+	for _, desc := range tp.pool {
+		descs = append(descs, desc)
+	}
+	// Ancestors always have less in-pool ancestors than their descendants.
+	sort.SliceStable(descs, func(i, j int) bool {
+		return descs[i].AncestorCount < descs[j].AncestorCount
+	})
+	orphans := tp.orphanTxPool.orphans()
+	feeDeltas := make(map[wire.Hash]int64, len(tp.feeDeltas))
+	for hash, delta := range tp.feeDeltas {
+		feeDeltas[hash] = delta
+	}
+	tp.RUnlock()
+
+	tmpPath := path + ".new"
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, 0, err
+	}
+	w := bufio.NewWriter(f)
+	err = writeMempool(w, descs, orphans, feeDeltas)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return 0, 0, err
+	}
+	if err = os.Rename(tmpPath, path); err != nil {
+		return 0, 0, err
+	}
+
+	logging.CPrint(logging.INFO, "memory pool saved", logging.LogFormat{
+		"path": path, "txs": len(descs), "orphans": len(orphans), "fee_deltas": len(feeDeltas)})
+	return len(descs), len(orphans), nil
+}
+
+func writeMempool(w io.Writer, descs []*TxDesc, orphans []*massutil.Tx, feeDeltas map[wire.Hash]int64) error {
+	if err := binary.Write(w, binary.LittleEndian, mempoolFileVersion); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(feeDeltas))); err != nil {
+		return err
+	}
+	for hash, delta := range feeDeltas {
+		if _, err := w.Write(hash[:]); err != nil {
+			return err
+		}
+		if err := binary.Write(w, binary.LittleEndian, delta); err != nil {
+			return err
+		}
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(descs))); err != nil {
+		return err
+	}
+	for _, desc := range descs {
+		meta := []uint64{uint64(desc.Added.UnixNano()), desc.Height, math.Float64bits(desc.startingPriority)}
+		if err := binary.Write(w, binary.LittleEndian, meta); err != nil {
+			return err
+		}
+		if err := writeMempoolTx(w, desc.Tx); err != nil {
+			return err
+		}
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(orphans))); err != nil {
+		return err
+	}
+	for _, orphan := range orphans {
+		if err := writeMempoolTx(w, orphan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeMempoolTx(w io.Writer, tx *massutil.Tx) error {
+	bs, err := tx.Bytes(wire.Packet)
+	if err != nil {
+		return err
+	}
+	if err = binary.Write(w, binary.LittleEndian, uint32(len(bs))); err != nil {
+		return err
+	}
+	_, err = w.Write(bs)
+	return err
+}
+
+func readMempool(r io.Reader) (descs []*savedTxDesc, orphans []*massutil.Tx, feeDeltas map[wire.Hash]int64, err error) {
+	var version, count uint32
+	if err = binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return nil, nil, nil, err
+	}
+	if version != mempoolFileVersion {
+		return nil, nil, nil, ErrMempoolFileVersion
+	}
+
+	if err = binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, nil, nil, err
+	}
+	feeDeltas = make(map[wire.Hash]int64)
+	for i := uint32(0); i < count; i++ {
+		var hash wire.Hash
+		var delta int64
+		if _, err = io.ReadFull(r, hash[:]); err != nil {
+			return nil, nil, nil, err
+		}
+		if err = binary.Read(r, binary.LittleEndian, &delta); err != nil {
+			return nil, nil, nil, err
+		}
+		feeDeltas[hash] = delta
+	}
+
+	if err = binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, nil, nil, err
+	}
+	for i := uint32(0); i < count; i++ {
+		meta := make([]uint64, 3)
+		if err = binary.Read(r, binary.LittleEndian, meta); err != nil {
+			return nil, nil, nil, err
+		}
+		tx, err := readMempoolTx(r)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		descs = append(descs, &savedTxDesc{
+			tx:               tx,
+			added:            time.Unix(0, int64(meta[0])),
+			height:           meta[1],
+			startingPriority: math.Float64frombits(meta[2]),
+		})
+	}
+
+	if err = binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, nil, nil, err
+	}
+	for i := uint32(0); i < count; i++ {
+		tx, err := readMempoolTx(r)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		orphans = append(orphans, tx)
+	}
+	return descs, orphans, feeDeltas, nil
+}
+
+func readMempoolTx(r io.Reader) (*massutil.Tx, error) {
+	var size uint32
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		return nil, err
+	}
+	if size > maxMempoolFileTxSize {
+		return nil, ErrMempoolFileCorrupted
+	}
+	bs := make([]byte, size)
+	if _, err := io.ReadFull(r, bs); err != nil {
+		return nil, err
+	}
+	return massutil.NewTxFromBytes(bs, wire.Packet)
+}
+
+// LoadMempool reads the file written by SaveMempool, and re-accepts the saved
+// transactions against the current best chain.  Transactions which are no
+// longer valid are dropped, while the accepted ones keep the time, height and
+// starting priority they had when first added to the pool.  Orphans are
+// processed after all other transactions.
+//
+// It is not an error if the file does not exist.
+//
+// This function is safe for concurrent access.
+func (tp *TxPool) LoadMempool(path string) (accepted, dropped int, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	descs, orphans, feeDeltas, err := readMempool(bufio.NewReader(f))
+	f.Close()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to read memory pool file", logging.LogFormat{"path": path, "err": err})
+		return 0, 0, err
+	}
+
+	for hash, delta := range feeDeltas {
+		tp.PrioritiseTransaction(&hash, delta)
+	}
+
+	for _, saved := range descs {
+		txHash := saved.tx.Hash()
+		missingParents, err := tp.MaybeAcceptTransaction(saved.tx, true, false)
+		if err != nil || len(missingParents) > 0 {
+			logging.CPrint(logging.DEBUG, "drop saved transaction", logging.LogFormat{
+				"txid": txHash, "err": err, "missing_parents": len(missingParents)})
+			dropped++
+			continue
+		}
+		tp.Lock()
+		if desc, exists := tp.pool[*txHash]; exists {
+			desc.Added = saved.added
+			desc.Height = saved.height
+			desc.startingPriority = saved.startingPriority
+		}
+		tp.Unlock()
+		accepted++
+	}
+
+	for _, orphan := range orphans {
+		if _, err := tp.ProcessTransaction(orphan, true, false); err != nil {
+			logging.CPrint(logging.DEBUG, "drop saved orphan transaction", logging.LogFormat{
+				"txid": orphan.Hash(), "err": err})
+			dropped++
+			continue
+		}
+		accepted++
+	}
+
+	logging.CPrint(logging.INFO, "memory pool loaded", logging.LogFormat{
+		"path": path, "accepted": accepted, "dropped": dropped})
+	return accepted, dropped, nil
+}
@@ -2,13 +2,18 @@ package blockchain
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"math"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/stretchr/testify/assert"
 	"massnet.org/mass/config"
 	"massnet.org/mass/consensus"
@@ -164,7 +169,10 @@ func newTxPool(loadBlksNum int) (*TxPool, func(), error) {
 		return nil, nil, err
 	}
 	bc.GetTxPool().SetNewTxCh(make(chan *massutil.Tx, 50))
-	return bc.GetTxPool(), func() { db.Close() }, err
+	return bc.GetTxPool(), func() {
+		bc.Stop()
+		db.Close()
+	}, err
 }
 
 func getTx(name string) (*wire.MsgTx, error) {
@@ -884,3 +892,296 @@ func TestTxPool_MaybeAcceptTransaction_LockTime(t *testing.T) {
 	_, err = txP.maybeAcceptTransaction(tx, true, true)
 	assert.Equal(t, ErrImmatureSpend, err)
 }
+
+func TestTxPool_PackageStats(t *testing.T) {
+	txP, close, err := newTxPool(25)
+	assert.Nil(t, err)
+	defer close()
+
+	childTx, err := getTx("child2")
+	assert.Nil(t, err)
+	child := massutil.NewTx(childTx)
+	grandTx, err := getTx("grandChild")
+	assert.Nil(t, err)
+	grand := massutil.NewTx(grandTx)
+
+	childFee, err := massutil.NewAmountFromInt(1000)
+	assert.Nil(t, err)
+	grandFee, err := massutil.NewAmountFromInt(50000)
+	assert.Nil(t, err)
+	assert.Nil(t, txP.addTransaction(child, 26, 100.0, massutil.ZeroAmount(), childFee))
+	assert.Nil(t, txP.addTransaction(grand, 27, 100.0, massutil.ZeroAmount(), grandFee))
+
+	childDesc, grandDesc := txP.pool[*child.Hash()], txP.pool[*grand.Hash()]
+
+	totalSize := int64(child.PlainSize() + grand.PlainSize())
+	assert.Equal(t, 1, childDesc.AncestorCount)
+	assert.Equal(t, 2, childDesc.DescendantCount)
+	assert.Equal(t, totalSize, childDesc.DescendantSize)
+	assert.Equal(t, int64(51000), childDesc.DescendantFees)
+	assert.Equal(t, 2, grandDesc.AncestorCount)
+	assert.Equal(t, totalSize, grandDesc.AncestorSize)
+	assert.Equal(t, int64(51000), grandDesc.AncestorFees)
+	assert.Equal(t, 1, grandDesc.DescendantCount)
+
+	assertPackageStats(t, txP)
+
+	// stats of child are updated once its descendant is removed
+	txP.RemoveTransaction(grand, false)
+	assert.Equal(t, 1, childDesc.DescendantCount)
+	assert.Equal(t, int64(1000), childDesc.DescendantFees)
+	assertPackageStats(t, txP)
+
+	// child is mined, leaving grand child in pool
+	assert.Nil(t, txP.addTransaction(grand, 27, 100.0, massutil.ZeroAmount(), grandFee))
+	grandDesc = txP.pool[*grand.Hash()]
+	txP.RemoveTransaction(child, false)
+	assert.Equal(t, 1, grandDesc.AncestorCount)
+	assert.Equal(t, int64(50000), grandDesc.AncestorFees)
+	assertPackageStats(t, txP)
+
+	// child is added back from disconnected block
+	assert.Nil(t, txP.addTransaction(child, 26, 100.0, massutil.ZeroAmount(), childFee))
+	assert.Equal(t, 2, grandDesc.AncestorCount)
+	assert.Equal(t, totalSize, grandDesc.AncestorSize)
+	assert.Equal(t, 2, txP.pool[*child.Hash()].DescendantCount)
+	assertPackageStats(t, txP)
+
+	// both are removed with redeemers
+	txP.RemoveTransaction(child, true)
+	assert.Equal(t, 0, len(txP.pool))
+}
+
+// assertPackageStats checks that package statistics updated incrementally
+// equal to those recalculated.
+func assertPackageStats(t *testing.T, txP *TxPool) {
+	for hash, desc := range txP.pool {
+		expected := *desc
+		expected.setAncestorStats(txP.calcAncestors(desc.Tx))
+		expected.setDescendantStats(txP.calcDescendants(desc.Tx))
+		assert.Equal(t, expected, *desc, hash.String())
+	}
+}
+
+func TestTxPool_validateReplacement(t *testing.T) {
+	txP, close, err := newTxPool(25)
+	assert.Nil(t, err)
+	defer close()
+
+	origTx, err := getTx("child2")
+	assert.Nil(t, err)
+	grandTx, err := getTx("grandChild")
+	assert.Nil(t, err)
+	orig := massutil.NewTx(origTx)
+
+	// original does not signal replaceability
+	assert.Nil(t, txP.addTransaction(orig, 26, 100.0, massutil.ZeroAmount(), massutil.ZeroAmount()))
+	replTx, err := getTx("child2")
+	assert.Nil(t, err)
+	replTx.TxOut[0].Value--
+	_, err = txP.checkPoolReplaceable(massutil.NewTx(replTx))
+	assert.Equal(t, ErrDoubleSpend, err)
+	txP.RemoveTransaction(orig, true)
+
+	// original signals replaceability, and has a descendant
+	oldHash := origTx.TxHash()
+	origTx.TxIn[0].Sequence = MaxRBFSequence
+	orig = massutil.NewTx(origTx)
+	origFee, err := massutil.NewAmountFromInt(100000)
+	assert.Nil(t, err)
+	assert.Nil(t, txP.addTransaction(orig, 26, 100.0, massutil.ZeroAmount(), origFee))
+	for _, txIn := range grandTx.TxIn {
+		if txIn.PreviousOutPoint.Hash == oldHash {
+			txIn.PreviousOutPoint.Hash = *orig.Hash()
+		}
+	}
+	grand := massutil.NewTx(grandTx)
+	grandFee, err := massutil.NewAmountFromInt(50000)
+	assert.Nil(t, err)
+	assert.Nil(t, txP.addTransaction(grand, 27, 100.0, massutil.ZeroAmount(), grandFee))
+
+	repl := massutil.NewTx(replTx)
+	conflicts, err := txP.checkPoolReplaceable(repl)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(conflicts))
+
+	tests := []struct {
+		fee int64
+		err error
+	}{
+		{100000, ErrReplacementInsufficientFeeRate},
+		{150000, ErrReplacementInsufficientFee},
+		{1000000, nil},
+	}
+	for _, test := range tests {
+		fee, err := massutil.NewAmountFromInt(test.fee)
+		assert.Nil(t, err)
+		assert.Equal(t, test.err, txP.validateReplacement(repl, fee, conflicts))
+	}
+
+	// replacement spending an output of its descendant
+	spendTx, err := getTx("child2")
+	assert.Nil(t, err)
+	spendTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(grand.Hash(), 0), nil))
+	fee, err := massutil.NewAmountFromInt(1000000)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrReplacementSpendsConflict, txP.validateReplacement(massutil.NewTx(spendTx), fee, conflicts))
+}
+
+// rbfTestKey signs inputs spending its 1-of-1 multisig witness script.
+type rbfTestKey struct {
+	privKey      *btcec.PrivateKey
+	redeemScript []byte
+	pkScript     []byte
+}
+
+func newRBFTestKey() (*rbfTestKey, error) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	addrPubKey, err := massutil.NewAddressPubKey(privKey.PubKey().SerializeCompressed(), &config.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	redeemScript, err := txscript.MultiSigScript([]*massutil.AddressPubKey{addrPubKey}, 1)
+	if err != nil {
+		return nil, err
+	}
+	scriptHash := sha256.Sum256(redeemScript)
+	pkScript, err := txscript.PayToWitnessScriptHashScript(scriptHash[:])
+	if err != nil {
+		return nil, err
+	}
+	return &rbfTestKey{privKey: privKey, redeemScript: redeemScript, pkScript: pkScript}, nil
+}
+
+// spend returns a signed transaction spending prevOut worth value, which
+// signals replaceability and pays fee.
+func (k *rbfTestKey) spend(prevOut *wire.OutPoint, value, fee int64) (*massutil.Tx, error) {
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxIn(wire.NewTxIn(prevOut, nil))
+	msgTx.TxIn[0].Sequence = MaxRBFSequence
+	msgTx.AddTxOut(wire.NewTxOut(value-fee, k.pkScript))
+
+	signClosure := txscript.SignClosure(func(pub *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+		return k.privKey.Sign(hash)
+	})
+	sig, err := txscript.RawTxInWitnessSignature(msgTx, txscript.NewTxSigHashes(msgTx), 0, value,
+		k.redeemScript, txscript.SigHashAll, k.privKey.PubKey(), signClosure)
+	if err != nil {
+		return nil, err
+	}
+	sigScript, err := txscript.NewScriptBuilder().AddData(sig).Script()
+	if err != nil {
+		return nil, err
+	}
+	msgTx.TxIn[0].Witness = wire.TxWitness{sigScript, k.redeemScript}
+	return massutil.NewTx(msgTx), nil
+}
+
+func TestTxPool_MaybeAcceptTransaction_Replacement(t *testing.T) {
+	txP, close, err := newTxPool(25)
+	assert.Nil(t, err)
+	defer close()
+
+	key, err := newRBFTestKey()
+	assert.Nil(t, err)
+
+	// an in-pool parent paying to key
+	const value = int64(10 * consensus.MaxwellPerMass)
+	parentTx, err := getTx("child2")
+	assert.Nil(t, err)
+	parentTx.TxOut = []*wire.TxOut{wire.NewTxOut(value, key.pkScript)}
+	parent := massutil.NewTx(parentTx)
+	assert.Nil(t, txP.addTransaction(parent, 26, 100.0, massutil.ZeroAmount(), massutil.ZeroAmount()))
+	prevOut := wire.NewOutPoint(parent.Hash(), 0)
+
+	orig, err := key.spend(prevOut, value, 1000000)
+	assert.Nil(t, err)
+	_, err = txP.maybeAcceptTransaction(orig, true, false)
+	assert.Nil(t, err)
+	grand, err := key.spend(wire.NewOutPoint(orig.Hash(), 0), value-1000000, 1000000)
+	assert.Nil(t, err)
+	_, err = txP.maybeAcceptTransaction(grand, true, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, txP.Count())
+
+	// replacement paying a higher fee rate, but too little fee for the
+	// evicted package is rejected
+	lowRepl, err := key.spend(prevOut, value, 1500000)
+	assert.Nil(t, err)
+	_, err = txP.maybeAcceptTransaction(lowRepl, true, false)
+	assert.Equal(t, ErrReplacementInsufficientFee, err)
+	assert.True(t, txP.IsTransactionInPool(orig.Hash()))
+	assert.True(t, txP.IsTransactionInPool(grand.Hash()))
+
+	// replacement failing after replacement checks keeps originals
+	badRepl, err := key.spend(prevOut, value, 5000000)
+	assert.Nil(t, err)
+	badSig := badRepl.MsgTx().TxIn[0].Witness[0]
+	badSig[len(badSig)/2] ^= 0xff
+	_, err = txP.maybeAcceptTransaction(massutil.NewTx(badRepl.MsgTx()), true, false)
+	assert.Equal(t, ErrScriptValidation, err)
+	assert.True(t, txP.IsTransactionInPool(orig.Hash()))
+	assert.True(t, txP.IsTransactionInPool(grand.Hash()))
+	assert.Equal(t, 3, txP.Count())
+
+	// replacement evicts original along with its descendant
+	repl, err := key.spend(prevOut, value, 5000000)
+	assert.Nil(t, err)
+	_, err = txP.maybeAcceptTransaction(repl, true, false)
+	assert.Nil(t, err)
+	assert.True(t, txP.IsTransactionInPool(repl.Hash()))
+	assert.False(t, txP.IsTransactionInPool(orig.Hash()))
+	assert.False(t, txP.IsTransactionInPool(grand.Hash()))
+	assert.Equal(t, 2, txP.Count())
+	assert.Equal(t, repl, txP.outpoints[*prevOut])
+}
+
+func TestTxPool_SaveLoadMempool(t *testing.T) {
+	txP, close, err := newTxPool(25)
+	assert.Nil(t, err)
+	defer close()
+
+	msgtx, err := getTx("child1")
+	assert.Nil(t, err)
+	tx := massutil.NewTx(msgtx)
+	_, err = txP.maybeAcceptTransaction(tx, true, true)
+	assert.Nil(t, err)
+	added := txP.pool[*tx.Hash()].Added.Add(-time.Hour)
+	txP.pool[*tx.Hash()].Added = added
+	txP.PrioritiseTransaction(tx.Hash(), 1000)
+
+	orphanTx, err := getTx("orphanTxStr")
+	assert.Nil(t, err)
+	orphan := massutil.NewTx(orphanTx)
+	assert.Nil(t, txP.orphanTxPool.maybeAddOrphan(orphan))
+
+	dir, err := ioutil.TempDir("", "mempool")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, MempoolFileName)
+
+	txCount, orphanCount, err := txP.SaveMempool(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, txCount)
+	assert.Equal(t, 1, orphanCount)
+
+	txP.RemoveTransaction(tx, true)
+	txP.RemoveOrphan(orphan.Hash())
+	txP.feeDeltas = make(map[wire.Hash]int64)
+
+	accepted, dropped, err := txP.LoadMempool(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, accepted)
+	assert.Equal(t, 0, dropped)
+	assert.True(t, txP.IsTransactionInPool(tx.Hash()))
+	assert.True(t, txP.IsOrphanInPool(orphan.Hash()))
+	assert.True(t, txP.pool[*tx.Hash()].Added.Equal(added))
+	assert.Equal(t, int64(1000), txP.FeeDeltas()[*tx.Hash()])
+
+	// a missing file is not an error
+	_, _, err = txP.LoadMempool(filepath.Join(dir, "missing.dat"))
+	assert.Nil(t, err)
+}
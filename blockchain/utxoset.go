@@ -0,0 +1,201 @@
+package blockchain
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+	"os"
+
+	"massnet.org/mass/database"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/txscript"
+	"massnet.org/mass/wire"
+)
+
+const (
+	// UtxoSetFileName is the name of the file the utxo set is dumped to.
+	UtxoSetFileName = "utxoset.dat"
+
+	// utxoSetFileVersion is the version of the utxo set file format.
+	utxoSetFileVersion uint32 = 1
+)
+
+// UtxoSetInfo houses statistics of the utxo set at a block.
+type UtxoSetInfo struct {
+	Height         uint64
+	BestHash       wire.Hash
+	Transactions   uint64
+	TxOuts         uint64
+	TotalAmount    massutil.Amount
+	StakingAmount  massutil.Amount
+	BindingAmount  massutil.Amount
+	SerializedSize uint64
+	SerializedHash wire.Hash
+}
+
+// utxoSetScanner accumulates statistics of unspent outputs, and writes their
+// serialization to the hash along with an optional writer.
+type utxoSetScanner struct {
+	info   UtxoSetInfo
+	hasher hash.Hash
+	w      io.Writer
+	lastTx wire.Hash
+	buf    []byte
+}
+
+func newUtxoSetScanner(w io.Writer) *utxoSetScanner {
+	return &utxoSetScanner{
+		info: UtxoSetInfo{
+			TotalAmount:   massutil.ZeroAmount(),
+			StakingAmount: massutil.ZeroAmount(),
+			BindingAmount: massutil.ZeroAmount(),
+		},
+		hasher: sha256.New(),
+		w:      w,
+	}
+}
+
+// serializeUtxo appends the serialization of utxo to buf:
+//
+//	TxID | Index | Height | Coinbase | Value | len(PkScript) | PkScript
+//	 32  |   4   |    8   |     1    |   8   |       4       |  variable
+//
+// Integers are encoded in little endian.
+func serializeUtxo(buf []byte, utxo *database.UtxoReply) []byte {
+	var bs [8]byte
+	buf = append(buf, utxo.TxSha[:]...)
+	binary.LittleEndian.PutUint32(bs[:4], utxo.Index)
+	buf = append(buf, bs[:4]...)
+	binary.LittleEndian.PutUint64(bs[:], utxo.Height)
+	buf = append(buf, bs[:]...)
+	if utxo.Coinbase {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	binary.LittleEndian.PutUint64(bs[:], utxo.Value.UintValue())
+	buf = append(buf, bs[:]...)
+	binary.LittleEndian.PutUint32(bs[:4], uint32(len(utxo.PkScript)))
+	buf = append(buf, bs[:4]...)
+	return append(buf, utxo.PkScript...)
+}
+
+func (s *utxoSetScanner) add(utxo *database.UtxoReply) (err error) {
+	if s.info.TxOuts == 0 || !utxo.TxSha.IsEqual(&s.lastTx) {
+		s.info.Transactions++
+		s.lastTx = *utxo.TxSha
+	}
+	s.info.TxOuts++
+	if s.info.TotalAmount, err = s.info.TotalAmount.Add(utxo.Value); err != nil {
+		return err
+	}
+	if txscript.IsPayToStakingScriptHash(utxo.PkScript) {
+		if s.info.StakingAmount, err = s.info.StakingAmount.Add(utxo.Value); err != nil {
+			return err
+		}
+	} else if txscript.IsPayToBindingScriptHash(utxo.PkScript) {
+		if s.info.BindingAmount, err = s.info.BindingAmount.Add(utxo.Value); err != nil {
+			return err
+		}
+	}
+
+	s.buf = serializeUtxo(s.buf[:0], utxo)
+	s.info.SerializedSize += uint64(len(s.buf))
+	s.hasher.Write(s.buf)
+	if s.w != nil {
+		_, err = s.w.Write(s.buf)
+	}
+	return err
+}
+
+func (s *utxoSetScanner) scan(iter database.UtxoIterator) (*UtxoSetInfo, error) {
+	for iter.Next() {
+		if err := s.add(iter.Utxo()); err != nil {
+			return nil, err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	best, height := iter.Tip()
+	s.info.BestHash = *best
+	s.info.Height = height
+	s.info.SerializedHash = wire.HashH(s.hasher.Sum(nil))
+	return &s.info, nil
+}
+
+// FetchUtxoSetInfo walks through the utxo set of the main chain, and returns
+// its statistics.  SerializedHash is the double sha256 of all unspent outputs
+// serialized in the order of transaction hash and output index.
+//
+// This function does not block processing of blocks, and may fail with
+// database.ErrUtxoSetChanged if the main chain is reorganized meanwhile.
+func (chain *Blockchain) FetchUtxoSetInfo() (*UtxoSetInfo, error) {
+	iter, err := chain.db.NewUtxoIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	return newUtxoSetScanner(nil).scan(iter)
+}
+
+// DumpUtxoSet writes the utxo set of the main chain to the file at path, and
+// returns its statistics as FetchUtxoSetInfo does.  The file consists of a
+// header of format version, best block hash and height, followed by each
+// unspent output in the serialization used for SerializedHash.
+//
+// The file is written to a temporary file which is then renamed, so that an
+// existing file would never be left partially written.
+func (chain *Blockchain) DumpUtxoSet(path string) (*UtxoSetInfo, error) {
+	iter, err := chain.db.NewUtxoIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	tmpPath := path + ".new"
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(f)
+	info, err := writeUtxoSet(w, iter)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return nil, err
+	}
+	if err = os.Rename(tmpPath, path); err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "utxo set dumped", logging.LogFormat{
+		"path": path, "height": info.Height, "txouts": info.TxOuts, "hash": info.SerializedHash})
+	return info, nil
+}
+
+func writeUtxoSet(w io.Writer, iter database.UtxoIterator) (*UtxoSetInfo, error) {
+	best, height := iter.Tip()
+	if err := binary.Write(w, binary.LittleEndian, utxoSetFileVersion); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(best[:]); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(w, binary.LittleEndian, height); err != nil {
+		return nil, err
+	}
+	return newUtxoSetScanner(w).scan(iter)
+}
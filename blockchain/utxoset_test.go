@@ -0,0 +1,51 @@
+package blockchain
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass/wire"
+)
+
+func TestBlockchain_UtxoSet(t *testing.T) {
+	bc, teardown, err := newBlockChain()
+	assert.Nil(t, err)
+	defer teardown()
+
+	blks, err := loadTopNBlk(10)
+	assert.Nil(t, err)
+	for i := 1; i < 10; i++ {
+		_, err = bc.processBlock(blks[i], BFNone)
+		assert.Nil(t, err)
+	}
+
+	info, err := bc.FetchUtxoSetInfo()
+	assert.Nil(t, err)
+	assert.Equal(t, bc.BestBlockHeight(), info.Height)
+	assert.Equal(t, *bc.BestBlockHash(), info.BestHash)
+	assert.NotZero(t, info.Transactions)
+	assert.True(t, info.Transactions <= info.TxOuts)
+	assert.False(t, info.TotalAmount.IsZero())
+	assert.True(t, info.TotalAmount.Cmp(info.StakingAmount) >= 0)
+
+	dir, err := ioutil.TempDir("", "utxoset")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, UtxoSetFileName)
+
+	dumped, err := bc.DumpUtxoSet(path)
+	assert.Nil(t, err)
+	assert.Equal(t, info, dumped)
+
+	bs, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 44+int(info.SerializedSize), len(bs))
+	assert.Equal(t, utxoSetFileVersion, binary.LittleEndian.Uint32(bs[:4]))
+	assert.Equal(t, info.BestHash[:], bs[4:36])
+	assert.Equal(t, info.Height, binary.LittleEndian.Uint64(bs[36:44]))
+	assert.Equal(t, info.SerializedHash, wire.DoubleHashH(bs[44:]))
+}
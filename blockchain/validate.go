@@ -1553,13 +1553,16 @@ func (chain *Blockchain) checkConnectBlock(node *BlockNode, block *massutil.Bloc
 	// optimization because running the scripts is the most time consuming
 	// portion of block handling.
 	var runScripts = true
+	if chain.isAssumedValid(node.Hash, node.Height) {
+		runScripts = false
+	}
 
 	// Now that the inexpensive checks are done and have passed, verify the
 	// transactions are actually allowed to spend the coins by running the
 	// expensive ECDSA signature check scripts.  Doing this last helps
 	// prevent CPU exhaustion attacks.
 	if runScripts {
-		err := checkBlockScripts(block, txInputStore, scriptFlags, chain.sigCache, chain.hashCache)
+		err := checkBlockScripts(chain.scriptValidator, block, txInputStore, scriptFlags, chain.sigCache, chain.hashCache)
 		if err != nil {
 			return err
 		}
@@ -23,6 +23,7 @@ func TestCheckConnectBlock(t *testing.T) {
 		t.Errorf("Failed to setup chain instance: %v", err)
 		return
 	}
+	defer chain.Stop()
 
 	// The genesis block should fail to connect since it's already
 	// inserted.
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"massnet.org/mass/database"
+	"massnet.org/mass/logging"
+)
+
+var errInconsistentDB = errors.New("block database is inconsistent")
+
+// checkBlockDB verifies the block database against raw blocks of the main
+// chain, prints the report and rebuilds inconsistent indexes if repair is
+// true.  An error is returned if inconsistencies remain.
+func checkBlockDB(repair bool) error {
+	db, err := loadBlockDB()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "loadBlockDB error", logging.LogFormat{"err": err})
+		return err
+	}
+	defer db.Close()
+
+	logging.CPrint(logging.INFO, "checking block database", logging.LogFormat{"repair": repair})
+	report, err := db.CheckIntegrity(repair)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to check block database", logging.LogFormat{"err": err})
+		return err
+	}
+	printIntegrityReport(report)
+
+	if report.Consistent() {
+		return nil
+	}
+	if report.Repaired {
+		// verify once more, as repair never touches the main chain itself
+		if report, err = db.CheckIntegrity(false); err != nil {
+			return err
+		}
+		if report.Consistent() {
+			fmt.Println("block database repaired")
+			return nil
+		}
+		printIntegrityReport(report)
+	}
+	return errInconsistentDB
+}
+
+func printIntegrityReport(report *database.IntegrityReport) {
+	fmt.Printf("newest block: %v (height %d)\n", report.Hash, report.Height)
+	if report.Consistent() {
+		fmt.Println("no inconsistency found")
+		return
+	}
+
+	indexes := make([]string, 0, len(report.Counts))
+	for index := range report.Counts {
+		indexes = append(indexes, index)
+	}
+	sort.Strings(indexes)
+	for _, index := range indexes {
+		fmt.Printf("%s: %d inconsistencies\n", index, report.Counts[index])
+	}
+	for _, inconsistency := range report.Inconsistencies {
+		fmt.Printf("  [%s] %s\n", inconsistency.Index, inconsistency.Detail)
+	}
+}
@@ -0,0 +1,411 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	pb "massnet.org/mass/api/proto"
+)
+
+type callFunc func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error)
+
+// command maps a command line onto a method of ApiService. args are required fields
+// of request given in order, opts are optional fields given as <field>=<value>, and
+// secrets are passphrase fields read by readSecrets and set by setSecrets.
+type command struct {
+	name       string
+	args       []string
+	opts       []string
+	secrets    []secret
+	help       string
+	newRequest func() proto.Message
+	call       callFunc
+}
+
+func newEmpty() proto.Message { return &empty.Empty{} }
+
+var (
+	walletPass    = secret{name: "passphrase", prompt: "Enter the private passphrase of wallet"}
+	keystorePass  = secret{name: "passphrase", prompt: "Enter the private passphrase of keystore"}
+	importOldPass = secret{name: "old_passphrase", prompt: "Enter the private passphrase of keystore"}
+	importNewPass = secret{name: "new_passphrase", prompt: "Enter a new private passphrase, or leave it empty to keep", optional: true, confirm: true}
+	oldPrivPass   = secret{name: "old_privpass", prompt: "Enter the current private passphrase"}
+	newPrivPass   = secret{name: "new_privpass", prompt: "Enter the new private passphrase", confirm: true}
+	oldPubPass    = secret{name: "old_pubpass", prompt: "Enter the current public passphrase"}
+	newPubPass    = secret{name: "new_pubpass", prompt: "Enter the new public passphrase", confirm: true}
+)
+
+var commands = []*command{
+	// client
+	{name: "status", help: "Show status of client and peers", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetClientStatus(ctx, req.(*empty.Empty))
+		}},
+	{name: "quit", help: "Stop MASS Miner", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.QuitClient(ctx, req.(*empty.Empty))
+		}},
+
+	// blocks
+	{name: "block best", help: "Show hash and height of best block", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBestBlock(ctx, req.(*empty.Empty))
+		}},
+	{name: "block get", args: []string{"hash"}, help: "Show block with transactions by hash",
+		newRequest: func() proto.Message { return &pb.GetBlockRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlock(ctx, req.(*pb.GetBlockRequest))
+		}},
+	{name: "block height", args: []string{"height"}, help: "Show block with transactions by height",
+		newRequest: func() proto.Message { return &pb.GetBlockByHeightRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlockByHeight(ctx, req.(*pb.GetBlockByHeightRequest))
+		}},
+	{name: "block hash", args: []string{"height"}, help: "Show block hash by height",
+		newRequest: func() proto.Message { return &pb.GetBlockHashByHeightRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlockHashByHeight(ctx, req.(*pb.GetBlockHashByHeightRequest))
+		}},
+	{name: "block header", args: []string{"hash"}, help: "Show block header by hash",
+		newRequest: func() proto.Message { return &pb.GetBlockHeaderRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlockHeader(ctx, req.(*pb.GetBlockHeaderRequest))
+		}},
+	{name: "block summary", args: []string{"id"}, help: "Show block with txids by hash or height",
+		newRequest: func() proto.Message { return &pb.GetBlockRequestV2{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlockV2(ctx, req.(*pb.GetBlockRequestV2))
+		}},
+	{name: "block header2", args: []string{"id"}, help: "Show block header by hash or height",
+		newRequest: func() proto.Message { return &pb.GetBlockRequestV2{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlockHeaderV2(ctx, req.(*pb.GetBlockRequestV2))
+		}},
+	{name: "block verbose", args: []string{"id"}, help: "Show block with transactions by hash or height",
+		newRequest: func() proto.Message { return &pb.GetBlockRequestV2{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlockVerbose1V2(ctx, req.(*pb.GetBlockRequestV2))
+		}},
+	{name: "block pubkey", args: []string{"public_key"}, help: "Show heights of blocks mined by public key",
+		newRequest: func() proto.Message { return &pb.GetBlockHeightByPubKeyRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlockHeightByPubKey(ctx, req.(*pb.GetBlockHeightByPubKeyRequest))
+		}},
+	{name: "block coinbase", args: []string{"height"}, help: "Show coinbase transaction by height",
+		newRequest: func() proto.Message { return &pb.GetCoinbaseRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetCoinbase(ctx, req.(*pb.GetCoinbaseRequest))
+		}},
+	{name: "block generate", args: []string{"count"}, opts: []string{"payout_address"}, help: "Generate blocks on regression test network",
+		newRequest: func() proto.Message { return &pb.GenerateBlocksRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GenerateBlocks(ctx, req.(*pb.GenerateBlocksRequest))
+		}},
+	{name: "block template", opts: []string{"payout_address"}, help: "Show template of next block",
+		newRequest: func() proto.Message { return &pb.GetBlockTemplateRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetBlockTemplate(ctx, req.(*pb.GetBlockTemplateRequest))
+		}},
+	{name: "block policy", args: []string{"strategy"}, help: "Set strategy of selecting transactions for block template",
+		newRequest: func() proto.Message { return &pb.SetBlockTemplatePolicyRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.SetBlockTemplatePolicy(ctx, req.(*pb.SetBlockTemplatePolicyRequest))
+		}},
+
+	// transaction pool
+	{name: "txpool list", help: "Show txids in transaction pool", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetTxPool(ctx, req.(*empty.Empty))
+		}},
+	{name: "txpool verbose0", help: "Show transactions in transaction pool", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetTxPoolVerbose0(ctx, req.(*empty.Empty))
+		}},
+	{name: "txpool verbose1", help: "Show transactions in transaction pool with details", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetTxPoolVerbose1(ctx, req.(*empty.Empty))
+		}},
+	{name: "txpool prioritise", args: []string{"txid", "fee_delta"}, help: "Adjust fee of transaction when selected for block template",
+		newRequest: func() proto.Message { return &pb.PrioritiseTransactionRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.PrioritiseTransaction(ctx, req.(*pb.PrioritiseTransactionRequest))
+		}},
+	{name: "txpool save", help: "Save transaction pool to disk", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.SaveMempool(ctx, req.(*empty.Empty))
+		}},
+
+	// utxo set
+	{name: "utxo info", help: "Show statistics of the utxo set", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetTxOutSetInfo(ctx, req.(*empty.Empty))
+		}},
+	{name: "utxo dump", help: "Dump the utxo set to disk", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.DumpTxOutSet(ctx, req.(*empty.Empty))
+		}},
+
+	// capacity spaces
+	{name: "space list", help: "Show all capacity spaces", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetCapacitySpaces(ctx, req.(*empty.Empty))
+		}},
+	{name: "space get", args: []string{"space_id"}, help: "Show capacity space",
+		newRequest: func() proto.Message { return &pb.WorkSpaceRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetCapacitySpace(ctx, req.(*pb.WorkSpaceRequest))
+		}},
+	{name: "space configure", args: []string{"capacity"}, opts: []string{"payout_addresses"}, secrets: []secret{walletPass}, help: "Configure capacity spaces of capacity in MiB",
+		newRequest: func() proto.Message { return &pb.ConfigureSpaceKeeperRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.ConfigureCapacity(ctx, req.(*pb.ConfigureSpaceKeeperRequest))
+		}},
+	{name: "space plot", opts: []string{"space_id"}, help: "Plot capacity space, or all spaces if space_id is not given",
+		newRequest: func() proto.Message { return &pb.WorkSpaceRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			if r := req.(*pb.WorkSpaceRequest); r.SpaceId != "" {
+				return c.PlotCapacitySpace(ctx, r)
+			}
+			return c.PlotCapacitySpaces(ctx, &empty.Empty{})
+		}},
+	{name: "space mine", opts: []string{"space_id"}, help: "Mine capacity space, or all spaces if space_id is not given",
+		newRequest: func() proto.Message { return &pb.WorkSpaceRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			if r := req.(*pb.WorkSpaceRequest); r.SpaceId != "" {
+				return c.MineCapacitySpace(ctx, r)
+			}
+			return c.MineCapacitySpaces(ctx, &empty.Empty{})
+		}},
+	{name: "space stop", opts: []string{"space_id"}, help: "Stop capacity space, or all spaces if space_id is not given",
+		newRequest: func() proto.Message { return &pb.WorkSpaceRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			if r := req.(*pb.WorkSpaceRequest); r.SpaceId != "" {
+				return c.StopCapacitySpace(ctx, r)
+			}
+			return c.StopCapacitySpaces(ctx, &empty.Empty{})
+		}},
+	{name: "space state", args: []string{"space_id"}, help: "Show desired state, tags and payout address of capacity space",
+		newRequest: func() proto.Message { return &pb.WorkSpaceRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetCapacitySpaceState(ctx, req.(*pb.WorkSpaceRequest))
+		}},
+	{name: "space label", args: []string{"space_id"}, opts: []string{"tags", "payout_address"}, help: "Update tags and payout address of capacity space",
+		newRequest: func() proto.Message { return &pb.UpdateWorkSpaceStateRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.UpdateCapacitySpaceState(ctx, req.(*pb.UpdateWorkSpaceStateRequest))
+		}},
+	{name: "space queue", help: "Show plot queue", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetPlotQueue(ctx, req.(*empty.Empty))
+		}},
+	{name: "space queue-update", args: []string{"action"}, opts: []string{"space_ids"}, help: "Update plot queue, action is one of {pause, resume, reorder}",
+		newRequest: func() proto.Message { return &pb.UpdatePlotQueueRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.UpdatePlotQueue(ctx, req.(*pb.UpdatePlotQueueRequest))
+		}},
+	{name: "space plan", help: "Show capacity plan of proof directories", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetCapacityPlan(ctx, req.(*empty.Empty))
+		}},
+	{name: "space plan-apply", args: []string{"plan_id"}, opts: []string{"payout_addresses"}, secrets: []secret{walletPass}, help: "Apply capacity plan",
+		newRequest: func() proto.Message { return &pb.ApplyCapacityPlanRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.ApplyCapacityPlan(ctx, req.(*pb.ApplyCapacityPlanRequest))
+		}},
+	{name: "space benchmark", opts: []string{"challenges"}, help: "Benchmark proof lookup of mining spaces",
+		newRequest: func() proto.Message { return &pb.BenchmarkProofsRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.BenchmarkProofs(ctx, req.(*pb.BenchmarkProofsRequest))
+		}},
+	{name: "space simulate", opts: []string{"count", "space_ids"}, help: "Simulate mining against recent blocks",
+		newRequest: func() proto.Message { return &pb.SimulateMiningRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.SimulateMining(ctx, req.(*pb.SimulateMiningRequest))
+		}},
+	{name: "space network", opts: []string{"blocks"}, help: "Estimate network space and expected time to block",
+		newRequest: func() proto.Message { return &pb.GetNetworkSpaceRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetNetworkSpace(ctx, req.(*pb.GetNetworkSpaceRequest))
+		}},
+
+	// keystore
+	{name: "keystore list", help: "Show keystores", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.GetKeystore(ctx, req.(*empty.Empty))
+		}},
+	{name: "keystore export", args: []string{"wallet_id"}, opts: []string{"export_path"}, secrets: []secret{keystorePass}, help: "Export keystore",
+		newRequest: func() proto.Message { return &pb.ExportKeystoreRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.ExportKeystore(ctx, req.(*pb.ExportKeystoreRequest))
+		}},
+	{name: "keystore import", args: []string{"import_path"}, secrets: []secret{importOldPass, importNewPass}, help: "Import keystore",
+		newRequest: func() proto.Message { return &pb.ImportKeystoreRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.ImportKeystore(ctx, req.(*pb.ImportKeystoreRequest))
+		}},
+
+	// wallet
+	{name: "wallet unlock", secrets: []secret{walletPass}, help: "Unlock wallet",
+		newRequest: func() proto.Message { return &pb.UnlockWalletRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.UnlockWallet(ctx, req.(*pb.UnlockWalletRequest))
+		}},
+	{name: "wallet lock", help: "Lock wallet", newRequest: newEmpty,
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.LockWallet(ctx, req.(*empty.Empty))
+		}},
+	{name: "wallet privpass", secrets: []secret{oldPrivPass, newPrivPass}, help: "Change private passphrase",
+		newRequest: func() proto.Message { return &pb.ChangePrivatePassRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.ChangePrivatePass(ctx, req.(*pb.ChangePrivatePassRequest))
+		}},
+	{name: "wallet pubpass", secrets: []secret{oldPubPass, newPubPass}, help: "Change public passphrase",
+		newRequest: func() proto.Message { return &pb.ChangePublicPassRequest{} },
+		call: func(ctx context.Context, c pb.ApiServiceClient, req proto.Message) (proto.Message, error) {
+			return c.ChangePublicPass(ctx, req.(*pb.ChangePublicPassRequest))
+		}},
+}
+
+func (cmd *command) usage() string {
+	parts := []string{cmd.name}
+	for _, arg := range cmd.args {
+		parts = append(parts, "<"+arg+">")
+	}
+	for _, opt := range cmd.opts {
+		parts = append(parts, "["+opt+"=<value>]")
+	}
+	return strings.Join(parts, " ")
+}
+
+func listCommands(w io.Writer) {
+	fmt.Fprintln(w, "Commands:")
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(tw, "  %s\t%s\n", cmd.usage(), cmd.help)
+	}
+	tw.Flush()
+	fmt.Fprintln(w, "\nRepeated fields, e.g. space_ids, tags and payout_addresses, are separated by comma.")
+	fmt.Fprintln(w, "Passphrases are prompted for, or read from stdin one per line if it is not a terminal.")
+}
+
+// findCommand returns the command of the longest name matching args, and the remaining args.
+func findCommand(args []string) (*command, []string, error) {
+	for n := 2; n > 0; n-- {
+		if len(args) < n {
+			continue
+		}
+		name := strings.Join(args[:n], " ")
+		for _, cmd := range commands {
+			if cmd.name == name {
+				return cmd, args[n:], nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("unknown command %s, use %s -l to list commands", strings.Join(args, " "), appName)
+}
+
+// buildRequest assigns args to fields of request, string values are kept as is,
+// repeated values are separated by comma, and others are parsed as JSON.
+func (cmd *command) buildRequest(args []string) (proto.Message, error) {
+	req := cmd.newRequest()
+	if len(args) < len(cmd.args) {
+		return nil, fmt.Errorf("missing argument %s", cmd.args[len(args)])
+	}
+	values := make(map[string]string)
+	for i, name := range cmd.args {
+		values[name] = args[i]
+	}
+	for i, arg := range args[len(cmd.args):] {
+		idx := strings.Index(arg, "=")
+		// the first optional field could be given without name
+		if idx < 0 && i == 0 && len(cmd.opts) > 0 {
+			values[cmd.opts[0]] = arg
+			continue
+		}
+		if idx < 0 || !containsString(cmd.opts, arg[:idx]) {
+			return nil, fmt.Errorf("invalid argument %s", arg)
+		}
+		values[arg[:idx]] = arg[idx+1:]
+	}
+	if len(values) == 0 {
+		return req, nil
+	}
+
+	fields := make(map[string]json.RawMessage, len(values))
+	for name, value := range values {
+		kind, repeated, ok := requestField(req, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %s", name)
+		}
+		var raw []byte
+		var err error
+		switch {
+		case repeated:
+			items := make([]string, 0)
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			raw, err = json.Marshal(items)
+		case kind == reflect.String:
+			raw, err = json.Marshal(value)
+		case kind == reflect.Bool:
+			var b bool
+			b, err = strconv.ParseBool(value)
+			raw = []byte(strconv.FormatBool(b))
+		default:
+			if _, err = strconv.ParseFloat(value, 64); err != nil {
+				err = fmt.Errorf("invalid number %s of %s", value, name)
+			}
+			raw = []byte(value)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = raw
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	if err = jsonpb.UnmarshalString(string(data), req); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %v", err)
+	}
+	return req, nil
+}
+
+// requestField finds the field of msg by proto name.
+func requestField(msg proto.Message, name string) (kind reflect.Kind, repeated bool, ok bool) {
+	typ := reflect.TypeOf(msg).Elem()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+			if part == "name="+name {
+				if field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() != reflect.Uint8 {
+					return field.Type.Elem().Kind(), true, true
+				}
+				return field.Type.Kind(), false, true
+			}
+		}
+	}
+	return reflect.Invalid, false, false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "massnet.org/mass/api/proto"
+)
+
+func TestBuildRequest(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected proto.Message
+		err      bool
+	}{
+		{args: []string{"block", "hash", "100"}, expected: &pb.GetBlockHashByHeightRequest{Height: 100}},
+		{args: []string{"block", "hash", "abc"}, err: true},
+		{args: []string{"block", "hash"}, err: true},
+		{args: []string{"wallet", "unlock"}, expected: &pb.UnlockWalletRequest{}},
+		{args: []string{"wallet", "unlock", "123456"}, err: true},
+		{args: []string{"keystore", "export", "wid", "export_path=/tmp"}, expected: &pb.ExportKeystoreRequest{WalletId: "wid", ExportPath: "/tmp"}},
+		{args: []string{"space", "plot"}, expected: &pb.WorkSpaceRequest{}},
+		{args: []string{"space", "plot", "sid"}, expected: &pb.WorkSpaceRequest{SpaceId: "sid"}},
+		{args: []string{"space", "label", "sid", "tags=ssd, rack1", "payout_address=ms1qq"},
+			expected: &pb.UpdateWorkSpaceStateRequest{SpaceId: "sid", Tags: []string{"ssd", "rack1"}, PayoutAddress: "ms1qq"}},
+		{args: []string{"space", "label", "sid", "unknown=1"}, err: true},
+		{args: []string{"txpool", "prioritise", "txid", "-1000"}, expected: &pb.PrioritiseTransactionRequest{Txid: "txid", FeeDelta: -1000}},
+	}
+
+	for i, test := range tests {
+		cmd, args, err := findCommand(test.args)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		req, err := cmd.buildRequest(args)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expected error, got %v", i, req)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !proto.Equal(req, test.expected) {
+			t.Errorf("%d: expected %v, got %v", i, test.expected, req)
+		}
+	}
+
+	if _, _, err := findCommand([]string{"block", "unknown"}); err == nil {
+		t.Errorf("expected unknown command")
+	}
+}
+
+func TestPrintTable(t *testing.T) {
+	resp := &pb.GetClientStatusResponse{
+		Version:   "1.0.0",
+		PeerCount: &pb.GetClientStatusResponsePeerCountInfo{Total: 2, Outbound: 1, Inbound: 1},
+		Peers: &pb.GetClientStatusResponsePeerList{
+			Outbound: []*pb.GetClientStatusResponsePeerInfo{{Id: "a", Address: "1.1.1.1:43453", Direction: "outbound"}},
+			Inbound:  []*pb.GetClientStatusResponsePeerInfo{{Id: "b", Address: "2.2.2.2:43453", Direction: "inbound"}},
+		},
+	}
+	var buf bytes.Buffer
+	if err := printResult(&buf, resp, outputTable); err != nil {
+		t.Fatal(err)
+	}
+	expected := "" +
+		"version:                    1.0.0\n" +
+		"peer_listening:             false\n" +
+		"syncing:                    false\n" +
+		"mining:                     false\n" +
+		"space_keeping:              false\n" +
+		"chain_id:                   \n" +
+		"local_best_height:          0\n" +
+		"known_best_height:          0\n" +
+		"p2p_id:                     \n" +
+		"peer_count.total:           2\n" +
+		"peer_count.outbound:        1\n" +
+		"peer_count.inbound:         1\n" +
+		"peers.other:                \n" +
+		"network_space:              0\n" +
+		"space_share:                0\n" +
+		"expected_seconds_to_block:  0\n" +
+		"script_validation:          -\n" +
+		"\n" +
+		"peers.outbound:\n" +
+		"ID  ADDRESS        DIRECTION\n" +
+		"a   1.1.1.1:43453  outbound\n" +
+		"\n" +
+		"peers.inbound:\n" +
+		"ID  ADDRESS        DIRECTION\n" +
+		"b   2.2.2.2:43453  inbound\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected table\n%s\nexpected\n%s", buf.String(), expected)
+	}
+}
+
+func TestReadSecrets(t *testing.T) {
+	cmd, _, err := findCommand([]string{"keystore", "import"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		input    string
+		expected map[string]string
+		err      bool
+	}{
+		{input: "old\nnew\n", expected: map[string]string{"old_passphrase": "old", "new_passphrase": "new"}},
+		{input: "old\r\nnew", expected: map[string]string{"old_passphrase": "old", "new_passphrase": "new"}},
+		// new passphrase is optional
+		{input: "old\n", expected: map[string]string{"old_passphrase": "old", "new_passphrase": ""}},
+		{input: "\nnew\n", err: true},
+		{input: "", err: true},
+	}
+	for i, test := range tests {
+		secrets, err := readSecrets(cmd, strings.NewReader(test.input), ioutil.Discard)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expected error, got %v", i, secrets)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(secrets, test.expected) {
+			t.Errorf("%d: expected %v, got %v, %v", i, test.expected, secrets, err)
+		}
+	}
+
+	req, err := cmd.buildRequest([]string{"/path/to/keystore"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	setSecrets(req, map[string]string{"old_passphrase": "old", "new_passphrase": "new"})
+	expected := &pb.ImportKeystoreRequest{ImportPath: "/path/to/keystore", OldPassphrase: "old", NewPassphrase: "new"}
+	if !proto.Equal(req, expected) {
+		t.Errorf("expected %v, got %v", expected, req)
+	}
+
+	// commands without passphrase never read stdin
+	cmd, _, _ = findCommand([]string{"status"})
+	if secrets, err := readSecrets(cmd, strings.NewReader("unused\n"), ioutil.Discard); secrets != nil || err != nil {
+		t.Errorf("unexpected secrets %v, %v", secrets, err)
+	}
+}
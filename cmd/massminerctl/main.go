@@ -0,0 +1,179 @@
+// massminerctl is a command line client for the gRPC API of MASS Miner.
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/go-flags"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	pb "massnet.org/mass/api/proto"
+	"massnet.org/mass/config"
+	configpb "massnet.org/mass/config/pb"
+	"massnet.org/mass/version"
+)
+
+const (
+	appName = "massminerctl"
+
+	// tokenEnv is the environment variable of bearer token, which takes precedence over config file.
+	tokenEnv = "MASSMINERCTL_TOKEN"
+
+	// defaultRPCHost is the address gRPC server of MASS Miner listens on.
+	defaultRPCHost = "127.0.0.1"
+
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+type options struct {
+	ConfigFile  string        `short:"C" long:"configfile" description:"Path to configuration file of MASS Miner"`
+	RPCServer   string        `short:"s" long:"rpcserver" description:"gRPC server to connect to, default 127.0.0.1:<api_port_grpc>"`
+	Token       string        `short:"t" long:"token" description:"Bearer token, default $MASSMINERCTL_TOKEN or the token of highest role in api_tokens"`
+	TLS         bool          `long:"tls" description:"Connect over TLS, which is set if api_tls is true"`
+	TLSCert     string        `long:"tlscert" description:"Certificate of gRPC server, default api_tls_cert"`
+	Output      string        `short:"o" long:"output" description:"Output format {table, json}" default:"table"`
+	Timeout     time.Duration `long:"timeout" description:"Timeout of each request" default:"2m"`
+	ShowVersion bool          `short:"V" long:"version" description:"Display version information and exit"`
+	ListCommand bool          `short:"l" long:"listcommands" description:"List all of the supported commands and exit"`
+}
+
+func main() {
+	if err := ctlMain(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func ctlMain() error {
+	opts := &options{ConfigFile: config.DefaultConfigFilename}
+	parser := flags.NewParser(opts, flags.Default|flags.PassAfterNonOption)
+	parser.Usage = "[OPTIONS] <command> [<args...>]"
+	args, err := parser.Parse()
+	if err != nil {
+		if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+			fmt.Fprintln(os.Stdout)
+			listCommands(os.Stdout)
+			os.Exit(0)
+		}
+		return fmt.Errorf("use %s -h to show usage", appName)
+	}
+	if opts.ShowVersion {
+		fmt.Println(appName, "version", version.GetVersion())
+		return nil
+	}
+	if opts.ListCommand {
+		listCommands(os.Stdout)
+		return nil
+	}
+	if opts.Output != outputTable && opts.Output != outputJSON {
+		return fmt.Errorf("invalid output format %s", opts.Output)
+	}
+	if len(args) == 0 {
+		listCommands(os.Stderr)
+		return fmt.Errorf("missing command")
+	}
+
+	cmd, args, err := findCommand(args)
+	if err != nil {
+		return err
+	}
+	req, err := cmd.buildRequest(args)
+	if err != nil {
+		return fmt.Errorf("%v\nusage: %s %s", err, appName, cmd.usage())
+	}
+	secrets, err := readSecrets(cmd, os.Stdin, os.Stderr)
+	if err != nil {
+		return err
+	}
+	setSecrets(req, secrets)
+
+	cfg, err := loadConfig(opts.ConfigFile)
+	if err != nil {
+		return err
+	}
+	conn, err := dial(opts, cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	defer cancel()
+	if token := apiToken(opts, cfg); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	resp, err := cmd.call(ctx, pb.NewApiServiceClient(conn), req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			return fmt.Errorf("%s (code %d)", st.Message(), st.Code())
+		}
+		return err
+	}
+	return printResult(os.Stdout, resp, opts.Output)
+}
+
+// loadConfig reads connection settings from config file of MASS Miner,
+// the default config file is not required to exist.
+func loadConfig(configFile string) (*config.Config, error) {
+	cfg := &config.Config{ConfigFile: configFile, Config: configpb.NewConfig()}
+	if _, err := config.LoadConfig(cfg); err != nil {
+		if !os.IsNotExist(err) || configFile != config.DefaultConfigFilename {
+			return nil, fmt.Errorf("failed to load config file %s: %v", configFile, err)
+		}
+	}
+	return config.CheckConfig(cfg)
+}
+
+func dial(opts *options, cfg *config.Config) (*grpc.ClientConn, error) {
+	address := opts.RPCServer
+	if address == "" {
+		address = net.JoinHostPort(defaultRPCHost, cfg.Network.API.APIPortGRPC)
+	}
+	dialOpts := []grpc.DialOption{grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1e7))}
+	if opts.TLS || cfg.Network.API.APITLS {
+		certFile := opts.TLSCert
+		if certFile == "" {
+			certFile = cfg.Network.API.APITLSCert
+		}
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		creds, err := credentials.NewClientTLSFromFile(certFile, host)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls cert %s: %v", certFile, err)
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(creds))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	return grpc.Dial(address, dialOpts...)
+}
+
+// tokenRoles is ordered by precedence when choosing token from api_tokens.
+var tokenRoles = []string{"wallet-admin", "mining-operator", "read-only"}
+
+func apiToken(opts *options, cfg *config.Config) string {
+	if opts.Token != "" {
+		return opts.Token
+	}
+	if token := os.Getenv(tokenEnv); token != "" {
+		return token
+	}
+	for _, role := range tokenRoles {
+		for _, item := range cfg.Network.API.APITokens {
+			if strings.HasPrefix(item, role+":") {
+				return item[len(role)+1:]
+			}
+		}
+	}
+	return ""
+}
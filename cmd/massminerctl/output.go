@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// field is an item of JSON object, objects are decoded into []field to keep
+// fields in the order of proto definition.
+type field struct {
+	key   string
+	value interface{}
+}
+
+func printResult(w io.Writer, msg proto.Message, format string) error {
+	if format == outputJSON {
+		m := &jsonpb.Marshaler{OrigName: true, EmitDefaults: true, Indent: "  "}
+		if err := m.Marshal(w, msg); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintln(w)
+		return err
+	}
+
+	var buf bytes.Buffer
+	m := &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	if err := m.Marshal(&buf, msg); err != nil {
+		return err
+	}
+	dec := json.NewDecoder(&buf)
+	dec.UseNumber()
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return err
+	}
+	return printTable(w, v)
+}
+
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := make([]field, 0)
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, field{key: key.(string), value: value})
+		}
+		_, err = dec.Token()
+		return obj, err
+	case json.Delim('['):
+		arr := make([]interface{}, 0)
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		_, err = dec.Token()
+		return arr, err
+	default:
+		return tok, nil
+	}
+}
+
+// printTable prints scalar fields as "key: value" lines, nested objects flattened with
+// dotted keys, and lists of objects as tables following the lines.
+func printTable(w io.Writer, v interface{}) error {
+	obj, ok := v.([]field)
+	if !ok {
+		_, err := fmt.Fprintln(w, formatValue(v))
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	lists := make([]field, 0)
+	for _, f := range flatten("", obj) {
+		if arr, ok := f.value.([]interface{}); ok && isObjectList(arr) {
+			lists = append(lists, f)
+			continue
+		}
+		fmt.Fprintf(tw, "%s:\t%s\n", f.key, formatValue(f.value))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, list := range lists {
+		fmt.Fprintf(w, "\n%s:\n", list.key)
+		rows := list.value.([]interface{})
+		columns := make([]string, 0)
+		flatRows := make([]map[string]interface{}, len(rows))
+		for i, row := range rows {
+			flatRows[i] = make(map[string]interface{})
+			for _, f := range flatten("", row.([]field)) {
+				if !containsString(columns, f.key) {
+					columns = append(columns, f.key)
+				}
+				flatRows[i][f.key] = f.value
+			}
+		}
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+		for _, row := range flatRows {
+			cells := make([]string, len(columns))
+			for i, column := range columns {
+				cells[i] = formatValue(row[column])
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flatten(prefix string, obj []field) []field {
+	fields := make([]field, 0, len(obj))
+	for _, f := range obj {
+		key := prefix + f.key
+		if nested, ok := f.value.([]field); ok {
+			fields = append(fields, flatten(key+".", nested)...)
+			continue
+		}
+		fields = append(fields, field{key: key, value: f.value})
+	}
+	return fields
+}
+
+func isObjectList(arr []interface{}) bool {
+	if len(arr) == 0 {
+		return false
+	}
+	_, ok := arr[0].([]field)
+	return ok
+}
+
+func formatValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		return value
+	case []interface{}:
+		if isObjectList(value) {
+			return fmt.Sprintf("[%d items]", len(value))
+		}
+		items := make([]string, len(value))
+		for i, item := range value {
+			items[i] = formatValue(item)
+		}
+		return strings.Join(items, ", ")
+	default:
+		return fmt.Sprint(value)
+	}
+}
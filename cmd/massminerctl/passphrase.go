@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// secret is a passphrase field of request, which is never given on command line,
+// so that it is kept out of shell history and process list.
+type secret struct {
+	name     string
+	prompt   string
+	optional bool
+	confirm  bool
+}
+
+// readSecrets reads passphrases of cmd from in, prompting on w without echo if in
+// is a terminal, or one per line otherwise, e.g. piped from a file.
+func readSecrets(cmd *command, in io.Reader, w io.Writer) (map[string]string, error) {
+	if len(cmd.secrets) == 0 {
+		return nil, nil
+	}
+	f, ok := in.(*os.File)
+	if ok && terminal.IsTerminal(int(f.Fd())) {
+		return promptSecrets(cmd.secrets, int(f.Fd()), w)
+	}
+
+	values := make(map[string]string, len(cmd.secrets))
+	reader := bufio.NewReader(in)
+	for _, s := range cmd.secrets {
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("failed to read %s from stdin: %v", s.name, err)
+		}
+		value := strings.TrimRight(line, "\r\n")
+		if value == "" && !s.optional {
+			return nil, fmt.Errorf("missing %s", s.name)
+		}
+		values[s.name] = value
+	}
+	return values, nil
+}
+
+func promptSecrets(secrets []secret, fd int, w io.Writer) (map[string]string, error) {
+	read := func(prompt string) (string, error) {
+		fmt.Fprintf(w, "%s: ", prompt)
+		value, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(w)
+		return string(value), err
+	}
+
+	values := make(map[string]string, len(secrets))
+	for _, s := range secrets {
+		for {
+			value, err := read(s.prompt)
+			if err != nil {
+				return nil, err
+			}
+			if value == "" && !s.optional {
+				continue
+			}
+			if s.confirm && value != "" {
+				confirm, err := read("Confirm " + strings.ToLower(s.prompt[:1]) + s.prompt[1:])
+				if err != nil {
+					return nil, err
+				}
+				if confirm != value {
+					fmt.Fprintln(w, "The entered passphrases do not match")
+					continue
+				}
+			}
+			values[s.name] = value
+			break
+		}
+	}
+	return values, nil
+}
+
+// setSecrets assigns secrets to string fields of req by proto name.
+func setSecrets(req proto.Message, secrets map[string]string) {
+	v := reflect.ValueOf(req).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		for _, part := range strings.Split(v.Type().Field(i).Tag.Get("protobuf"), ",") {
+			if value, ok := secrets[strings.TrimPrefix(part, "name=")]; ok && strings.HasPrefix(part, "name=") {
+				v.Field(i).SetString(value)
+			}
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net"
 	"os"
 	"path/filepath"
@@ -22,6 +23,7 @@ import (
 
 const (
 	defaultChainTag           = "mainnet"
+	regressionChainTag        = "regtest"
 	DefaultConfigFilename     = "config.json"
 	defaultShowVersion        = false
 	DefaultDataDirname        = "chain"
@@ -33,6 +35,8 @@ const (
 	defaultDbType             = "leveldb"
 	defaultPoCMinerBackend    = "sync"
 	defaultSpaceKeeperBackend = "spacekeeper.v1"
+	defaultMassDBType         = "massdb.v1"
+	defaultPlotPerDisk        = 1
 	defaultBlockMinSize       = 0
 	defaultBlockMaxSize       = wire.MaxBlockPayload
 	defaultBlockPrioritySize  = consensus.DefaultBlockPrioritySize
@@ -42,8 +46,17 @@ const (
 	MaxMiningPayoutAddresses  = 6000
 	defaultAPIPortGRPC        = "9685"
 	defaultAPIPortHttp        = "9686"
+	defaultAPITLSCert         = "api.cert"
+	defaultAPITLSKey          = "api.key"
+
+	// defaultAPIRateBurstSeconds is the default burst of api rate limits in seconds of rate
+	defaultAPIRateBurstSeconds = 2
+
+	defaultSigCacheSize = 50000
 )
 
+var defaultAPICORSMethods = []string{"GET", "POST"}
+
 var (
 	FreeTxRelayLimit         = 15.0
 	AddrIndex                = true
@@ -60,7 +73,8 @@ var (
 	MassHomeDir                  = AppDataDir("mass", false)
 	defaultConfigFile            = DefaultConfigFilename
 	defaultDataDir               = DefaultDataDirname
-	knownDbTypes                 = []string{"leveldb", "memdb"}
+	knownDbTypes                 = []string{"leveldb", "badger", "memdb"}
+	knownMassDBTypes             = []string{"massdb.v1", "massdb.v2"}
 	defaultMinerFileDir          = defaultMinerFileDirname
 	defaultProofDir              = defaultProofDirname
 	defaultLogDir                = defaultLogDirname
@@ -79,11 +93,18 @@ type serviceOptions struct {
 
 type Config struct {
 	*configpb.Config
-	ConfigFile  string `short:"C" long:"configfile" description:"Path to configuration file"`
-	ShowVersion bool   `short:"V" long:"version" description:"Display Version information and exit"`
-	Generate    bool   `long:"generate" description:"Generate (mine) coins when start"`
-	Init        bool   `long:"init" description:"Init miner keystore"`
-	PrivatePass string `short:"P" long:"privpass" description:"Private passphrase for miner"`
+	ConfigFile    string `short:"C" long:"configfile" description:"Path to configuration file"`
+	ShowVersion   bool   `short:"V" long:"version" description:"Display Version information and exit"`
+	Generate      bool   `long:"generate" description:"Generate (mine) coins when start"`
+	Init          bool   `long:"init" description:"Init miner keystore"`
+	PrivatePass   string `short:"P" long:"privpass" description:"Private passphrase for miner"`
+	RegTest       bool   `long:"regtest" description:"Use the regression test network"`
+	CheckDb       bool   `long:"checkdb" description:"Check consistency of block database and exit"`
+	RepairDb      bool   `long:"repairdb" description:"Check block database, rebuild inconsistent indexes and exit"`
+	Reindex       bool   `long:"reindex" description:"Rebuild chain state from blocks stored in block database"`
+	MigrateDb     string `long:"migratedb" description:"Copy block database into a new database of given type and exit"`
+	MigrateDir    string `long:"migratedir" description:"Data directory of the database created by --migratedb"`
+	ConvertMassDB bool   `long:"convertmassdb" description:"Convert plotted massdb.v1 files in proof dirs into massdb.v2 and exit"`
 }
 
 // newConfigParser returns a new command line flags parser.
@@ -98,8 +119,8 @@ func newConfigParser(cfg *Config, so *serviceOptions, options flags.Options) *fl
 // ParseConfig reads and parses the config using a Config file and command
 // line options.
 // This func proceeds as follows:
-//  1) Start with a default config with sane settings
-//  2) Pre-parse the command line to check for an alternative config file
+//  1. Start with a default config with sane settings
+//  2. Pre-parse the command line to check for an alternative config file
 func ParseConfig() (*Config, []string, error) {
 	// Default config.
 	cfg := Config{
@@ -197,6 +218,9 @@ func CheckConfig(cfg *Config) (*Config, error) {
 	if cfg.Miner == nil {
 		cfg.Miner = new(configpb.MinerConfig)
 	}
+	if cfg.Chain == nil {
+		cfg.Chain = new(configpb.ChainConfig)
+	}
 	if cfg.Miner.MiningAddr == nil {
 		cfg.Miner.MiningAddr = make([]string, 0)
 	}
@@ -231,7 +255,36 @@ func CheckConfig(cfg *Config) (*Config, error) {
 			return cfg, errors.New(fmt.Sprintf("invalid api whitelist, %d, %s", i, addr))
 		}
 	}
+	if cfg.Network.API.APITokens == nil {
+		cfg.Network.API.APITokens = make([]string, 0)
+	}
+	for i, origin := range cfg.Network.API.APICORSOrigins {
+		if origin != "*" && !strings.HasPrefix(origin, "http://") && !strings.HasPrefix(origin, "https://") {
+			return cfg, errors.New(fmt.Sprintf("invalid api cors origin, %d, %s", i, origin))
+		}
+	}
+	if cfg.Network.API.APICORSOrigins == nil {
+		cfg.Network.API.APICORSOrigins = make([]string, 0)
+	}
+	if len(cfg.Network.API.APICORSMethods) == 0 {
+		cfg.Network.API.APICORSMethods = append([]string(nil), defaultAPICORSMethods...)
+	}
+	for i, method := range cfg.Network.API.APICORSMethods {
+		cfg.Network.API.APICORSMethods[i] = strings.ToUpper(method)
+	}
+	// rate limits are disabled unless set to positive values
+	if cfg.Network.API.APIRateBurst == 0 {
+		cfg.Network.API.APIRateBurst = defaultRateBurst(cfg.Network.API.APIRateLimit)
+	}
+	if cfg.Network.API.APIExpensiveRateBurst == 0 {
+		cfg.Network.API.APIExpensiveRateBurst = defaultRateBurst(cfg.Network.API.APIExpensiveRateLimit)
+	}
 	// TODO: add ip:port match
+	// Switch to regression test network before anything depends on ChainParams
+	if cfg.RegTest {
+		UseRegressionNetParams()
+	}
+
 	// Checks for P2PConfig
 	cfg.Network.P2P.Seeds = NormalizeSeeds(cfg.Network.P2P.Seeds, ChainParams.DefaultPort)
 	if cfg.Network.P2P.ListenAddress == "" {
@@ -259,6 +312,47 @@ func CheckConfig(cfg *Config) (*Config, error) {
 		cfg.Db.DataDir = defaultDataDir
 	}
 	cfg.Db.DataDir = dealWithDir(cfg.Db.DataDir)
+	if cfg.RegTest {
+		cfg.Db.DataDir = filepath.Join(cfg.Db.DataDir, regressionChainTag)
+	}
+	if cfg.MigrateDb != "" {
+		if !validDbType(cfg.MigrateDb) || cfg.MigrateDb == "memdb" || cfg.Db.DbType == "memdb" {
+			return cfg, errors.New(fmt.Sprintf("cannot migrate db_type %s to %s", cfg.Db.DbType, cfg.MigrateDb))
+		}
+		if cfg.MigrateDb == cfg.Db.DbType {
+			return cfg, errors.New(fmt.Sprintf("block database is already %s", cfg.MigrateDb))
+		}
+		if cfg.MigrateDir == "" {
+			return cfg, errors.New("migratedir is required by migratedb")
+		}
+		cfg.MigrateDir = dealWithDir(cfg.MigrateDir)
+		if cfg.MigrateDir == cfg.Db.DataDir {
+			return cfg, errors.New("migratedir should differ from data_dir")
+		}
+	}
+
+	// Checks for TLS files of APIConfig
+	if cfg.Network.API.APITLSCert == "" {
+		cfg.Network.API.APITLSCert = defaultAPITLSCert
+	}
+	cfg.Network.API.APITLSCert = dealWithDir(cfg.Network.API.APITLSCert)
+	if cfg.Network.API.APITLSKey == "" {
+		cfg.Network.API.APITLSKey = defaultAPITLSKey
+	}
+	cfg.Network.API.APITLSKey = dealWithDir(cfg.Network.API.APITLSKey)
+
+	// Checks for ChainConfig
+	if cfg.Chain.ScriptWorkers == 0 {
+		cfg.Chain.ScriptWorkers = uint32(runtime.NumCPU())
+	}
+	if cfg.Chain.SigCacheSize == 0 {
+		cfg.Chain.SigCacheSize = defaultSigCacheSize
+	}
+	if cfg.Chain.AssumeValid != "" {
+		if _, err := DecodeAssumeValid(cfg.Chain.AssumeValid); err != nil {
+			return cfg, err
+		}
+	}
 
 	// Checks for LogConfig
 	if cfg.Log.LogDir == "" {
@@ -276,6 +370,15 @@ func CheckConfig(cfg *Config) (*Config, error) {
 	if cfg.Miner.PocminerBackend == "" {
 		cfg.Miner.PocminerBackend = defaultPoCMinerBackend
 	}
+	if cfg.Miner.PlotPerDisk == 0 {
+		cfg.Miner.PlotPerDisk = defaultPlotPerDisk
+	}
+	if cfg.Miner.MassdbType == "" {
+		cfg.Miner.MassdbType = defaultMassDBType
+	}
+	if !validMassDBType(cfg.Miner.MassdbType) {
+		return cfg, errors.New(fmt.Sprintf("invalid massdb_type %s", cfg.Miner.MassdbType))
+	}
 	if cfg.Generate {
 		cfg.Miner.Generate = true
 	}
@@ -300,6 +403,9 @@ func CheckConfig(cfg *Config) (*Config, error) {
 	for i, dir := range cfg.Miner.ProofDir {
 		cfg.Miner.ProofDir[i] = dealWithDir(dir)
 	}
+	if cfg.Miner.PlotTempDir != "" {
+		cfg.Miner.PlotTempDir = dealWithDir(cfg.Miner.PlotTempDir)
+	}
 	if cfg.Miner.ProofList != "" {
 		_, err := DecodeProofList(cfg.Miner.ProofList)
 		if err != nil {
@@ -364,6 +470,42 @@ func DecodeProofList(proofList string) (map[int]int, error) {
 	return conf, nil
 }
 
+// DecodeAssumeValid decodes config assume valid block in format like "<Height>:<Hash>".
+func DecodeAssumeValid(assumeValid string) (*Checkpoint, error) {
+	desc := strings.Split(strings.Replace(assumeValid, " ", "", -1), ":")
+	if len(desc) != 2 {
+		return nil, errors.New(fmt.Sprintln("invalid assume valid, wrong format", assumeValid))
+	}
+	height, err := strconv.ParseUint(desc[0], 10, 64)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintln("invalid assume valid height", desc[0]))
+	}
+	hash, err := wire.NewHashFromStr(desc[1])
+	if err != nil {
+		return nil, errors.New(fmt.Sprintln("invalid assume valid hash", desc[1]))
+	}
+	return &Checkpoint{Height: height, Hash: hash}, nil
+}
+
+// defaultRateBurst returns the burst allowing defaultAPIRateBurstSeconds of rate, at least 1.
+func defaultRateBurst(rate float64) uint32 {
+	if burst := math.Ceil(rate * defaultAPIRateBurstSeconds); burst > 1 {
+		return uint32(math.Min(burst, math.MaxUint32))
+	}
+	return 1
+}
+
+// validMassDBType returns whether or not massDBType is a supported MassDB type.
+func validMassDBType(massDBType string) bool {
+	for _, knownType := range knownMassDBTypes {
+		if massDBType == knownType {
+			return true
+		}
+	}
+
+	return false
+}
+
 // validDbType returns whether or not dbType is a supported database type.
 func validDbType(dbType string) bool {
 	for _, knownType := range knownDbTypes {
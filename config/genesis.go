@@ -66,6 +66,26 @@ var genesisBlock = wire.MsgBlock{
 	Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
 }
 
+// regressionGenesisHeader only differs from genesisHeader in Target, which
+// is the smallest target allowed.  ChainID is calculated on init.
+var regressionGenesisHeader = func() wire.BlockHeader {
+	header := genesisHeader
+	header.Target = new(big.Int).Set(mainPocLimit)
+	header.BanList = make([]*pocec.PublicKey, 0)
+	return header
+}()
+
+// regressionGenesisBlock defines the genesis block of the regression test
+// network.
+var regressionGenesisBlock = wire.MsgBlock{
+	Header: regressionGenesisHeader,
+	Proposals: wire.ProposalArea{
+		PunishmentArea: make([]*wire.FaultPubKey, 0),
+		OtherArea:      make([]*wire.NormalProposal, 0),
+	},
+	Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
+}
+
 var genesisHash = mustDecodeHash("ee26300e0f068114a680a772e080507c0f9c0ca4335c382c42b78e2eafbebaa3")
 
 var genesisChainID = mustDecodeHash("5433524b370b149007ba1d06225b5d8e53137a041869834cff5860b02bebc5c7")
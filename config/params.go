@@ -145,6 +145,47 @@ var ChainParams = Params{
 	HDCoinType: HDCoinTypeMassMainNet,
 }
 
+// RegressionNetParams defines the network parameters for the regression test
+// Mass network.  Its genesis block starts from the smallest target, so that a
+// single space of the smallest BitLength is able to generate blocks on demand.
+// Address encodings are the same as the main network.
+var RegressionNetParams = Params{
+	Name:        regressionChainTag,
+	DefaultPort: "43455",
+	DNSSeeds:    []string{},
+
+	// Chain parameters
+	GenesisBlock:           &regressionGenesisBlock,
+	PocLimit:               mainPocLimit,
+	SubsidyHalvingInterval: consensus.SubsidyHalvingInterval,
+	ResetMinDifficulty:     true,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: []Checkpoint{},
+
+	// Mempool parameters
+	RelayNonStdTxs: true,
+
+	// Human-readable part for Bech32 encoded segwit addresses, as defined in
+	// BIP 173.
+	Bech32HRPSegwit: "ms",
+
+	// Address encoding magics
+	PubKeyHashAddrID:        0x00,
+	ScriptHashAddrID:        0x05,
+	PrivateKeyID:            0x80,
+	WitnessPubKeyHashAddrID: 0x06,
+	WitnessScriptHashAddrID: 0x0A,
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x04, 0x88, 0xad, 0xe4},
+	HDPublicKeyID:  [4]byte{0x04, 0x88, 0xb2, 0x1e},
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	HDCoinType: HDCoinTypeMassMainNet,
+}
+
 // IsPubKeyHashAddrID returns whether the id is an identifier known to prefix a
 // pay-to-pubkey-hash address on any default or registered network.  This is
 // used when decoding an wallet string into a specific wallet type.  It is up
@@ -211,9 +252,31 @@ func UpdateGenesisBlock(blk *wire.MsgBlock) {
 	ChainParams.GenesisHash = &genesisHash
 }
 
+// UseRegressionNetParams switches ChainParams to the regression test network.
+// It must be called before any chain data is loaded.
+func UseRegressionNetParams() {
+	ChainParams = RegressionNetParams
+	ChainTag = RegressionNetParams.Name
+	Register(&ChainParams)
+}
+
+// updateGenesisIdentity calculates ChainID and GenesisHash of params from its
+// genesis block.
+func updateGenesisIdentity(params *Params) {
+	chainID, err := params.GenesisBlock.Header.GetChainID()
+	if err != nil {
+		panic(err) // should not happen
+	}
+	params.GenesisBlock.Header.ChainID = chainID
+	params.ChainID = &chainID
+	genesisHash := params.GenesisBlock.Header.BlockHash()
+	params.GenesisHash = &genesisHash
+}
+
 func init() {
 	// update genesis block
 	UpdateGenesisBlock(ChainParams.GenesisBlock)
+	updateGenesisIdentity(&RegressionNetParams)
 	// register chainParams
 	Register(&ChainParams)
 }
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestRegressionNetParams(t *testing.T) {
+	params := RegressionNetParams
+	if params.GenesisBlock.Header.Target.Cmp(params.PocLimit) != 0 {
+		t.Errorf("genesis target should be PocLimit, got %s", params.GenesisBlock.Header.Target)
+	}
+	if !params.GenesisBlock.Header.ChainID.IsEqual(params.ChainID) {
+		t.Errorf("mismatched chain id, %s, %s", params.GenesisBlock.Header.ChainID, params.ChainID)
+	}
+	if hash := params.GenesisBlock.Header.BlockHash(); !hash.IsEqual(params.GenesisHash) {
+		t.Errorf("mismatched genesis hash, %s, %s", hash, params.GenesisHash)
+	}
+	if params.GenesisHash.IsEqual(ChainParams.GenesisHash) {
+		t.Errorf("regtest genesis hash should differ from %s", ChainParams.Name)
+	}
+	if params.ChainID.IsEqual(ChainParams.ChainID) {
+		t.Errorf("regtest chain id should differ from %s", ChainParams.Name)
+	}
+}
@@ -12,8 +12,9 @@ func NewConfig() *Config {
 				APIAllowedLan: make([]string, 0),
 			},
 		},
-		Db:  &DataConfig{},
-		Log: &LogConfig{},
+		Db:    &DataConfig{},
+		Log:   &LogConfig{},
+		Chain: &ChainConfig{},
 		Miner: &MinerConfig{
 			MiningAddr: make([]string, 0),
 			ProofDir:   make([]string, 0),
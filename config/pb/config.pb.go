@@ -26,6 +26,7 @@ type Config struct {
 	Db                   *DataConfig    `protobuf:"bytes,3,opt,name=db,proto3" json:"db"`
 	Log                  *LogConfig     `protobuf:"bytes,4,opt,name=log,proto3" json:"log"`
 	Miner                *MinerConfig   `protobuf:"bytes,5,opt,name=miner,proto3" json:"miner"`
+	Chain                *ChainConfig   `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -90,6 +91,13 @@ func (m *Config) GetMiner() *MinerConfig {
 	return nil
 }
 
+func (m *Config) GetChain() *ChainConfig {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
 type AppConfig struct {
 	Profile              string   `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile"`
 	CPUProfile           string   `protobuf:"bytes,2,opt,name=cpu_profile,json=cpuProfile,proto3" json:"cpu_profile"`
@@ -201,6 +209,9 @@ func (m *NetworkConfig) GetAPI() *APIConfig {
 type DataConfig struct {
 	DataDir              string   `protobuf:"bytes,1,opt,name=data_dir,json=dataDir,proto3" json:"data_dir"`
 	DbType               string   `protobuf:"bytes,2,opt,name=db_type,json=dbType,proto3" json:"db_type"`
+	Compactors           uint32   `protobuf:"varint,3,opt,name=compactors,proto3" json:"compactors"`
+	LevelSizeMultiplier  uint32   `protobuf:"varint,4,opt,name=level_size_multiplier,json=levelSizeMultiplier,proto3" json:"level_size_multiplier"`
+	LevelZeroTables      uint32   `protobuf:"varint,5,opt,name=level_zero_tables,json=levelZeroTables,proto3" json:"level_zero_tables"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -244,6 +255,81 @@ func (m *DataConfig) GetDbType() string {
 	return ""
 }
 
+func (m *DataConfig) GetCompactors() uint32 {
+	if m != nil {
+		return m.Compactors
+	}
+	return 0
+}
+
+func (m *DataConfig) GetLevelSizeMultiplier() uint32 {
+	if m != nil {
+		return m.LevelSizeMultiplier
+	}
+	return 0
+}
+
+func (m *DataConfig) GetLevelZeroTables() uint32 {
+	if m != nil {
+		return m.LevelZeroTables
+	}
+	return 0
+}
+
+type ChainConfig struct {
+	ScriptWorkers        uint32   `protobuf:"varint,1,opt,name=script_workers,json=scriptWorkers,proto3" json:"script_workers"`
+	SigCacheSize         uint32   `protobuf:"varint,2,opt,name=sig_cache_size,json=sigCacheSize,proto3" json:"sig_cache_size"`
+	AssumeValid          string   `protobuf:"bytes,3,opt,name=assume_valid,json=assumeValid,proto3" json:"assume_valid"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
+func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
+func (*ChainConfig) ProtoMessage()    {}
+func (*ChainConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{4}
+}
+func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainConfig.Unmarshal(m, b)
+}
+func (m *ChainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainConfig.Marshal(b, m, deterministic)
+}
+func (m *ChainConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainConfig.Merge(m, src)
+}
+func (m *ChainConfig) XXX_Size() int {
+	return xxx_messageInfo_ChainConfig.Size(m)
+}
+func (m *ChainConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainConfig proto.InternalMessageInfo
+
+func (m *ChainConfig) GetScriptWorkers() uint32 {
+	if m != nil {
+		return m.ScriptWorkers
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetSigCacheSize() uint32 {
+	if m != nil {
+		return m.SigCacheSize
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetAssumeValid() string {
+	if m != nil {
+		return m.AssumeValid
+	}
+	return ""
+}
+
 type LogConfig struct {
 	LogDir               string   `protobuf:"bytes,1,opt,name=log_dir,json=logDir,proto3" json:"log_dir"`
 	LogLevel             string   `protobuf:"bytes,2,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
@@ -257,7 +343,7 @@ func (m *LogConfig) Reset()         { *m = LogConfig{} }
 func (m *LogConfig) String() string { return proto.CompactTextString(m) }
 func (*LogConfig) ProtoMessage()    {}
 func (*LogConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{4}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{5}
 }
 func (m *LogConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogConfig.Unmarshal(m, b)
@@ -309,6 +395,14 @@ type MinerConfig struct {
 	ProofList            string   `protobuf:"bytes,8,opt,name=proof_list,json=proofList,proto3" json:"proof_list"`
 	Plot                 bool     `protobuf:"varint,9,opt,name=plot,proto3" json:"plot"`
 	PrivatePassword      string   `protobuf:"bytes,10,opt,name=private_password,json=privatePassword,proto3" json:"private_password"`
+	TemplateStrategy     string   `protobuf:"bytes,11,opt,name=template_strategy,json=templateStrategy,proto3" json:"template_strategy"`
+	AutoMine             bool     `protobuf:"varint,12,opt,name=auto_mine,json=autoMine,proto3" json:"auto_mine"`
+	PoolPubKey           []string `protobuf:"bytes,13,rep,name=pool_pub_key,json=poolPubKey,proto3" json:"pool_pub_key"`
+	PoolSigner           string   `protobuf:"bytes,14,opt,name=pool_signer,json=poolSigner,proto3" json:"pool_signer"`
+	PlotPerDisk          uint32   `protobuf:"varint,15,opt,name=plot_per_disk,json=plotPerDisk,proto3" json:"plot_per_disk"`
+	PlotMemoryMb         uint64   `protobuf:"varint,16,opt,name=plot_memory_mb,json=plotMemoryMb,proto3" json:"plot_memory_mb"`
+	PlotTempDir          string   `protobuf:"bytes,17,opt,name=plot_temp_dir,json=plotTempDir,proto3" json:"plot_temp_dir"`
+	MassdbType           string   `protobuf:"bytes,18,opt,name=massdb_type,json=massdbType,proto3" json:"massdb_type"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -318,7 +412,7 @@ func (m *MinerConfig) Reset()         { *m = MinerConfig{} }
 func (m *MinerConfig) String() string { return proto.CompactTextString(m) }
 func (*MinerConfig) ProtoMessage()    {}
 func (*MinerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{5}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{6}
 }
 func (m *MinerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MinerConfig.Unmarshal(m, b)
@@ -408,6 +502,62 @@ func (m *MinerConfig) GetPrivatePassword() string {
 	return ""
 }
 
+func (m *MinerConfig) GetTemplateStrategy() string {
+	if m != nil {
+		return m.TemplateStrategy
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetAutoMine() bool {
+	if m != nil {
+		return m.AutoMine
+	}
+	return false
+}
+
+func (m *MinerConfig) GetPoolPubKey() []string {
+	if m != nil {
+		return m.PoolPubKey
+	}
+	return nil
+}
+
+func (m *MinerConfig) GetPoolSigner() string {
+	if m != nil {
+		return m.PoolSigner
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetPlotPerDisk() uint32 {
+	if m != nil {
+		return m.PlotPerDisk
+	}
+	return 0
+}
+
+func (m *MinerConfig) GetPlotMemoryMb() uint64 {
+	if m != nil {
+		return m.PlotMemoryMb
+	}
+	return 0
+}
+
+func (m *MinerConfig) GetPlotTempDir() string {
+	if m != nil {
+		return m.PlotTempDir
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetMassdbType() string {
+	if m != nil {
+		return m.MassdbType
+	}
+	return ""
+}
+
 type P2PConfig struct {
 	Seeds                string   `protobuf:"bytes,1,opt,name=seeds,proto3" json:"seeds"`
 	AddPeer              []string `protobuf:"bytes,2,rep,name=add_peer,json=addPeer,proto3" json:"add_peer"`
@@ -425,7 +575,7 @@ func (m *P2PConfig) Reset()         { *m = P2PConfig{} }
 func (m *P2PConfig) String() string { return proto.CompactTextString(m) }
 func (*P2PConfig) ProtoMessage()    {}
 func (*P2PConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{6}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{7}
 }
 func (m *P2PConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PConfig.Unmarshal(m, b)
@@ -495,20 +645,30 @@ func (m *P2PConfig) GetListenAddress() string {
 }
 
 type APIConfig struct {
-	APIPortGRPC          string   `protobuf:"bytes,1,opt,name=api_port_grpc,json=apiPortGRPC,proto3" json:"api_port_grpc"`
-	APIPortHttp          string   `protobuf:"bytes,2,opt,name=api_port_http,json=apiPortHttp,proto3" json:"api_port_http"`
-	APIWhitelist         []string `protobuf:"bytes,3,rep,name=api_whitelist,json=apiWhitelist,proto3" json:"api_whitelist"`
-	APIAllowedLan        []string `protobuf:"bytes,4,rep,name=api_allowed_lan,json=apiAllowedLan,proto3" json:"api_allowed_lan"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	APIPortGRPC           string   `protobuf:"bytes,1,opt,name=api_port_grpc,json=apiPortGRPC,proto3" json:"api_port_grpc"`
+	APIPortHttp           string   `protobuf:"bytes,2,opt,name=api_port_http,json=apiPortHttp,proto3" json:"api_port_http"`
+	APIWhitelist          []string `protobuf:"bytes,3,rep,name=api_whitelist,json=apiWhitelist,proto3" json:"api_whitelist"`
+	APIAllowedLan         []string `protobuf:"bytes,4,rep,name=api_allowed_lan,json=apiAllowedLan,proto3" json:"api_allowed_lan"`
+	APITLS                bool     `protobuf:"varint,5,opt,name=api_tls,json=apiTLS,proto3" json:"api_tls"`
+	APITLSCert            string   `protobuf:"bytes,6,opt,name=api_tls_cert,json=apiTLSCert,proto3" json:"api_tls_cert"`
+	APITLSKey             string   `protobuf:"bytes,7,opt,name=api_tls_key,json=apiTLSKey,proto3" json:"api_tls_key"`
+	APITokens             []string `protobuf:"bytes,8,rep,name=api_tokens,json=apiTokens,proto3" json:"api_tokens"`
+	APICORSOrigins        []string `protobuf:"bytes,9,rep,name=api_cors_origins,json=apiCORSOrigins,proto3" json:"api_cors_origins"`
+	APICORSMethods        []string `protobuf:"bytes,10,rep,name=api_cors_methods,json=apiCORSMethods,proto3" json:"api_cors_methods"`
+	APIRateLimit          float64  `protobuf:"fixed64,11,opt,name=api_rate_limit,json=apiRateLimit,proto3" json:"api_rate_limit"`
+	APIRateBurst          uint32   `protobuf:"varint,12,opt,name=api_rate_burst,json=apiRateBurst,proto3" json:"api_rate_burst"`
+	APIExpensiveRateLimit float64  `protobuf:"fixed64,13,opt,name=api_expensive_rate_limit,json=apiExpensiveRateLimit,proto3" json:"api_expensive_rate_limit"`
+	APIExpensiveRateBurst uint32   `protobuf:"varint,14,opt,name=api_expensive_rate_burst,json=apiExpensiveRateBurst,proto3" json:"api_expensive_rate_burst"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *APIConfig) Reset()         { *m = APIConfig{} }
 func (m *APIConfig) String() string { return proto.CompactTextString(m) }
 func (*APIConfig) ProtoMessage()    {}
 func (*APIConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{7}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{8}
 }
 func (m *APIConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_APIConfig.Unmarshal(m, b)
@@ -556,11 +716,82 @@ func (m *APIConfig) GetAPIAllowedLan() []string {
 	return nil
 }
 
+func (m *APIConfig) GetAPITLS() bool {
+	if m != nil {
+		return m.APITLS
+	}
+	return false
+}
+
+func (m *APIConfig) GetAPITLSCert() string {
+	if m != nil {
+		return m.APITLSCert
+	}
+	return ""
+}
+
+func (m *APIConfig) GetAPITLSKey() string {
+	if m != nil {
+		return m.APITLSKey
+	}
+	return ""
+}
+
+func (m *APIConfig) GetAPITokens() []string {
+	if m != nil {
+		return m.APITokens
+	}
+	return nil
+}
+
+func (m *APIConfig) GetAPICORSOrigins() []string {
+	if m != nil {
+		return m.APICORSOrigins
+	}
+	return nil
+}
+
+func (m *APIConfig) GetAPICORSMethods() []string {
+	if m != nil {
+		return m.APICORSMethods
+	}
+	return nil
+}
+
+func (m *APIConfig) GetAPIRateLimit() float64 {
+	if m != nil {
+		return m.APIRateLimit
+	}
+	return 0
+}
+
+func (m *APIConfig) GetAPIRateBurst() uint32 {
+	if m != nil {
+		return m.APIRateBurst
+	}
+	return 0
+}
+
+func (m *APIConfig) GetAPIExpensiveRateLimit() float64 {
+	if m != nil {
+		return m.APIExpensiveRateLimit
+	}
+	return 0
+}
+
+func (m *APIConfig) GetAPIExpensiveRateBurst() uint32 {
+	if m != nil {
+		return m.APIExpensiveRateBurst
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "configpb.Config")
 	proto.RegisterType((*AppConfig)(nil), "configpb.AppConfig")
 	proto.RegisterType((*NetworkConfig)(nil), "configpb.NetworkConfig")
 	proto.RegisterType((*DataConfig)(nil), "configpb.DataConfig")
+	proto.RegisterType((*ChainConfig)(nil), "configpb.ChainConfig")
 	proto.RegisterType((*LogConfig)(nil), "configpb.LogConfig")
 	proto.RegisterType((*MinerConfig)(nil), "configpb.MinerConfig")
 	proto.RegisterType((*P2PConfig)(nil), "configpb.P2PConfig")
@@ -570,52 +801,80 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 741 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xe1, 0x6e, 0x23, 0x35,
-	0x10, 0xc7, 0x95, 0xa4, 0x49, 0x76, 0x27, 0x0d, 0x77, 0xf8, 0x0e, 0xdd, 0xc2, 0xa9, 0xba, 0x63,
-	0xa1, 0x08, 0x54, 0xa9, 0x88, 0xf0, 0x02, 0x84, 0x56, 0x02, 0xa4, 0x14, 0x45, 0xa1, 0x88, 0x4f,
-	0x68, 0xe5, 0x5d, 0xbb, 0x1b, 0x2b, 0xce, 0x7a, 0xe4, 0x75, 0x1a, 0xf5, 0x01, 0x78, 0x0f, 0x1e,
-	0x0c, 0x9e, 0x81, 0x57, 0x40, 0x1e, 0xaf, 0x93, 0x14, 0xf1, 0x2d, 0xf3, 0x9f, 0x9f, 0x3d, 0x9e,
-	0xf9, 0xcf, 0x06, 0xce, 0x2b, 0xd3, 0x3c, 0xa8, 0xfa, 0x1a, 0xad, 0x71, 0x86, 0x25, 0x21, 0xc2,
-	0x32, 0xff, 0xab, 0x07, 0xa3, 0x1b, 0x0a, 0xd8, 0x25, 0x0c, 0x38, 0x62, 0xd6, 0x7b, 0xdf, 0xfb,
-	0x72, 0x32, 0x7b, 0x75, 0x1d, 0x91, 0xeb, 0x39, 0x62, 0x20, 0x56, 0x3e, 0xcf, 0xbe, 0x81, 0x71,
-	0x23, 0xdd, 0xde, 0xd8, 0x4d, 0xd6, 0x27, 0xf4, 0xcd, 0x11, 0xfd, 0x39, 0x24, 0x3a, 0x3c, 0x72,
-	0xec, 0x73, 0xe8, 0x8b, 0x32, 0x1b, 0x10, 0xfd, 0xfa, 0x48, 0xdf, 0x72, 0xc7, 0x3b, 0xb4, 0x2f,
-	0x4a, 0x5f, 0x5f, 0x9b, 0x3a, 0x3b, 0xfb, 0x6f, 0xfd, 0x85, 0xa9, 0x63, 0x7d, 0x6d, 0x6a, 0x76,
-	0x05, 0xc3, 0xad, 0x6a, 0xa4, 0xcd, 0x86, 0x04, 0x7e, 0x74, 0x04, 0xef, 0xbc, 0xdc, 0xa1, 0x81,
-	0xc9, 0xff, 0xe8, 0x41, 0x7a, 0x78, 0x3f, 0xcb, 0x60, 0x8c, 0xd6, 0x3c, 0x28, 0x2d, 0xa9, 0xcb,
-	0x74, 0x15, 0x43, 0xf6, 0x0e, 0x26, 0x15, 0xee, 0x8a, 0x98, 0xed, 0x53, 0x16, 0x2a, 0xdc, 0x2d,
-	0x3b, 0xe0, 0x53, 0x38, 0xc7, 0x5d, 0x59, 0x20, 0x6f, 0xdb, 0xbd, 0xb1, 0x82, 0x9a, 0x49, 0x57,
-	0x13, 0xdc, 0x95, 0xcb, 0x4e, 0x62, 0x9f, 0x40, 0xb2, 0x36, 0xad, 0x6b, 0xf8, 0x56, 0x52, 0x13,
-	0xe9, 0xea, 0x10, 0xe7, 0xbf, 0xc3, 0xf4, 0xd9, 0x6c, 0x7c, 0xb3, 0x38, 0xfb, 0x9f, 0x61, 0x2f,
-	0x67, 0xcb, 0xd8, 0x2c, 0xce, 0x30, 0x78, 0xa2, 0xba, 0x41, 0x9f, 0x7a, 0xb2, 0xfc, 0xe9, 0xe8,
-	0x89, 0xca, 0xbf, 0x03, 0x38, 0x0e, 0x93, 0x7d, 0x0c, 0x89, 0xe0, 0x8e, 0x17, 0x42, 0xd9, 0xd8,
-	0xa7, 0x8f, 0x6f, 0x95, 0x65, 0x6f, 0x60, 0x2c, 0xca, 0xc2, 0x3d, 0x61, 0xec, 0x71, 0x24, 0xca,
-	0xfb, 0x27, 0x94, 0xf9, 0x1a, 0xd2, 0xc3, 0x9c, 0x3d, 0xa5, 0x4d, 0x7d, 0x72, 0x7e, 0xa4, 0x4d,
-	0xed, 0x8f, 0xbf, 0x85, 0xd4, 0x27, 0xb4, 0x7c, 0x94, 0xba, 0xbb, 0x20, 0xd1, 0xa6, 0x5e, 0xf8,
-	0x98, 0x5d, 0xc2, 0x07, 0x42, 0xb5, 0xbc, 0xd4, 0xb2, 0xa8, 0xd0, 0xaa, 0xc6, 0xd1, 0x90, 0x92,
-	0xd5, 0xb4, 0x53, 0x6f, 0x48, 0xcc, 0xff, 0xee, 0xc3, 0xe4, 0xc4, 0x29, 0xf6, 0x15, 0xbc, 0x44,
-	0x53, 0x91, 0x5d, 0x45, 0xc9, 0xab, 0x8d, 0x6c, 0x44, 0x57, 0xf5, 0x45, 0xd4, 0xbf, 0x0f, 0x32,
-	0xfb, 0x1a, 0x5e, 0xb5, 0xc8, 0x2b, 0xb9, 0x91, 0x12, 0x4f, 0xe8, 0xf0, 0x10, 0x76, 0x92, 0x8a,
-	0x07, 0xde, 0x42, 0x1a, 0x2e, 0xf6, 0xad, 0x04, 0xcb, 0x12, 0x12, 0x7c, 0x33, 0xef, 0x60, 0xb2,
-	0x55, 0x8d, 0x6a, 0xea, 0x82, 0x0b, 0x61, 0xb3, 0xb3, 0xf7, 0x03, 0xef, 0x79, 0x90, 0xe6, 0x42,
-	0x58, 0x6f, 0x68, 0x2d, 0x1b, 0x69, 0xb9, 0x93, 0xb4, 0x6c, 0xc9, 0xea, 0x10, 0xb3, 0x0b, 0x00,
-	0xae, 0xb5, 0xd9, 0x17, 0xad, 0xd1, 0x26, 0x1b, 0x51, 0x36, 0x25, 0xe5, 0x17, 0xa3, 0x8d, 0x2f,
-	0x8c, 0xd6, 0x98, 0x07, 0x2a, 0x3c, 0xa6, 0x9b, 0x13, 0x12, 0x7c, 0xe1, 0x0b, 0x80, 0x90, 0xd4,
-	0xaa, 0x75, 0x59, 0x42, 0xcf, 0x0a, 0xf8, 0x42, 0xb5, 0x8e, 0x31, 0x38, 0x43, 0x6d, 0x5c, 0x96,
-	0xd2, 0xa5, 0xf4, 0x9b, 0x86, 0x64, 0xd5, 0x23, 0x77, 0xf2, 0xb8, 0x82, 0xd0, 0x0d, 0x29, 0xe8,
-	0x71, 0x0d, 0xf3, 0x7f, 0x7a, 0x90, 0x1e, 0xb6, 0x88, 0xbd, 0x86, 0x61, 0x2b, 0xa5, 0x68, 0xbb,
-	0x91, 0x86, 0xc0, 0x6f, 0x08, 0x17, 0xa2, 0x40, 0x29, 0x6d, 0xd6, 0xa7, 0xd7, 0x8d, 0xb9, 0x10,
-	0x4b, 0x29, 0xc9, 0xe2, 0x76, 0xa3, 0xb0, 0xd8, 0x61, 0x83, 0x9d, 0x81, 0x89, 0x17, 0x7e, 0xc5,
-	0x06, 0xd9, 0x15, 0x7c, 0xb8, 0xe6, 0x8d, 0x68, 0xd7, 0x7c, 0x23, 0x0b, 0xa7, 0xb6, 0xd2, 0xec,
-	0x1c, 0xed, 0xfa, 0x74, 0xf5, 0xf2, 0x90, 0xb8, 0x0f, 0xba, 0xff, 0x64, 0x84, 0xe2, 0xfa, 0xc0,
-	0x0d, 0x89, 0x9b, 0x78, 0x2d, 0x22, 0x17, 0x00, 0x8f, 0x7c, 0xa7, 0x5d, 0xb1, 0x35, 0x42, 0xc6,
-	0x29, 0x92, 0x72, 0x67, 0x84, 0xf4, 0x1b, 0xe5, 0x47, 0x24, 0x1b, 0x72, 0x48, 0xb6, 0x6d, 0x36,
-	0xa6, 0x2e, 0xa6, 0x41, 0x9d, 0x07, 0x31, 0xff, 0xd3, 0x7f, 0xe4, 0xf1, 0x83, 0x60, 0x39, 0x4c,
-	0x39, 0xaa, 0x02, 0x8d, 0x75, 0x45, 0x6d, 0xb1, 0xea, 0x3a, 0x9f, 0x70, 0x54, 0x4b, 0x63, 0xdd,
-	0x0f, 0x16, 0xab, 0x67, 0xcc, 0xda, 0x39, 0xec, 0x56, 0x28, 0x32, 0x3f, 0x3a, 0x87, 0xec, 0xb3,
-	0xc0, 0xec, 0xd7, 0xca, 0x49, 0x32, 0x6a, 0x40, 0x83, 0x3a, 0xe7, 0xa8, 0x7e, 0x8b, 0x1a, 0xfb,
-	0x02, 0x5e, 0x78, 0x88, 0x8c, 0x97, 0xa2, 0xd0, 0xbc, 0xe9, 0xf6, 0xc8, 0x9f, 0x9d, 0x07, 0x75,
-	0xc1, 0x9b, 0x72, 0x44, 0xff, 0xbb, 0xdf, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x04, 0x5e,
-	0xd2, 0x87, 0x05, 0x00, 0x00,
+	// 1186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x96, 0x51, 0x6f, 0x1c, 0x35,
+	0x10, 0xc7, 0x75, 0x97, 0x34, 0xb9, 0xf5, 0xdd, 0x25, 0xa9, 0xdb, 0xaa, 0x0b, 0x55, 0xdb, 0x70,
+	0xb4, 0xa8, 0x50, 0xa9, 0x88, 0xf0, 0xc0, 0x73, 0x9a, 0x22, 0x40, 0x24, 0x70, 0xda, 0x04, 0x2a,
+	0x21, 0x21, 0xcb, 0xbb, 0x3b, 0xdd, 0xb3, 0xce, 0xbb, 0xb6, 0x6c, 0x5f, 0x42, 0x2a, 0xf1, 0xc8,
+	0x03, 0xdf, 0x85, 0x0f, 0xc1, 0x37, 0xe2, 0x99, 0x37, 0x34, 0xe3, 0xdd, 0xbd, 0x4b, 0xe9, 0xdb,
+	0xf9, 0x3f, 0xbf, 0xb5, 0x3d, 0xff, 0x99, 0xb1, 0x8e, 0x4d, 0x0a, 0xd3, 0xbc, 0x51, 0xd5, 0x0b,
+	0xeb, 0x4c, 0x30, 0x7c, 0x14, 0x57, 0x36, 0x9f, 0xfd, 0x39, 0x64, 0x3b, 0x27, 0xb4, 0xe0, 0x4f,
+	0xd9, 0x96, 0xb4, 0x36, 0x1d, 0x1c, 0x0e, 0x9e, 0x8d, 0x8f, 0xee, 0xbc, 0xe8, 0x90, 0x17, 0xc7,
+	0xd6, 0x46, 0x22, 0xc3, 0x38, 0xff, 0x82, 0xed, 0x36, 0x10, 0xae, 0x8c, 0x5b, 0xa6, 0x43, 0x42,
+	0xef, 0xaf, 0xd1, 0x1f, 0x62, 0xa0, 0xc5, 0x3b, 0x8e, 0x3f, 0x61, 0xc3, 0x32, 0x4f, 0xb7, 0x88,
+	0xbe, 0xbb, 0xa6, 0x5f, 0xc9, 0x20, 0x5b, 0x74, 0x58, 0xe6, 0x78, 0xbe, 0x36, 0x55, 0xba, 0xfd,
+	0xee, 0xf9, 0xa7, 0xa6, 0xea, 0xce, 0xd7, 0xa6, 0xe2, 0xcf, 0xd9, 0xad, 0x5a, 0x35, 0xe0, 0xd2,
+	0x5b, 0x04, 0xde, 0x5b, 0x83, 0x67, 0x28, 0xb7, 0x68, 0x64, 0x10, 0x2e, 0x16, 0x52, 0x35, 0xe9,
+	0xce, 0xbb, 0xf0, 0x09, 0xca, 0x1d, 0x4c, 0xcc, 0xec, 0x8f, 0x01, 0x4b, 0xfa, 0x64, 0x79, 0xca,
+	0x76, 0xad, 0x33, 0x6f, 0x94, 0x06, 0xb2, 0x24, 0xc9, 0xba, 0x25, 0x7f, 0xcc, 0xc6, 0x85, 0x5d,
+	0x89, 0x2e, 0x3a, 0xa4, 0x28, 0x2b, 0xec, 0x6a, 0xde, 0x02, 0x1f, 0xb1, 0x89, 0x5d, 0xe5, 0xc2,
+	0x4a, 0xef, 0xaf, 0x8c, 0x2b, 0x29, 0xf3, 0x24, 0x1b, 0xdb, 0x55, 0x3e, 0x6f, 0x25, 0xfe, 0x21,
+	0x1b, 0x2d, 0x8c, 0x0f, 0x8d, 0xac, 0x81, 0x32, 0x4e, 0xb2, 0x7e, 0x3d, 0xfb, 0x95, 0x4d, 0x6f,
+	0x18, 0x89, 0xce, 0xd8, 0xa3, 0xf7, 0x54, 0x66, 0x7e, 0x34, 0xef, 0x9c, 0xb1, 0x47, 0x36, 0x16,
+	0x50, 0xa5, 0xc3, 0x77, 0xb1, 0xe3, 0xf9, 0x77, 0xeb, 0x02, 0xaa, 0xd9, 0xdf, 0x03, 0xc6, 0xd6,
+	0xd6, 0xf3, 0x0f, 0xd8, 0xa8, 0x94, 0x41, 0x8a, 0x52, 0xb9, 0x2e, 0x51, 0x5c, 0xbf, 0x52, 0x8e,
+	0xdf, 0x67, 0xbb, 0x65, 0x2e, 0xc2, 0xb5, 0xed, 0x92, 0xdc, 0x29, 0xf3, 0x8b, 0x6b, 0x0b, 0xfc,
+	0x11, 0x63, 0x85, 0xa9, 0xad, 0x2c, 0x82, 0x71, 0x9e, 0xd2, 0x9b, 0x66, 0x1b, 0x0a, 0x3f, 0x62,
+	0xf7, 0x34, 0x5c, 0x82, 0x16, 0x5e, 0xbd, 0x05, 0x51, 0xaf, 0x74, 0x50, 0x56, 0x2b, 0x70, 0x94,
+	0xea, 0x34, 0xbb, 0x43, 0xc1, 0x73, 0xf5, 0x16, 0xce, 0xfa, 0x10, 0xff, 0x8c, 0xdd, 0x8e, 0xdf,
+	0xbc, 0x05, 0x67, 0x44, 0x90, 0xb9, 0x06, 0x4f, 0x35, 0x9e, 0x66, 0xfb, 0x14, 0xf8, 0x05, 0x9c,
+	0xb9, 0x20, 0x79, 0xf6, 0x3b, 0x1b, 0x6f, 0xd4, 0x8f, 0x3f, 0x65, 0x7b, 0xbe, 0x70, 0xca, 0x06,
+	0x81, 0xa6, 0x81, 0xf3, 0x94, 0xc8, 0x34, 0x9b, 0x46, 0xf5, 0x75, 0x14, 0xf9, 0x13, 0xb6, 0xe7,
+	0x55, 0x25, 0x0a, 0x59, 0x2c, 0x80, 0x6e, 0x46, 0x59, 0x4d, 0xb3, 0x89, 0x57, 0xd5, 0x09, 0x8a,
+	0x78, 0x23, 0x2c, 0x9e, 0xf4, 0x7e, 0x55, 0x83, 0xb8, 0x94, 0x5a, 0xf5, 0xc5, 0x8b, 0xda, 0xcf,
+	0x28, 0xcd, 0x16, 0x2c, 0xe9, 0x9b, 0x12, 0x4d, 0xd2, 0xa6, 0xda, 0xb0, 0x6f, 0x47, 0x9b, 0x0a,
+	0xdd, 0x7b, 0xc0, 0x12, 0x0c, 0xd0, 0xdd, 0x5b, 0xff, 0x46, 0xda, 0x54, 0xa7, 0xb8, 0xc6, 0x2b,
+	0x97, 0xca, 0x63, 0x36, 0xa2, 0xb0, 0x4e, 0x35, 0x81, 0xce, 0x19, 0x65, 0xd3, 0x56, 0x3d, 0x21,
+	0x71, 0xf6, 0xef, 0x36, 0x1b, 0x6f, 0xb4, 0x35, 0xff, 0x94, 0x1d, 0x58, 0x53, 0x50, 0x6f, 0x8b,
+	0x5c, 0x16, 0x4b, 0x68, 0xca, 0xf6, 0xd4, 0xfd, 0x4e, 0x7f, 0x19, 0x65, 0xfe, 0x39, 0xbb, 0xe3,
+	0xad, 0x2c, 0x60, 0x09, 0x60, 0x37, 0xe8, 0x78, 0x11, 0xbe, 0x11, 0xea, 0x3e, 0x78, 0xc0, 0x92,
+	0xb8, 0x31, 0xa6, 0x12, 0xb3, 0x1e, 0x91, 0x80, 0xc9, 0x3c, 0x66, 0xe3, 0x5a, 0x35, 0xaa, 0xa9,
+	0x84, 0x2c, 0x4b, 0xac, 0xe3, 0x16, 0xf6, 0x7c, 0x94, 0x8e, 0xcb, 0xd2, 0x61, 0x43, 0x57, 0xd0,
+	0x80, 0x93, 0x01, 0xa8, 0x6a, 0xa3, 0xac, 0x5f, 0xf3, 0x87, 0x8c, 0x49, 0xad, 0xcd, 0x95, 0xf0,
+	0x46, 0x1b, 0x1a, 0xc5, 0x51, 0x96, 0x90, 0x72, 0x6e, 0xb4, 0xc1, 0x83, 0xad, 0x33, 0xe6, 0x0d,
+	0x1d, 0xbc, 0x4b, 0x3b, 0x8f, 0x48, 0xc0, 0x83, 0x1f, 0x32, 0x16, 0x83, 0x5a, 0xf9, 0x90, 0x8e,
+	0xe8, 0x5a, 0x11, 0x3f, 0x55, 0x3e, 0x70, 0xce, 0xb6, 0xad, 0x36, 0x21, 0x4d, 0x68, 0x53, 0xfa,
+	0x4d, 0x26, 0x39, 0x75, 0x29, 0x03, 0xac, 0x47, 0x90, 0xb5, 0x26, 0x45, 0xbd, 0x1f, 0xc3, 0xe7,
+	0xec, 0x76, 0x80, 0xda, 0x6a, 0x64, 0x7d, 0xc0, 0xcb, 0x56, 0xd7, 0xe9, 0x98, 0xd8, 0x83, 0x2e,
+	0x70, 0xde, 0xea, 0x78, 0x4f, 0xb9, 0x0a, 0x46, 0xa0, 0x29, 0xe9, 0x24, 0xe6, 0x88, 0x02, 0x16,
+	0x88, 0x1f, 0xb2, 0x89, 0x35, 0x46, 0x0b, 0x1c, 0xfc, 0x25, 0x5c, 0xa7, 0xd3, 0xe8, 0x10, 0x6a,
+	0xf3, 0x55, 0xfe, 0x3d, 0x5c, 0xa3, 0x85, 0x44, 0x78, 0x55, 0xe1, 0xf3, 0xb5, 0x17, 0x9f, 0x0d,
+	0x94, 0xce, 0x49, 0xe1, 0x33, 0x36, 0xc5, 0xfb, 0x0b, 0x4b, 0x35, 0xf0, 0xcb, 0x74, 0x9f, 0xda,
+	0x73, 0x8c, 0xe2, 0x1c, 0xcb, 0xe0, 0xf1, 0x29, 0xdd, 0x23, 0xa6, 0x86, 0xda, 0xb8, 0x6b, 0x51,
+	0xe7, 0xe9, 0xc1, 0xe1, 0xe0, 0xd9, 0x76, 0x36, 0x41, 0xf5, 0x8c, 0xc4, 0xb3, 0xbc, 0xdf, 0x09,
+	0x53, 0x20, 0x57, 0x6f, 0xb7, 0x2f, 0x90, 0x36, 0xe1, 0x02, 0x6a, 0xdb, 0x55, 0x54, 0x7a, 0xdf,
+	0x0d, 0x38, 0x8f, 0xd7, 0x89, 0x12, 0x0e, 0xf9, 0xec, 0x9f, 0x01, 0x4b, 0xfa, 0x17, 0x86, 0xdf,
+	0x65, 0xb7, 0x3c, 0x40, 0xe9, 0xdb, 0x76, 0x8b, 0x0b, 0x7c, 0x3c, 0x64, 0x59, 0x0a, 0x0b, 0xe0,
+	0xd2, 0x21, 0x65, 0xbc, 0x2b, 0xcb, 0x72, 0x0e, 0x40, 0xed, 0xef, 0x97, 0xca, 0x8a, 0x95, 0x6d,
+	0x6c, 0xdb, 0xdc, 0x23, 0x14, 0x7e, 0xb2, 0x8d, 0x45, 0xdf, 0x17, 0xb2, 0x29, 0xfd, 0x42, 0x2e,
+	0x41, 0x04, 0x55, 0x83, 0x59, 0x85, 0xf6, 0x71, 0x38, 0xe8, 0x03, 0x17, 0x51, 0xc7, 0x89, 0x2c,
+	0x95, 0xd4, 0x3d, 0x17, 0x1f, 0x85, 0x31, 0x6a, 0x1d, 0xf2, 0x90, 0xb1, 0x4b, 0xb9, 0xd2, 0x41,
+	0xd4, 0xa6, 0x84, 0xae, 0xc3, 0x48, 0x39, 0x33, 0x25, 0xe0, 0xb4, 0x61, 0xfb, 0x40, 0x43, 0xdd,
+	0x0b, 0xde, 0xa7, 0xbb, 0x94, 0xc5, 0x34, 0xaa, 0xc7, 0x51, 0x9c, 0xfd, 0xb5, 0xcd, 0x92, 0xfe,
+	0xb1, 0x44, 0x13, 0xa5, 0x55, 0xc2, 0x1a, 0x17, 0x44, 0xe5, 0x6c, 0xd1, 0x66, 0x3e, 0x96, 0x56,
+	0xcd, 0x8d, 0x0b, 0xdf, 0x38, 0x5b, 0xdc, 0x60, 0x16, 0x21, 0xd8, 0x74, 0x78, 0x83, 0xf9, 0x36,
+	0x04, 0xcb, 0x3f, 0x8e, 0xcc, 0xd5, 0x42, 0x05, 0xa0, 0x26, 0xde, 0x22, 0xa3, 0x26, 0xd2, 0xaa,
+	0xd7, 0x9d, 0xc6, 0x3f, 0x61, 0xfb, 0x08, 0xd1, 0x50, 0x40, 0x29, 0xb4, 0x6c, 0xda, 0x19, 0xc3,
+	0x6f, 0x8f, 0xa3, 0x7a, 0x2a, 0x1b, 0x7c, 0x6d, 0x90, 0x0b, 0xda, 0xb7, 0x53, 0xb6, 0x23, 0xad,
+	0xba, 0xd0, 0x1e, 0xfb, 0xaf, 0x0d, 0x88, 0x02, 0x5c, 0x20, 0x0f, 0x92, 0x8c, 0xc5, 0xe8, 0x09,
+	0xb8, 0xc0, 0x1f, 0xb1, 0x71, 0x47, 0x60, 0x83, 0x46, 0x07, 0x92, 0x08, 0x60, 0x7f, 0xe2, 0x94,
+	0x62, 0xdc, 0x2c, 0xa1, 0xf1, 0xe9, 0xe8, 0x70, 0xab, 0x0b, 0x93, 0xc0, 0x9f, 0xb1, 0x03, 0x0c,
+	0x17, 0xc6, 0x79, 0x61, 0x9c, 0xaa, 0x54, 0xe3, 0xd3, 0x84, 0xa0, 0x3d, 0x69, 0xd5, 0x89, 0x71,
+	0xfe, 0xc7, 0xa8, 0xde, 0x20, 0x6b, 0x08, 0x0b, 0x53, 0xfa, 0x94, 0xdd, 0x20, 0xcf, 0xa2, 0x8a,
+	0xdd, 0x8c, 0x24, 0xce, 0x97, 0xd0, 0xaa, 0x56, 0x81, 0x66, 0x6f, 0x40, 0xde, 0x64, 0x32, 0xc0,
+	0x29, 0x6a, 0x37, 0xa8, 0x7c, 0xe5, 0x7c, 0xa0, 0xe1, 0x9b, 0xf6, 0xd4, 0x4b, 0xd4, 0xf8, 0x57,
+	0x2c, 0x45, 0x0a, 0x7e, 0xb3, 0xd0, 0x78, 0x75, 0x09, 0x9b, 0xbb, 0x4e, 0x69, 0xd7, 0x7b, 0xd2,
+	0xaa, 0xaf, 0xbb, 0xf0, 0x7a, 0xfb, 0xf7, 0x7f, 0x18, 0x0f, 0xda, 0xa3, 0x83, 0xfe, 0xf7, 0x21,
+	0x9d, 0x98, 0xef, 0xd0, 0x9f, 0xa9, 0x2f, 0xff, 0x1b, 0x00, 0x91, 0xcc, 0x86, 0x20, 0x5c, 0x09,
+	0x00, 0x00,
 }
@@ -0,0 +1,152 @@
+package difficulty
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"time"
+
+	"massnet.org/mass/poc"
+	"massnet.org/mass/wire"
+)
+
+// ProofDensity is the fraction of challenges that a plotted space has a proof for,
+// which is measured on plotted massdb.
+const ProofDensity = 0.33
+
+var (
+	ErrEstimateTooFewHeaders   = errors.New("at least 2 headers are required for estimation")
+	ErrEstimateInvalidSequence = errors.New("headers are not in sequence")
+)
+
+// SpaceEstimate is the estimated space of the whole network.
+//
+// QualitySpace is the space counted as in Proof.GetQuality, which is the sum of
+// (1 << BitLength) * BitLength for all spaces. For a set of spaces with QualitySpace W,
+// the best quality in a slot exceeds target T with the probability of
+//
+//	1 - exp(-ln2 * ProofDensity * W / T),
+//
+// since Quality = Q1 / -log2(U), where Q1 = (1 << BitLength) * BitLength and U is uniform in (0, 1).
+type SpaceEstimate struct {
+	StartHeight  uint64
+	EndHeight    uint64
+	Slots        uint64   // slots elapsed from the block before StartHeight to EndHeight
+	Target       *big.Int // target of the last header
+	QualitySpace float64
+	Bytes        float64 // disk size by bitLengths of proofs in headers
+}
+
+// QualitySpace returns the QualitySpace of a space with bitLength.
+func QualitySpace(bitLength int) float64 {
+	return float64(uint64(1)<<uint(bitLength)) * float64(bitLength)
+}
+
+// SlotWinProbability returns the probability for spaces of qualitySpace to find
+// a proof with quality exceeding target in one slot.
+func SlotWinProbability(qualitySpace float64, target *big.Int) float64 {
+	t, _ := new(big.Float).SetInt(target).Float64()
+	if t <= 0 {
+		return 1
+	}
+	return -math.Expm1(-math.Ln2 * ProofDensity * qualitySpace / t)
+}
+
+// ExpectedTimeToWin returns the expected time for spaces of qualitySpace to find
+// a proof with quality exceeding target, without considering other miners.
+func ExpectedTimeToWin(qualitySpace float64, target *big.Int) time.Duration {
+	p := SlotWinProbability(qualitySpace, target)
+	if p <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+	seconds := poc.PoCSlot / p
+	if seconds >= float64(math.MaxInt64)/float64(time.Second) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// EstimateNetworkSpace estimates the space of the whole network from consecutive headers
+// in increasing height. Targets and slot gaps of headers[1:] are taken as samples.
+//
+// Gap of slots between a block and its parent is geometric distributed with the slot win
+// probability of the network, the QualitySpace is the maximum likelihood estimation of it.
+// Proofs in headers are sampled by QualitySpace from all spaces, so the disk size is
+// estimated by the average disk size per QualitySpace of their bitLengths.
+func EstimateNetworkSpace(headers []*wire.BlockHeader) (*SpaceEstimate, error) {
+	if len(headers) < 2 {
+		return nil, ErrEstimateTooFewHeaders
+	}
+
+	var gaps = make([]float64, 0, len(headers)-1)
+	var coefs = make([]float64, 0, len(headers)-1) // ln2 * ProofDensity / T
+	var bytesPerSpace float64
+	var slots uint64
+	for i := 1; i < len(headers); i++ {
+		header, parent := headers[i], headers[i-1]
+		if header.Height != parent.Height+1 {
+			return nil, ErrEstimateInvalidSequence
+		}
+		gap := header.Timestamp.Unix()/poc.PoCSlot - parent.Timestamp.Unix()/poc.PoCSlot
+		if gap < 1 {
+			gap = 1
+		}
+		t, _ := new(big.Float).SetInt(header.Target).Float64()
+		if t <= 0 {
+			continue
+		}
+		slots += uint64(gap)
+		gaps = append(gaps, float64(gap))
+		coefs = append(coefs, math.Ln2*ProofDensity/t)
+		bl := header.Proof.BitLength
+		bytesPerSpace += float64(poc.BitLengthDiskSize[bl]) / QualitySpace(bl)
+	}
+
+	estimate := &SpaceEstimate{
+		StartHeight: headers[1].Height,
+		EndHeight:   headers[len(headers)-1].Height,
+		Slots:       slots,
+		Target:      headers[len(headers)-1].Target,
+	}
+	if len(gaps) == 0 {
+		return estimate, nil
+	}
+	estimate.QualitySpace = maxLikelihoodSpace(gaps, coefs)
+	estimate.Bytes = estimate.QualitySpace * bytesPerSpace / float64(len(gaps))
+	return estimate, nil
+}
+
+// maxLikelihoodSpace solves W from the derivative of log likelihood
+//
+//	sum(-(g - 1) * c + c / (exp(c * W) - 1)) = 0
+//
+// by bisection, the left side decreases with W.
+func maxLikelihoodSpace(gaps, coefs []float64) float64 {
+	var derivative = func(w float64) float64 {
+		var d float64
+		for i, g := range gaps {
+			c := coefs[i]
+			d += -(g-1)*c + c/math.Expm1(c*w)
+		}
+		return d
+	}
+
+	// find the upper bound, likelihood keeps increasing with W
+	// if all blocks are found in the next slot of their parents
+	var lo, hi = 0.0, 1.0
+	for derivative(hi) > 0 {
+		lo, hi = hi, hi*2
+		if math.IsInf(hi, 0) {
+			return lo
+		}
+	}
+	for i := 0; i < 200 && hi-lo > hi*1e-12; i++ {
+		mid := (lo + hi) / 2
+		if derivative(mid) > 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
@@ -0,0 +1,77 @@
+package difficulty_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"massnet.org/mass/consensus/difficulty"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/wire"
+)
+
+// newEstimateHeaders generates headers with slot gaps sampled by the slot win probability of qualitySpace.
+func newEstimateHeaders(count int, qualitySpace float64, target *big.Int, bitLength int) []*wire.BlockHeader {
+	rnd := rand.New(rand.NewSource(1))
+	p := difficulty.SlotWinProbability(qualitySpace, target)
+	headers := make([]*wire.BlockHeader, count)
+	slot := int64(500000000)
+	for i := range headers {
+		headers[i] = &wire.BlockHeader{
+			Height:    uint64(1000 + i),
+			Timestamp: time.Unix(slot*poc.PoCSlot, 0),
+			Target:    target,
+			Proof:     &poc.Proof{BitLength: bitLength},
+		}
+		gap := int64(1)
+		for rnd.Float64() >= p {
+			gap++
+		}
+		slot += gap
+	}
+	return headers
+}
+
+func TestEstimateNetworkSpace(t *testing.T) {
+	target := big.NewInt(1e12)
+	for _, expected := range []float64{1e11, 1e12, 4e12} {
+		estimate, err := difficulty.EstimateNetworkSpace(newEstimateHeaders(5000, expected, target, 32))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(estimate.QualitySpace-expected)/expected > 0.1 {
+			t.Errorf("expected quality space %g, got %g", expected, estimate.QualitySpace)
+		}
+		expectedBytes := estimate.QualitySpace * float64(poc.BitLengthDiskSize[32]) / difficulty.QualitySpace(32)
+		if math.Abs(estimate.Bytes-expectedBytes) > 1 {
+			t.Errorf("expected bytes %g, got %g", expectedBytes, estimate.Bytes)
+		}
+		if estimate.StartHeight != 1001 || estimate.EndHeight != 5999 {
+			t.Errorf("unexpected heights %d - %d", estimate.StartHeight, estimate.EndHeight)
+		}
+	}
+
+	if _, err := difficulty.EstimateNetworkSpace(newEstimateHeaders(1, 1e12, target, 32)); err != difficulty.ErrEstimateTooFewHeaders {
+		t.Errorf("expected %v, got %v", difficulty.ErrEstimateTooFewHeaders, err)
+	}
+	headers := newEstimateHeaders(3, 1e12, target, 32)
+	headers[1], headers[2] = headers[2], headers[1]
+	if _, err := difficulty.EstimateNetworkSpace(headers); err != difficulty.ErrEstimateInvalidSequence {
+		t.Errorf("expected %v, got %v", difficulty.ErrEstimateInvalidSequence, err)
+	}
+}
+
+func TestExpectedTimeToWin(t *testing.T) {
+	target := big.NewInt(1e12)
+	// probability is close to ln2 * ProofDensity * W / T for small W
+	w := 1e9
+	expected := time.Duration(float64(poc.PoCSlot) / (math.Ln2 * difficulty.ProofDensity * w / 1e12) * float64(time.Second))
+	if actual := difficulty.ExpectedTimeToWin(w, target); math.Abs(float64(actual-expected))/float64(expected) > 0.01 {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+	if actual := difficulty.ExpectedTimeToWin(0, target); actual != time.Duration(math.MaxInt64) {
+		t.Errorf("expected max duration for no space, got %v", actual)
+	}
+}
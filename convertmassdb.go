@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"massnet.org/mass/logging"
+	massdb_v2 "massnet.org/mass/poc/engine/massdb/massdb.v2"
+)
+
+// massDBV1FileName matches HashMapB files of massdb.v1, which are converted by convertMassDB.
+var massDBV1FileName = regexp.MustCompile(`^\d+_[A-F0-9]{66}_\d{2}\.MASSDB$`)
+
+var errConvertMassDB = errors.New("failed to convert some massdb.v1 files")
+
+// convertMassDB converts every plotted massdb.v1 file in proofDirs into a massdb.v2 file
+// in the same dir, partially converted files are resumed. The massdb.v1 files are left
+// untouched, they could be removed once massdb_type is set to massdb.v2.
+func convertMassDB(proofDirs []string) error {
+	var converted, failed int
+	for _, dir := range proofDirs {
+		fis, err := ioutil.ReadDir(dir)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "fail to read proof dir", logging.LogFormat{"dir": dir, "err": err})
+			failed++
+			continue
+		}
+		for _, fi := range fis {
+			if fi.IsDir() || !massDBV1FileName.MatchString(strings.ToUpper(fi.Name())) {
+				continue
+			}
+			srcPath := filepath.Join(dir, fi.Name())
+			dstPath := strings.TrimSuffix(srcPath, filepath.Ext(srcPath)) + ".massdb2"
+			logging.CPrint(logging.INFO, "converting massdb", logging.LogFormat{"src": srcPath, "dst": dstPath})
+			switch err = massdb_v2.ConvertFromV1(srcPath, dstPath); err {
+			case nil:
+				converted++
+				fmt.Printf("converted %s to %s\n", srcPath, dstPath)
+			case massdb_v2.ErrSourceNotPlotted, massdb_v2.ErrSourceWrongType:
+				fmt.Printf("skipped %s, %v\n", srcPath, err)
+			default:
+				logging.CPrint(logging.ERROR, "fail to convert massdb", logging.LogFormat{"src": srcPath, "err": err})
+				fmt.Printf("failed to convert %s, %v\n", srcPath, err)
+				failed++
+			}
+		}
+	}
+
+	fmt.Printf("converted %d massdb.v1 files, %d failed\n", converted, failed)
+	if failed != 0 {
+		return errConvertMassDB
+	}
+	fmt.Println("set massdb_type to massdb.v2 to use converted files, massdb.v1 files could be removed then")
+	return nil
+}
@@ -29,6 +29,9 @@ var (
 	ErrInvalidBlockStorageMeta  = errors.New("invalid block storage meta")
 	ErrInvalidAddrIndexMeta     = errors.New("invalid addr index meta")
 	ErrDeleteNonNewestBlock     = errors.New("delete block that is not newest")
+	ErrUtxoSetChanged           = errors.New("utxo set changed during iteration")
+	ErrNotReindexing            = errors.New("database is not being reindexed")
+	ErrReadOnly                 = errors.New("database is read-only")
 )
 
 // Db defines a generic interface that is used to request and insert data into
@@ -139,6 +142,11 @@ type Db interface {
 
 	FetchHeightRange(startHeight, endHeight uint64) ([]wire.Hash, error)
 
+	// NewUtxoIterator returns an iterator over all unspent transaction
+	// outputs as of the most recent block at the time of invocation.
+	// The iterator must be released after use.
+	NewUtxoIterator() (UtxoIterator, error)
+
 	// NewestSha returns the hash and block height of the most recent (end)
 	// block of the block chain.  It will return the zero hash, -1 for
 	// the block height, and no error (nil) if there are not any blocks in
@@ -190,6 +198,33 @@ type Db interface {
 
 	FetchScriptHashRelatedBindingTx(scriptHash []byte, chainParams *config.Params) ([]*BindingTxReply, error)
 
+	// CheckIntegrity walks the main chain from genesis to the newest block,
+	// verifies the block index and indexes derived from raw blocks, and
+	// reports inconsistencies found.  If repair is true, derived indexes are
+	// rewritten to match raw blocks.
+	CheckIntegrity(repair bool) (*IntegrityReport, error)
+
+	// StartReindex drops all state derived from blocks while keeping raw
+	// blocks, and reconnects the genesis block.  The newest block is recorded
+	// as the reindex target, which stored blocks are to be reconnected up to
+	// before FinishReindex is called.  If reindexing is already in progress,
+	// it is resumed instead.
+	StartReindex() (err error)
+
+	// FetchReindexTarget returns the hash and block height of the reindex
+	// target, or ErrNotReindexing if the database is not being reindexed.
+	FetchReindexTarget() (sha *wire.Hash, height uint64, err error)
+
+	// FinishReindex marks reindexing as completed.
+	FinishReindex() (err error)
+
+	// Snapshot returns a read-only view of the database pinned to its
+	// current state, so that multiple reads from the view are consistent
+	// with each other while blocks are connected or disconnected.  Writes to
+	// the view fail with ErrReadOnly, and the view should be closed after
+	// use to release the snapshot.
+	Snapshot() (Db, error)
+
 	// For testing purpose
 	TestExportDbEntries() map[string][]byte
 
@@ -266,6 +301,60 @@ type UtxoReply struct {
 	Coinbase bool
 	Index    uint32
 	Value    massutil.Amount
+	PkScript []byte
+}
+
+// UtxoIterator iterates over unspent transaction outputs, ordered by
+// transaction hash and output index.
+type UtxoIterator interface {
+	// Tip returns the hash and height of the block the iterated set is as of.
+	Tip() (sha *wire.Hash, height uint64)
+
+	// Next moves the iterator to the next unspent output, it returns false
+	// if the iterator is exhausted or an error occurs.
+	Next() bool
+
+	// Utxo returns the current unspent output.
+	Utxo() *UtxoReply
+
+	// Error returns any accumulated error.
+	Error() error
+
+	// Release releases associated resources.
+	Release()
+}
+
+// Names of indexes verified by CheckIntegrity.
+const (
+	IndexBlock      = "block"
+	IndexTx         = "tx"
+	IndexStaking    = "staking"
+	IndexMinedBlock = "mined-block"
+	IndexPubkeyBl   = "pubkey-bl"
+	IndexAddr       = "addr"
+	IndexBinding    = "binding"
+)
+
+// Inconsistency describes a mismatch between an index and raw blocks.
+type Inconsistency struct {
+	Index  string
+	Detail string
+}
+
+// IntegrityReport is the result of CheckIntegrity.  Counts holds the number
+// of inconsistencies of each index, while Inconsistencies holds details of
+// the leading ones.
+type IntegrityReport struct {
+	Height          uint64
+	Hash            wire.Hash
+	Counts          map[string]int
+	Inconsistencies []*Inconsistency
+	Repaired        bool
+}
+
+// Consistent returns whether or not no inconsistency was found.
+func (r *IntegrityReport) Consistent() bool {
+	return len(r.Counts) == 0
 }
 
 // AddrIndexKeySize is the number of bytes used by keys into the BlockAddrIndex.
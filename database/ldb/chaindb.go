@@ -7,6 +7,7 @@ import (
 
 	"massnet.org/mass/database"
 	"massnet.org/mass/database/storage"
+	_ "massnet.org/mass/database/storage/bdgstorage"
 	_ "massnet.org/mass/database/storage/ldbstorage"
 	"massnet.org/mass/logging"
 	"massnet.org/mass/wire"
@@ -79,7 +80,7 @@ func init() {
 					return nil, storage.ErrInvalidArgument
 				}
 
-				stor, err := storage.CreateStorage(tp, dbpath, nil)
+				stor, err := storage.CreateStorage(tp, dbpath, args[1:]...)
 				if err != nil {
 					return nil, err
 				}
@@ -94,7 +95,7 @@ func init() {
 					return nil, storage.ErrInvalidArgument
 				}
 
-				stor, err := storage.OpenStorage(tp, dbpath, nil)
+				stor, err := storage.OpenStorage(tp, dbpath, args[1:]...)
 				if err != nil {
 					return nil, err
 				}
@@ -104,8 +105,8 @@ func init() {
 	}
 }
 
-func NewChainDb(stor storage.Storage) (*ChainDb, error) {
-	cdb := &ChainDb{
+func newChainDb(stor storage.Storage) *ChainDb {
+	return &ChainDb{
 		stor:                stor,
 		dbBatch:             stor.NewBatch(),
 		txUpdateMap:         make(map[wire.Hash]*txUpdateObj),
@@ -113,6 +114,10 @@ func NewChainDb(stor storage.Storage) (*ChainDb, error) {
 		stakingTxMap:        make(map[stakingTxMapKey]*stakingTx),
 		expiredStakingTxMap: make(map[stakingTxMapKey]*stakingTx),
 	}
+}
+
+func NewChainDb(stor storage.Storage) (*ChainDb, error) {
+	cdb := newChainDb(stor)
 
 	blockMeta, err := cdb.getBlockStorageMeta()
 	if err != nil {
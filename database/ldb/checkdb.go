@@ -0,0 +1,590 @@
+package ldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"golang.org/x/crypto/ripemd160"
+	"massnet.org/mass/database"
+	"massnet.org/mass/database/storage"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/txscript"
+	"massnet.org/mass/wire"
+)
+
+const (
+	// maxReportedInconsistencies is the maximum number of inconsistencies
+	// detailed in the report for each index.
+	maxReportedInconsistencies = 20
+
+	// repairBatchSize is the number of writes flushed at once on repair.
+	repairBatchSize = 10000
+
+	// checkBlockCacheSize is the number of recent raw blocks kept in memory
+	// to look up outputs spent by later blocks.
+	checkBlockCacheSize = 256
+)
+
+// derivedIndexes lists key prefixes of each index that could be rebuilt from
+// raw blocks, in the order they are verified.
+var derivedIndexes = []struct {
+	name     string
+	prefixes [][]byte
+}{
+	{database.IndexBlock, [][]byte{blockShaKeyPrefix}},
+	{database.IndexTx, [][]byte{recordSuffixTx, recordSuffixSpentTx}},
+	{database.IndexStaking, [][]byte{recordStakingTx, recordExpiredStakingTx}},
+	{database.IndexMinedBlock, [][]byte{minedBlockIndexPrefix}},
+	{database.IndexPubkeyBl, [][]byte{[]byte(pubkblKeyPrefix)}},
+	{database.IndexAddr, [][]byte{txIndexPrefix, shIndexPrefix}},
+	{database.IndexBinding, [][]byte{bindingTxIndexPrefix, bindingShIndexPrefix, bindingTxSpentIndexPrefix}},
+}
+
+// integrityChecker replays raw blocks of the main chain the way they were
+// submitted, and collects the entries index is expected to have.  Only the
+// state required by index is kept, e.g. outputs are tracked only for indexes
+// of transactions and addresses.
+type integrityChecker struct {
+	db       *ChainDb
+	report   *database.IntegrityReport
+	index    string
+	txs      map[wire.Hash]*txUpdateObj
+	spentTxs map[wire.Hash][]*spentTx
+	stakings []*stakingTx
+	pubkbls  map[string][]byte
+	expected map[string][]byte
+	blocks   map[uint64][]byte
+	repaired bool
+}
+
+func newIntegrityChecker(db *ChainDb, report *database.IntegrityReport, index string) *integrityChecker {
+	return &integrityChecker{
+		db:       db,
+		report:   report,
+		index:    index,
+		txs:      make(map[wire.Hash]*txUpdateObj),
+		spentTxs: make(map[wire.Hash][]*spentTx),
+		pubkbls:  make(map[string][]byte),
+		expected: make(map[string][]byte),
+		blocks:   make(map[uint64][]byte),
+	}
+}
+
+// CheckIntegrity walks the main chain from genesis to the newest block,
+// verifies the block index and indexes derived from raw blocks, and reports
+// inconsistencies found.  If repair is true, derived indexes are rewritten to
+// match raw blocks, while a broken block index is only reported.
+//
+// The main chain is replayed once for each index, so that only entries of a
+// single index are kept in memory at a time.  The whole database is locked
+// during the check, so it is expected to be run when the node is not
+// processing blocks.
+func (db *ChainDb) CheckIntegrity(repair bool) (*database.IntegrityReport, error) {
+	db.dbLock.Lock()
+	defer db.dbLock.Unlock()
+
+	report := &database.IntegrityReport{
+		Height: db.dbStorageMeta.currentHeight,
+		Hash:   db.dbStorageMeta.currentHash,
+		Counts: make(map[string]int),
+	}
+	if report.Height == UnknownHeight {
+		return report, nil
+	}
+
+	for _, idx := range derivedIndexes {
+		logging.CPrint(logging.INFO, "checking index", logging.LogFormat{"index": idx.name})
+		c := newIntegrityChecker(db, report, idx.name)
+		ok, err := c.replay()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// derived indexes are meaningless without a sound main chain
+			logging.CPrint(logging.ERROR, "block index is broken, skip checking derived indexes",
+				logging.LogFormat{"inconsistencies": report.Counts[database.IndexBlock]})
+			return report, nil
+		}
+		c.finish()
+		if err = c.compare(idx.prefixes, repair); err != nil {
+			return nil, err
+		}
+		report.Repaired = report.Repaired || c.repaired
+	}
+	return report, nil
+}
+
+// addInconsistency counts an inconsistency of index, and records its detail
+// unless enough have been recorded.
+func (c *integrityChecker) addInconsistency(index string, format string, args ...interface{}) {
+	c.report.Counts[index]++
+	if c.report.Counts[index] <= maxReportedInconsistencies {
+		c.report.Inconsistencies = append(c.report.Inconsistencies, &database.Inconsistency{
+			Index:  index,
+			Detail: fmt.Sprintf(format, args...),
+		})
+	}
+}
+
+// expect records an entry expected by index, entries of other indexes are ignored.
+func (c *integrityChecker) expect(index string, key, value []byte) {
+	if index == c.index {
+		c.expected[string(key)] = value
+	}
+}
+
+// tracksOutputs returns whether transaction outputs and their spending are
+// tracked for the index being checked.
+func (c *integrityChecker) tracksOutputs() bool {
+	return c.index == database.IndexTx || c.index == database.IndexAddr || c.index == database.IndexBinding
+}
+
+// replay walks blocks by height, and returns false if the chain itself is
+// broken.
+func (c *integrityChecker) replay() (bool, error) {
+	var prevSha *wire.Hash
+	for height := uint64(0); height <= c.report.Height; height++ {
+		sha, buf, err := c.db.getBlkByHeight(height)
+		if err != nil {
+			if err == storage.ErrNotFound {
+				c.addInconsistency(database.IndexBlock, "block of height %d not found", height)
+				return false, nil
+			}
+			return false, err
+		}
+		block, err := massutil.NewBlockFromBytes(buf, wire.DB)
+		if err != nil {
+			c.addInconsistency(database.IndexBlock, "block of height %d is undecodable: %v", height, err)
+			return false, nil
+		}
+		header := &block.MsgBlock().Header
+		if !block.Hash().IsEqual(sha) {
+			c.addInconsistency(database.IndexBlock, "block of height %d has hash %v, indexed as %v", height, block.Hash(), sha)
+			return false, nil
+		}
+		if header.Height != height {
+			c.addInconsistency(database.IndexBlock, "block %v is stored at height %d, but has height %d", sha, height, header.Height)
+			return false, nil
+		}
+		if prevSha != nil && !header.Previous.IsEqual(prevSha) {
+			c.addInconsistency(database.IndexBlock, "block %v of height %d does not connect to previous block %v", sha, height, prevSha)
+			return false, nil
+		}
+		if err = c.connect(block, buf); err != nil {
+			return false, err
+		}
+		prevSha = sha
+
+		if height%10000 == 0 && height > 0 {
+			logging.CPrint(logging.INFO, "replayed blocks", logging.LogFormat{"index": c.index, "height": height, "newest": c.report.Height})
+		}
+	}
+
+	if !prevSha.IsEqual(&c.report.Hash) {
+		c.addInconsistency(database.IndexBlock, "newest block is %v, but block of height %d is %v",
+			&c.report.Hash, c.report.Height, prevSha)
+		return false, nil
+	}
+	exists, err := c.db.stor.Has(makeBlockHeightKey(c.report.Height + 1))
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		c.addInconsistency(database.IndexBlock, "block of height %d exists above newest block", c.report.Height+1)
+	}
+	return true, nil
+}
+
+// connect collects index entries of block as submitBlock and the address
+// indexer do.
+func (c *integrityChecker) connect(block *massutil.Block, buf []byte) error {
+	msgBlock := block.MsgBlock()
+	height := msgBlock.Header.Height
+	c.blocks[height] = buf
+	delete(c.blocks, height-checkBlockCacheSize)
+
+	var lw [8]byte
+	binary.LittleEndian.PutUint64(lw[:], height)
+	c.expect(database.IndexBlock, makeBlockShaKey(block.Hash()), lw[:])
+
+	pubKey := msgBlock.Header.PubKey
+	c.expect(database.IndexMinedBlock, minedBlockIndexToKey(pubKey, height), blankData)
+
+	if c.index == database.IndexPubkeyBl {
+		pkKey := string(makePubkblKey(pubKey))
+		bitLength := msgBlock.Header.Proof.BitLength
+		if v := c.pubkbls[pkKey]; len(v) == 0 || bitLength > int(v[len(v)-blHeightLen]) {
+			c.pubkbls[pkKey] = append(v, serializeBLHeights(uint8(bitLength), height)...)
+		}
+	}
+	if c.index == database.IndexStaking {
+		return c.collectStakings(msgBlock)
+	}
+	if !c.tracksOutputs() {
+		return nil
+	}
+
+	txLocs, err := block.TxLoc()
+	if err != nil {
+		return err
+	}
+	for txIdx, tx := range msgBlock.Transactions {
+		txSha := tx.TxHash()
+		loc := &txLocs[txIdx]
+
+		if !isCoinBaseTx(tx) && height != 0 {
+			for _, txIn := range tx.TxIn {
+				if err := c.indexTxIn(height, loc, &txIn.PreviousOutPoint); err != nil {
+					return err
+				}
+			}
+		}
+		for i, txOut := range tx.TxOut {
+			if height != 0 {
+				if err := c.indexTxOut(height, loc, uint32(i), txOut.PkScript); err != nil {
+					return err
+				}
+			}
+		}
+
+		// spent bits of trailing padding are set except for genesis,
+		// which is the way submitBlock does
+		spentbuflen := (len(tx.TxOut) + 7) / 8
+		spentbuf := make([]byte, spentbuflen)
+		if height != 0 && len(tx.TxOut)%8 != 0 {
+			for i := uint(len(tx.TxOut) % 8); i < 8; i++ {
+				spentbuf[spentbuflen-1] |= byte(1) << i
+			}
+		}
+		c.txs[txSha] = &txUpdateObj{
+			txSha:     &txSha,
+			blkHeight: height,
+			txoff:     loc.TxStart,
+			txlen:     loc.TxLen,
+			spentData: spentbuf,
+		}
+
+		if isCoinBaseTx(tx) {
+			continue
+		}
+		for _, txIn := range tx.TxIn {
+			if txIn.PreviousOutPoint.Index == ^uint32(0) {
+				continue
+			}
+			if err := c.spend(&txIn.PreviousOutPoint); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collectStakings collects staking outputs of block.
+func (c *integrityChecker) collectStakings(msgBlock *wire.MsgBlock) error {
+	height := msgBlock.Header.Height
+	for _, tx := range msgBlock.Transactions {
+		txSha := tx.TxHash()
+		for i, txOut := range tx.TxOut {
+			class, pops := txscript.GetScriptInfo(txOut.PkScript)
+			if class != txscript.StakingScriptHashTy {
+				continue
+			}
+			frozenPeriod, rsh, err := txscript.GetParsedOpcode(pops, class)
+			if err != nil {
+				return err
+			}
+			c.stakings = append(c.stakings, &stakingTx{
+				txSha:         &txSha,
+				index:         uint32(i),
+				expiredHeight: height + frozenPeriod,
+				rsh:           rsh,
+				value:         uint64(txOut.Value),
+				blkHeight:     height,
+			})
+		}
+	}
+	return nil
+}
+
+// spend marks the output as spent, and moves its transaction to the fully
+// spent table once all outputs are spent.  Fully spent transactions are only
+// kept for the transaction index.
+func (c *integrityChecker) spend(op *wire.OutPoint) error {
+	txU, ok := c.txs[op.Hash]
+	if !ok || int(op.Index/8) >= len(txU.spentData) {
+		return fmt.Errorf("block spends unknown output %v", op)
+	}
+	txU.spentData[op.Index/8] |= byte(1) << (op.Index % 8)
+	for _, b := range txU.spentData {
+		if b != ^byte(0) {
+			return nil
+		}
+	}
+	if c.index == database.IndexTx {
+		c.spentTxs[op.Hash] = append(c.spentTxs[op.Hash], &spentTx{
+			blkHeight: txU.blkHeight,
+			txoff:     txU.txoff,
+			txlen:     txU.txlen,
+			numTxO:    8 * len(txU.spentData),
+		})
+	}
+	delete(c.txs, op.Hash)
+	return nil
+}
+
+// fetchPkScript returns the pkScript of the output, along with the location
+// of its transaction.
+func (c *integrityChecker) fetchPkScript(op *wire.OutPoint) ([]byte, *txUpdateObj, error) {
+	txU, ok := c.txs[op.Hash]
+	if !ok {
+		return nil, nil, fmt.Errorf("block spends unknown output %v", op)
+	}
+	buf, ok := c.blocks[txU.blkHeight]
+	if !ok {
+		var err error
+		if _, buf, err = c.db.getBlkByHeight(txU.blkHeight); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(buf) < txU.txoff+txU.txlen {
+		return nil, nil, database.ErrTxShaMissing
+	}
+	var tx wire.MsgTx
+	if err := tx.SetBytes(buf[txU.txoff:txU.txoff+txU.txlen], wire.DB); err != nil {
+		return nil, nil, err
+	}
+	if int(op.Index) >= len(tx.TxOut) {
+		return nil, nil, fmt.Errorf("block spends unknown output %v", op)
+	}
+	return tx.TxOut[op.Index].PkScript, txU, nil
+}
+
+func (c *integrityChecker) addTxIndex(scriptHash []byte, height uint64, loc *wire.TxLoc) {
+	var sh [32]byte
+	copy(sh[:], scriptHash)
+	c.expect(database.IndexAddr, txIndexToKey(&txIndex{
+		scriptHash: sh,
+		blkHeight:  height,
+		txOffset:   uint32(loc.TxStart),
+		txLen:      uint32(loc.TxLen),
+	}), blankData)
+	c.expect(database.IndexAddr, shIndexToKey(&shIndex{blkHeight: height, scriptHash: sh}), blankData)
+}
+
+// indexTxIn collects address index entries of the output spent by a tx
+// input, following indexScriptPubKeyForTxIn of blockchain.
+func (c *integrityChecker) indexTxIn(height uint64, loc *wire.TxLoc, op *wire.OutPoint) error {
+	pkScript, prev, err := c.fetchPkScript(op)
+	if err != nil {
+		return err
+	}
+	class, pops := txscript.GetScriptInfo(pkScript)
+	switch class {
+	case txscript.WitnessV0ScriptHashTy, txscript.StakingScriptHashTy:
+		_, rsh, err := txscript.GetParsedOpcode(pops, class)
+		if err != nil {
+			return err
+		}
+		c.addTxIndex(rsh[:], height, loc)
+	case txscript.BindingScriptHashTy:
+		holder, binding, err := txscript.GetParsedBindingOpcode(pops)
+		if err != nil {
+			return err
+		}
+		c.addTxIndex(holder, height, loc)
+		var bsh [ripemd160.Size]byte
+		copy(bsh[:], binding)
+		c.expect(database.IndexBinding, bindingTxSpentIndexToKey(&bindingTxSpentIndex{
+			scriptHash:       bsh,
+			blkHeightSpent:   height,
+			txOffsetSpent:    uint32(loc.TxStart),
+			txLenSpent:       uint32(loc.TxLen),
+			blkHeightBinding: prev.blkHeight,
+			txOffsetBinding:  uint32(prev.txoff),
+			txLenBinding:     uint32(prev.txlen),
+			indexBinding:     op.Index,
+		}), blankData)
+		delete(c.expected, string(bindingTxIndexToKey(&bindingTxIndex{
+			scriptHash: bsh,
+			blkHeight:  prev.blkHeight,
+			txOffset:   uint32(prev.txoff),
+			txLen:      uint32(prev.txlen),
+			index:      op.Index,
+		})))
+	}
+	return nil
+}
+
+// indexTxOut collects address index entries of a tx output, following
+// indexScriptPubKeyForTxOut of blockchain.
+func (c *integrityChecker) indexTxOut(height uint64, loc *wire.TxLoc, index uint32, pkScript []byte) error {
+	class, pops := txscript.GetScriptInfo(pkScript)
+	switch class {
+	case txscript.WitnessV0ScriptHashTy, txscript.StakingScriptHashTy:
+		_, rsh, err := txscript.GetParsedOpcode(pops, class)
+		if err != nil {
+			return err
+		}
+		c.addTxIndex(rsh[:], height, loc)
+	case txscript.BindingScriptHashTy:
+		holder, binding, err := txscript.GetParsedBindingOpcode(pops)
+		if err != nil {
+			return err
+		}
+		c.addTxIndex(holder, height, loc)
+		var bsh [ripemd160.Size]byte
+		copy(bsh[:], binding)
+		c.expect(database.IndexBinding, bindingTxIndexToKey(&bindingTxIndex{
+			scriptHash: bsh,
+			blkHeight:  height,
+			txOffset:   uint32(loc.TxStart),
+			txLen:      uint32(loc.TxLen),
+			index:      index,
+		}), blankData)
+	}
+	return nil
+}
+
+// finish collects entries which depend on the state at the newest block.
+func (c *integrityChecker) finish() {
+	switch c.index {
+	case database.IndexTx:
+		// entries are moved out of state, so that they are not kept twice
+		for txSha, txU := range c.txs {
+			c.expect(database.IndexTx, shaTxToKey(&txSha), c.db.formatTx(txU))
+			delete(c.txs, txSha)
+		}
+		for txSha, txl := range c.spentTxs {
+			c.expect(database.IndexTx, shaSpentTxToKey(&txSha), c.db.formatTxFullySpent(txl))
+			delete(c.spentTxs, txSha)
+		}
+
+	case database.IndexStaking:
+		for _, txL := range c.stakings {
+			mapKey := stakingTxMapKey{blockHeight: txL.blkHeight, txID: *txL.txSha, index: txL.index}
+			if txL.expiredHeight <= c.report.Height {
+				c.expect(database.IndexStaking, heightExpiredStakingTxToKey(txL.expiredHeight, mapKey), c.db.formatTxU(txL))
+			} else {
+				c.expect(database.IndexStaking, heightStakingTxToKey(txL.expiredHeight, mapKey), c.db.formatTxL(txL))
+			}
+		}
+
+	case database.IndexPubkeyBl:
+		for key, value := range c.pubkbls {
+			c.expect(database.IndexPubkeyBl, []byte(key), value)
+		}
+
+	case database.IndexBinding:
+		c.finishBinding()
+	}
+	c.txs, c.spentTxs, c.stakings, c.pubkbls = nil, nil, nil, nil
+}
+
+// finishBinding collects binding script hash entries, a binding script hash is
+// indexed by height as long as any of its binding outputs at that height is unspent.
+func (c *integrityChecker) finishBinding() {
+	shKeys := make([][]byte, 0)
+	for key := range c.expected {
+		if !bytes.HasPrefix([]byte(key), bindingTxIndexPrefix) {
+			continue
+		}
+		btx, err := mustDecodeBindingTxIndexKey([]byte(key))
+		if err != nil {
+			continue
+		}
+		shKeys = append(shKeys, bindingShIndexToKey(&bindingShIndex{blkHeight: btx.blkHeight, scriptHash: btx.scriptHash}))
+	}
+	for _, key := range shKeys {
+		c.expect(database.IndexBinding, key, blankData)
+	}
+}
+
+// compare diffs stored entries of index against expected ones, and rewrites
+// those differing if repair is true.
+func (c *integrityChecker) compare(prefixes [][]byte, repair bool) error {
+	index, expected := c.index, c.expected
+	batch := c.db.stor.NewBatch()
+	defer batch.Release()
+	pending := 0
+	flush := func(force bool) error {
+		if pending == 0 || (!force && pending < repairBatchSize) {
+			return nil
+		}
+		if err := c.db.stor.Write(batch); err != nil {
+			return err
+		}
+		batch.Reset()
+		pending = 0
+		return nil
+	}
+
+	for _, prefix := range prefixes {
+		iter := c.db.stor.NewIterator(storage.BytesPrefix(prefix))
+		for iter.Next() {
+			key := string(iter.Key())
+			value, ok := expected[key]
+			if !ok {
+				c.addInconsistency(index, "unexpected entry %s", formatCheckKey(prefix, iter.Key()))
+				if repair {
+					batch.Delete([]byte(key))
+					pending++
+				}
+			} else {
+				delete(expected, key)
+				if !bytes.Equal(value, iter.Value()) {
+					c.addInconsistency(index, "mismatched entry %s: %x, expected %x",
+						formatCheckKey(prefix, iter.Key()), iter.Value(), value)
+					if repair {
+						batch.Put([]byte(key), value)
+						pending++
+					}
+				}
+			}
+			if repair {
+				if err := flush(false); err != nil {
+					iter.Release()
+					return err
+				}
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	missing := make([]string, 0, len(expected))
+	for key := range expected {
+		missing = append(missing, key)
+	}
+	sort.Strings(missing)
+	for _, key := range missing {
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix([]byte(key), prefix) {
+				c.addInconsistency(index, "missing entry %s", formatCheckKey(prefix, []byte(key)))
+				break
+			}
+		}
+		if repair {
+			batch.Put([]byte(key), expected[key])
+			pending++
+			if err := flush(false); err != nil {
+				return err
+			}
+		}
+	}
+	if repair {
+		c.repaired = c.repaired || c.report.Counts[index] > 0
+		return flush(true)
+	}
+	return nil
+}
+
+// formatCheckKey returns the readable prefix of key followed by the rest in
+// hex.
+func formatCheckKey(prefix, key []byte) string {
+	return fmt.Sprintf("%s%x", prefix, key[len(prefix):])
+}
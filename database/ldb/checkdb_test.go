@@ -0,0 +1,88 @@
+package ldb_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass/database"
+	"massnet.org/mass/database/ldb"
+	"massnet.org/mass/database/storage"
+)
+
+// firstKey returns a copy of the first key with prefix in stor.
+func firstKey(t *testing.T, stor storage.Storage, prefix string) []byte {
+	iter := stor.NewIterator(storage.BytesPrefix([]byte(prefix)))
+	defer iter.Release()
+	assert.True(t, iter.Next(), "no entry with prefix %s", prefix)
+	return append([]byte{}, iter.Key()...)
+}
+
+func TestChainDb_CheckIntegrity(t *testing.T) {
+	dbPath := filepath.Join(testDbRoot, "CheckDbTest")
+	os.RemoveAll(dbPath)
+	assert.Nil(t, os.MkdirAll(testDbRoot, 0700))
+	defer os.RemoveAll(testDbRoot)
+
+	stor, err := storage.CreateStorage(dbtype, dbPath)
+	assert.Nil(t, err)
+	db, err := ldb.NewChainDb(stor)
+	assert.Nil(t, err)
+	defer db.Close()
+
+	blks, err := loadFreshTopNBlk(10)
+	assert.Nil(t, err)
+	assert.Nil(t, db.InitByGenesisBlock(blks[0]))
+	assert.Nil(t, submitBlocks(db, blks))
+
+	// address index is not submitted by initBlocks, so it is rebuilt
+	report, err := db.CheckIntegrity(true)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(9), report.Height)
+	for index := range report.Counts {
+		assert.Contains(t, []string{database.IndexAddr, database.IndexBinding}, index)
+	}
+	assert.Equal(t, !report.Consistent(), report.Repaired)
+
+	report, err = db.CheckIntegrity(false)
+	assert.Nil(t, err)
+	assert.True(t, report.Consistent(), "%v", report.Counts)
+	assert.False(t, report.Repaired)
+
+	// corrupt derived indexes
+	assert.Nil(t, stor.Delete(firstKey(t, stor, "TXD")))
+	assert.Nil(t, stor.Delete(firstKey(t, stor, "MBP")))
+	assert.Nil(t, stor.Put(firstKey(t, stor, "PUBKBL"), []byte{0}))
+	assert.Nil(t, stor.Put(append([]byte("STL"), make([]byte, 48)...), nil))
+
+	report, err = db.CheckIntegrity(false)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{
+		database.IndexTx:         1,
+		database.IndexMinedBlock: 1,
+		database.IndexPubkeyBl:   1,
+		database.IndexAddr:       1,
+	}, report.Counts)
+	assert.Equal(t, 4, len(report.Inconsistencies))
+	assert.False(t, report.Repaired)
+
+	report, err = db.CheckIntegrity(true)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(report.Counts))
+	assert.True(t, report.Repaired)
+
+	report, err = db.CheckIntegrity(false)
+	assert.Nil(t, err)
+	assert.True(t, report.Consistent(), "%v", report.Counts)
+
+	// a broken main chain is reported but never repaired
+	hgtKey := firstKey(t, stor, "BLKHGT")
+	hgtKey[len(hgtKey)-8] = 5
+	assert.Nil(t, stor.Delete(hgtKey))
+
+	report, err = db.CheckIntegrity(true)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{database.IndexBlock: 1}, report.Counts)
+	assert.False(t, report.Repaired)
+}
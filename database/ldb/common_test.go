@@ -1,8 +1,6 @@
 package ldb_test
 
 import (
-	"bufio"
-	"encoding/hex"
 	"fmt"
 	"math"
 	"os"
@@ -12,10 +10,10 @@ import (
 	"massnet.org/mass/database"
 	"massnet.org/mass/database/ldb"
 	_ "massnet.org/mass/database/ldb"
+	"massnet.org/mass/database/ldb/dbtest"
 	_ "massnet.org/mass/database/memdb"
 	"massnet.org/mass/errors"
 	"massnet.org/mass/massutil"
-	"massnet.org/mass/wire"
 )
 
 // testDbRoot is the root directory used to create all test databases.
@@ -25,29 +23,16 @@ const (
 )
 
 var (
-	blks200 []*massutil.Block
+	blks200  []*massutil.Block
+	mockBlks *dbtest.MockBlocks
 )
 
 func init() {
-	f, err := os.Open("./data/mockBlks.dat")
-	if err != nil {
+	var err error
+	if mockBlks, err = dbtest.LoadMockBlocks("./data/mockBlks.dat"); err != nil {
 		panic(err)
 	}
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		buf, err := hex.DecodeString(scanner.Text())
-		if err != nil {
-			panic(err)
-		}
-
-		blk, err := massutil.NewBlockFromBytes(buf, wire.Packet)
-		if err != nil {
-			panic(err)
-		}
-		blks200 = append(blks200, blk)
-	}
+	blks200 = mockBlks.Blocks()
 }
 
 // the 1st block is genesis
@@ -67,6 +52,12 @@ func loadTopNBlk(n int) ([]*massutil.Block, error) {
 	return blks200[:n], nil
 }
 
+// loadFreshTopNBlk returns the first n blocks decoded again, which are not
+// affected by tests modifying blocks returned by loadTopNBlk.
+func loadFreshTopNBlk(n int) ([]*massutil.Block, error) {
+	return mockBlks.FreshTopN(n)
+}
+
 func insertBlock(db database.Db, blk *massutil.Block) error {
 	err := db.SubmitBlock(blk)
 	if err != nil {
@@ -83,13 +74,27 @@ func initBlocks(db database.Db, numBlks int) error {
 	if err != nil {
 		return err
 	}
+	return submitBlocks(db, blks)
+}
+
+// initFreshBlocks is like initBlocks, but submits blocks from
+// loadFreshTopNBlk.
+func initFreshBlocks(db database.Db, numBlks int) error {
+	blks, err := loadFreshTopNBlk(numBlks)
+	if err != nil {
+		return err
+	}
+	return submitBlocks(db, blks)
+}
 
+func submitBlocks(db database.Db, blks []*massutil.Block) error {
+	numBlks := len(blks)
 	for i, blk := range blks {
 		if i == 0 {
 			continue
 		}
 
-		err = db.SubmitBlock(blk)
+		err := db.SubmitBlock(blk)
 		if err != nil {
 			return err
 		}
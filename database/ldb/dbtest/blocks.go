@@ -0,0 +1,66 @@
+// Package dbtest provides block fixtures shared by tests of databases and the chain.
+package dbtest
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"os"
+
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/wire"
+)
+
+// MockBlocks are blocks read from a file of hex encoded blocks, one per line,
+// the 1st block is genesis.
+type MockBlocks struct {
+	raw    [][]byte
+	blocks []*massutil.Block
+}
+
+// LoadMockBlocks reads blocks from file.
+func LoadMockBlocks(file string) (*MockBlocks, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := &MockBlocks{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		buf, err := hex.DecodeString(scanner.Text())
+		if err != nil {
+			return nil, err
+		}
+		blk, err := massutil.NewBlockFromBytes(buf, wire.Packet)
+		if err != nil {
+			return nil, err
+		}
+		m.raw = append(m.raw, buf)
+		m.blocks = append(m.blocks, blk)
+	}
+	return m, scanner.Err()
+}
+
+// Blocks returns all blocks, which are shared by callers.
+func (m *MockBlocks) Blocks() []*massutil.Block {
+	return m.blocks
+}
+
+// FreshTopN returns the first n blocks decoded again, which are not affected
+// by tests modifying blocks returned by Blocks.
+func (m *MockBlocks) FreshTopN(n int) ([]*massutil.Block, error) {
+	if n <= 0 || n > len(m.raw) {
+		return nil, errors.New("invalid n")
+	}
+	blks := make([]*massutil.Block, n)
+	for i := range blks {
+		blk, err := massutil.NewBlockFromBytes(m.raw[i], wire.Packet)
+		if err != nil {
+			return nil, err
+		}
+		blks[i] = blk
+	}
+	return blks, nil
+}
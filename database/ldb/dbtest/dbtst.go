@@ -1,5 +1,5 @@
 //
-package ldb
+package dbtest
 
 //
 //import (
@@ -0,0 +1,196 @@
+package ldb
+
+import (
+	"encoding/binary"
+
+	"massnet.org/mass/database"
+	"massnet.org/mass/database/storage"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/wire"
+)
+
+const (
+	// reindexDropping indicates derived state is being dropped.
+	reindexDropping byte = iota
+
+	// reindexConnecting indicates stored blocks are being reconnected.
+	reindexConnecting
+)
+
+const (
+	// Each reindex value is 41 bytes:
+	// ----------------------------------------
+	// |  Phase | Target Height | Target Hash |
+	// ----------------------------------------
+	// | 1 byte |    8 bytes    |  32 bytes   |
+	// ----------------------------------------
+	reindexValueLength = 41
+
+	// reindexDropBatchSize is the number of entries deleted at once when
+	// dropping derived state.
+	reindexDropBatchSize = 10000
+)
+
+var (
+	reindexKey = []byte("REINDEX")
+
+	// reindexDropPrefixes are prefixes of entries derived from raw blocks.
+	reindexDropPrefixes = [][]byte{
+		recordSuffixTx,
+		recordSuffixSpentTx,
+		recordStakingTx,
+		recordExpiredStakingTx,
+		txIndexPrefix,
+		shIndexPrefix,
+		bindingTxIndexPrefix,
+		bindingShIndexPrefix,
+		bindingTxSpentIndexPrefix,
+		faultPkShaDataPrefix,
+		faultPkHeightShaPrefix,
+		minedBlockIndexPrefix,
+		[]byte(pubkblKeyPrefix),
+		uppkblKey,
+	}
+)
+
+func encodeReindexValue(phase byte, height uint64, sha *wire.Hash) []byte {
+	value := make([]byte, reindexValueLength)
+	value[0] = phase
+	binary.LittleEndian.PutUint64(value[1:9], height)
+	copy(value[9:], sha[:])
+	return value
+}
+
+// fetchReindexState returns storage.ErrNotFound if the database is not being
+// reindexed.
+func (db *ChainDb) fetchReindexState() (phase byte, height uint64, sha *wire.Hash, err error) {
+	value, err := db.stor.Get(reindexKey)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if len(value) != reindexValueLength {
+		logging.CPrint(logging.ERROR, "invalid reindex value", logging.LogFormat{"value": value})
+		return 0, 0, nil, storage.ErrInvalidValue
+	}
+	sha = new(wire.Hash)
+	copy(sha[:], value[9:])
+	return value[0], binary.LittleEndian.Uint64(value[1:9]), sha, nil
+}
+
+func (db *ChainDb) StartReindex() error {
+	db.dbLock.Lock()
+	defer db.dbLock.Unlock()
+
+	phase, height, sha, err := db.fetchReindexState()
+	switch err {
+	case nil:
+		if phase == reindexConnecting {
+			logging.CPrint(logging.INFO, "resume reindexing", logging.LogFormat{
+				"current": db.dbStorageMeta.currentHeight, "target": height})
+			return nil
+		}
+	case storage.ErrNotFound:
+		if db.dbStorageMeta.currentHeight == UnknownHeight {
+			return nil
+		}
+		height = db.dbStorageMeta.currentHeight
+		sha = &wire.Hash{}
+		*sha = db.dbStorageMeta.currentHash
+		if err = db.stor.Put(reindexKey, encodeReindexValue(reindexDropping, height, sha)); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	logging.CPrint(logging.INFO, "dropping state derived from blocks", logging.LogFormat{"target": height, "hash": sha})
+	for _, prefix := range reindexDropPrefixes {
+		if err = db.dropPrefix(prefix); err != nil {
+			return err
+		}
+	}
+
+	// reconnect genesis, and switch to the connecting phase in the same batch
+	_, buf, err := db.getBlkByHeight(0)
+	if err != nil {
+		return err
+	}
+	genesis, err := massutil.NewBlockFromBytes(buf, wire.DB)
+	if err != nil {
+		return err
+	}
+	genesis.SetHeight(0)
+
+	batch := db.Batch(blockBatch)
+	defer batch.Reset()
+	if err = db.submitBlock(genesis); err != nil {
+		return err
+	}
+	batch.Batch().Put(reindexKey, encodeReindexValue(reindexConnecting, height, sha))
+	if err = db.stor.Write(batch.Batch()); err != nil {
+		return err
+	}
+	db.dbStorageMeta.currentHash = *genesis.Hash()
+	db.dbStorageMeta.currentHeight = 0
+
+	logging.CPrint(logging.INFO, "start reindexing", logging.LogFormat{"target": height, "hash": sha})
+	return nil
+}
+
+// dropPrefix deletes all entries with prefix.
+func (db *ChainDb) dropPrefix(prefix []byte) error {
+	batch := db.stor.NewBatch()
+	defer batch.Release()
+
+	iter := db.stor.NewIterator(storage.BytesPrefix(prefix))
+	defer iter.Release()
+
+	count := 0
+	for iter.Next() {
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+		if count++; count%reindexDropBatchSize == 0 {
+			if err := db.stor.Write(batch); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	return db.stor.Write(batch)
+}
+
+func (db *ChainDb) FetchReindexTarget() (*wire.Hash, uint64, error) {
+	db.dbLock.Lock()
+	defer db.dbLock.Unlock()
+
+	_, height, sha, err := db.fetchReindexState()
+	if err != nil {
+		if err == storage.ErrNotFound {
+			err = database.ErrNotReindexing
+		}
+		return nil, 0, err
+	}
+	return sha, height, nil
+}
+
+func (db *ChainDb) FinishReindex() error {
+	db.dbLock.Lock()
+	defer db.dbLock.Unlock()
+
+	phase, _, _, err := db.fetchReindexState()
+	if err != nil {
+		if err == storage.ErrNotFound {
+			err = database.ErrNotReindexing
+		}
+		return err
+	}
+	if phase != reindexConnecting {
+		return database.ErrNotReindexing
+	}
+	return db.stor.Delete(reindexKey)
+}
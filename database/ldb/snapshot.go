@@ -0,0 +1,69 @@
+package ldb
+
+import (
+	"massnet.org/mass/database"
+	"massnet.org/mass/database/storage"
+)
+
+// snapshotStorage is a read-only storage.Storage backed by a snapshot.
+type snapshotStorage struct {
+	storage.Snapshot
+}
+
+// readOnlyBatch rejects all writes.
+type readOnlyBatch struct{}
+
+func (s *snapshotStorage) Close() error {
+	s.Release()
+	return nil
+}
+
+func (s *snapshotStorage) Put(key, value []byte) error {
+	return database.ErrReadOnly
+}
+
+func (s *snapshotStorage) Delete(key []byte) error {
+	return database.ErrReadOnly
+}
+
+func (s *snapshotStorage) Write(batch storage.Batch) error {
+	return database.ErrReadOnly
+}
+
+func (s *snapshotStorage) NewBatch() storage.Batch {
+	return readOnlyBatch{}
+}
+
+func (s *snapshotStorage) GetSnapshot() (storage.Snapshot, error) {
+	return nil, database.ErrReadOnly
+}
+
+func (readOnlyBatch) Release() {}
+
+func (readOnlyBatch) Put(key, value []byte) error {
+	return database.ErrReadOnly
+}
+
+func (readOnlyBatch) Delete(key []byte) error {
+	return database.ErrReadOnly
+}
+
+func (readOnlyBatch) Reset() {}
+
+// Snapshot returns a read-only ChainDb on a snapshot of storage.  Blocks are
+// committed in a single write, so the view always holds whole blocks.
+func (db *ChainDb) Snapshot() (database.Db, error) {
+	db.dbLock.Lock()
+	defer db.dbLock.Unlock()
+
+	snap, err := db.stor.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	view := newChainDb(&snapshotStorage{Snapshot: snap})
+	if view.dbStorageMeta, err = view.getBlockStorageMeta(); err != nil {
+		snap.Release()
+		return nil, err
+	}
+	return view, nil
+}
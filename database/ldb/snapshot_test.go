@@ -0,0 +1,63 @@
+package ldb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass/database"
+)
+
+func TestChainDb_Snapshot(t *testing.T) {
+	db, tearDown, err := GetDb("SnapshotTest")
+	assert.Nil(t, err)
+	defer tearDown()
+
+	blks, err := loadFreshTopNBlk(10)
+	assert.Nil(t, err)
+	assert.Nil(t, submitBlocks(db, blks[:5]))
+
+	view, err := db.Snapshot()
+	assert.Nil(t, err)
+	defer view.Close()
+
+	for _, blk := range blks[5:] {
+		assert.Nil(t, insertBlock(db, blk))
+	}
+	_, height, err := db.NewestSha()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(9), height)
+
+	// view is pinned to the newest block when it is taken
+	sha, height, err := view.NewestSha()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), height)
+	assert.Equal(t, blks[4].Hash(), sha)
+
+	sha, err = view.FetchBlockShaByHeight(3)
+	assert.Nil(t, err)
+	assert.Equal(t, blks[3].Hash(), sha)
+	_, err = view.FetchBlockShaByHeight(7)
+	assert.NotNil(t, err)
+	exists, err := view.ExistsSha(blks[7].Hash())
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	coinbase := blks[7].Transactions()[0].Hash()
+	replies, err := db.FetchTxBySha(coinbase)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(replies))
+	replies, _ = view.FetchTxBySha(coinbase)
+	assert.Equal(t, 0, len(replies))
+
+	// disconnecting blocks does not affect view either
+	assert.Nil(t, db.DeleteBlock(blks[9].Hash()))
+	block, err := view.FetchBlockBySha(blks[4].Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, blks[4].Hash(), block.Hash())
+
+	// view is read-only, address index missing from submitBlocks is not
+	// rebuilt
+	report, err := view.CheckIntegrity(true)
+	assert.Equal(t, database.ErrReadOnly, err)
+	assert.Nil(t, report)
+}
@@ -0,0 +1,129 @@
+package ldb
+
+import (
+	"encoding/binary"
+
+	"massnet.org/mass/database"
+	"massnet.org/mass/database/storage"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/wire"
+)
+
+// utxoIterator walks the unspent transaction table, and yields unspent
+// outputs of each transaction in it.
+type utxoIterator struct {
+	db        *ChainDb
+	iter      storage.Iterator
+	tipSha    wire.Hash
+	tipHeight uint64
+	pending   []*database.UtxoReply
+	current   *database.UtxoReply
+	err       error
+}
+
+// NewUtxoIterator returns an iterator over all unspent transaction outputs
+// as of the most recent committed block.
+//
+// The unspent transaction table is read from an implicit snapshot of storage,
+// while transactions are loaded from blocks on the fly.  If blocks are
+// disconnected during iteration, the iterator stops with
+// database.ErrUtxoSetChanged.
+func (db *ChainDb) NewUtxoIterator() (database.UtxoIterator, error) {
+	db.dbLock.Lock()
+	defer db.dbLock.Unlock()
+
+	if db.dbStorageMeta.currentHeight == UnknownHeight {
+		return nil, database.ErrBlockShaMissing
+	}
+	return &utxoIterator{
+		db:        db,
+		iter:      db.stor.NewIterator(storage.BytesPrefix(recordSuffixTx)),
+		tipSha:    db.dbStorageMeta.currentHash,
+		tipHeight: db.dbStorageMeta.currentHeight,
+	}, nil
+}
+
+func (it *utxoIterator) Tip() (*wire.Hash, uint64) {
+	sha := it.tipSha
+	return &sha, it.tipHeight
+}
+
+func (it *utxoIterator) Next() bool {
+	for len(it.pending) == 0 {
+		if it.err != nil || !it.iter.Next() {
+			it.current = nil
+			return false
+		}
+		it.pending, it.err = it.decodeEntry(it.iter.Key(), it.iter.Value())
+	}
+	it.current, it.pending = it.pending[0], it.pending[1:]
+	return true
+}
+
+func (it *utxoIterator) Utxo() *database.UtxoReply {
+	return it.current
+}
+
+func (it *utxoIterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.iter.Error()
+}
+
+func (it *utxoIterator) Release() {
+	it.iter.Release()
+	it.pending = nil
+	it.current = nil
+}
+
+// decodeEntry returns unspent outputs of the transaction recorded in an entry
+// of the unspent transaction table.
+func (it *utxoIterator) decodeEntry(key, value []byte) ([]*database.UtxoReply, error) {
+	if len(key) != len(recordSuffixTx)+wire.HashSize || len(value) < 16 {
+		logging.CPrint(logging.ERROR, "invalid unspent tx entry", logging.LogFormat{"key": key, "value": value})
+		return nil, storage.ErrInvalidValue
+	}
+	var txSha wire.Hash
+	copy(txSha[:], key[len(recordSuffixTx):])
+	blkHeight := binary.LittleEndian.Uint64(value[0:8])
+	txOff := int(binary.LittleEndian.Uint32(value[8:12]))
+	txLen := int(binary.LittleEndian.Uint32(value[12:16]))
+	spentBuf := value[16:]
+
+	if blkHeight > it.tipHeight {
+		return nil, database.ErrUtxoSetChanged
+	}
+	tx, _, err := it.db.fetchTxDataByLoc(blkHeight, txOff, txLen)
+	if err != nil {
+		if err == database.ErrTxShaMissing {
+			err = database.ErrUtxoSetChanged
+		}
+		return nil, err
+	}
+	if tx.TxHash() != txSha {
+		return nil, database.ErrUtxoSetChanged
+	}
+
+	coinbase := isCoinBaseTx(tx)
+	utxos := make([]*database.UtxoReply, 0, len(tx.TxOut))
+	for i, txOut := range tx.TxOut {
+		if byteIdx := i / 8; byteIdx < len(spentBuf) && spentBuf[byteIdx]&(byte(1)<<uint(i%8)) != 0 {
+			continue
+		}
+		amount, err := massutil.NewAmountFromInt(txOut.Value)
+		if err != nil {
+			return nil, err
+		}
+		utxos = append(utxos, &database.UtxoReply{
+			TxSha:    &txSha,
+			Height:   blkHeight,
+			Coinbase: coinbase,
+			Index:    uint32(i),
+			Value:    amount,
+			PkScript: txOut.PkScript,
+		})
+	}
+	return utxos, nil
+}
@@ -0,0 +1,69 @@
+package ldb_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass/database"
+	"massnet.org/mass/wire"
+)
+
+func TestLevelDb_NewUtxoIterator(t *testing.T) {
+	db, tearDown, err := GetDb("DbTest")
+	assert.Nil(t, err)
+	defer tearDown()
+
+	err = initFreshBlocks(db, 10)
+	assert.Nil(t, err)
+
+	// count unspent outputs by transactions of blocks
+	expected := make(map[wire.OutPoint]int64)
+	_, newestHeight, err := db.NewestSha()
+	assert.Nil(t, err)
+	for height := uint64(0); height <= newestHeight; height++ {
+		sha, err := db.FetchBlockShaByHeight(height)
+		assert.Nil(t, err)
+		blk, err := db.FetchBlockBySha(sha)
+		assert.Nil(t, err)
+		for _, tx := range blk.Transactions() {
+			reply := db.FetchUnSpentTxByShaList([]*wire.Hash{tx.Hash()})[0]
+			if reply.Err == database.ErrTxShaMissing {
+				continue
+			}
+			assert.Nil(t, reply.Err)
+			for i, spent := range reply.TxSpent {
+				if !spent {
+					expected[*wire.NewOutPoint(tx.Hash(), uint32(i))] = tx.MsgTx().TxOut[i].Value
+				}
+			}
+		}
+	}
+	assert.NotZero(t, len(expected))
+
+	iter, err := db.NewUtxoIterator()
+	assert.Nil(t, err)
+	defer iter.Release()
+
+	tip, tipHeight := iter.Tip()
+	newestSha, _, _ := db.NewestSha()
+	assert.Equal(t, newestSha, tip)
+	assert.Equal(t, newestHeight, tipHeight)
+
+	var last *database.UtxoReply
+	count := 0
+	for iter.Next() {
+		utxo := iter.Utxo()
+		value, ok := expected[*wire.NewOutPoint(utxo.TxSha, utxo.Index)]
+		assert.True(t, ok, "unexpected utxo %v:%d", utxo.TxSha, utxo.Index)
+		assert.Equal(t, value, utxo.Value.IntValue())
+		if last != nil {
+			cmp := bytes.Compare(last.TxSha[:], utxo.TxSha[:])
+			assert.True(t, cmp < 0 || cmp == 0 && last.Index < utxo.Index)
+		}
+		last = utxo
+		count++
+	}
+	assert.Nil(t, iter.Error())
+	assert.Equal(t, len(expected), count)
+}
@@ -15,6 +15,10 @@ type memLevelDB struct {
 	db *leveldb.DB
 }
 
+type levelSnapshot struct {
+	snap *leveldb.Snapshot
+}
+
 type levelBatch struct {
 	b *leveldb.Batch
 }
@@ -92,6 +96,23 @@ func (l *memLevelDB) Write(batch dbstorage.Batch) error {
 }
 
 func (l *memLevelDB) NewIterator(slice *dbstorage.Range) dbstorage.Iterator {
+	return newLevelIterator(l.db, slice)
+}
+
+func (l *memLevelDB) GetSnapshot() (dbstorage.Snapshot, error) {
+	snap, err := l.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &levelSnapshot{snap: snap}, nil
+}
+
+// levelReader is implemented by both leveldb.DB and leveldb.Snapshot.
+type levelReader interface {
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+func newLevelIterator(reader levelReader, slice *dbstorage.Range) dbstorage.Iterator {
 	if slice == nil {
 		slice = &dbstorage.Range{}
 	} else {
@@ -104,13 +125,38 @@ func (l *memLevelDB) NewIterator(slice *dbstorage.Range) dbstorage.Iterator {
 	}
 	return &levelIterator{
 		slice: slice,
-		iter: l.db.NewIterator(&util.Range{
+		iter: reader.NewIterator(&util.Range{
 			Start: slice.Start,
 			Limit: slice.Limit,
 		}, nil),
 	}
 }
 
+// -------------levelSnapshot-------------
+
+func (s *levelSnapshot) Get(key []byte) ([]byte, error) {
+	value, err := s.snap.Get(key, nil)
+	if err != nil {
+		if err == leveldb.ErrNotFound {
+			return nil, dbstorage.ErrNotFound
+		}
+		return nil, err
+	}
+	return value, nil
+}
+
+func (s *levelSnapshot) Has(key []byte) (bool, error) {
+	return s.snap.Has(key, nil)
+}
+
+func (s *levelSnapshot) NewIterator(slice *dbstorage.Range) dbstorage.Iterator {
+	return newLevelIterator(s.snap, slice)
+}
+
+func (s *levelSnapshot) Release() {
+	s.snap.Release()
+}
+
 // -------------levelBatch-------------
 
 func (b *levelBatch) Put(key, value []byte) error {
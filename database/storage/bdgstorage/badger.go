@@ -0,0 +1,452 @@
+package bdgstorage
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"massnet.org/mass/database"
+	"massnet.org/mass/database/storage"
+	"massnet.org/mass/logging"
+)
+
+const (
+	// DbType is the storage type of badger.
+	DbType = "badger"
+
+	// valueLogGCInterval is the interval to reclaim space of value log files.
+	valueLogGCInterval = 10 * time.Minute
+
+	// valueLogGCDiscardRatio is the minimum fraction of stale data in a value
+	// log file to rewrite it.
+	valueLogGCDiscardRatio = 0.5
+
+	// manifestFile exists in every badger database directory.
+	manifestFile = "MANIFEST"
+)
+
+// Options tunes compaction of badger storage.  Zero values leave the badger
+// defaults.
+type Options struct {
+	// NumCompactors is the number of concurrent compaction workers.
+	NumCompactors int
+
+	// LevelSizeMultiplier is the size ratio between adjacent levels of the
+	// LSM tree.  Greater values mean less levels and less write
+	// amplification, at the cost of more space amplification.
+	LevelSizeMultiplier int
+
+	// NumLevelZeroTables is the number of level 0 tables that triggers a
+	// compaction.  Writes are stalled at twice this number.
+	NumLevelZeroTables int
+}
+
+type badgerDB struct {
+	db   *badger.DB
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+type badgerOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+type badgerBatch struct {
+	ops []badgerOp
+}
+
+type badgerSnapshot struct {
+	txn *badger.Txn
+}
+
+type badgerIterator struct {
+	txn     *badger.Txn
+	ownTxn  bool
+	iter    *badger.Iterator
+	slice   *storage.Range
+	started bool
+	err     error
+}
+
+func init() {
+	storage.RegisterDriver(storage.StorageDriver{
+		DbType:        DbType,
+		OpenStorage:   OpenDB,
+		CreateStorage: CreateDB,
+	})
+}
+
+// CreateDB creates a badger storage at path.  An optional *Options in args
+// tunes compaction.
+func CreateDB(path string, args ...interface{}) (storage.Storage, error) {
+	return newBadgerDB(path, true, parseOptions(args))
+}
+
+// OpenDB opens an existing badger storage at path.  An optional *Options in
+// args tunes compaction.
+func OpenDB(path string, args ...interface{}) (storage.Storage, error) {
+	return newBadgerDB(path, false, parseOptions(args))
+}
+
+func parseOptions(args []interface{}) *Options {
+	for _, arg := range args {
+		if opts, ok := arg.(*Options); ok && opts != nil {
+			return opts
+		}
+	}
+	return &Options{}
+}
+
+func newBadgerDB(path string, create bool, options *Options) (storage.Storage, error) {
+	_, err := os.Stat(filepath.Join(path, manifestFile))
+	switch {
+	case err == nil && create:
+		return nil, &os.PathError{Op: "create", Path: path, Err: os.ErrExist}
+	case os.IsNotExist(err) && !create:
+		return nil, database.ErrDbDoesNotExist
+	case err != nil && !os.IsNotExist(err):
+		return nil, err
+	}
+
+	opts := badgerOptions(path, options)
+	db, err := badger.Open(opts)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "init badger error", logging.LogFormat{
+			"path":   path,
+			"create": create,
+			"err":    err,
+		})
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "init badger", logging.LogFormat{
+		"path":                  path,
+		"create":                create,
+		"num_compactors":        opts.NumCompactors,
+		"level_size_multiplier": opts.LevelSizeMultiplier,
+		"level_zero_tables":     opts.NumLevelZeroTables,
+	})
+	b := &badgerDB{db: db, quit: make(chan struct{})}
+	b.wg.Add(1)
+	go b.valueLogGCHandler()
+	return b, nil
+}
+
+// badgerOptions returns badger options of path tuned by options.
+func badgerOptions(path string, options *Options) badger.Options {
+	opts := badger.DefaultOptions(path).
+		WithSyncWrites(false).
+		WithTruncate(true).
+		WithLogger(badgerLogger{})
+	if options.NumCompactors > 0 {
+		opts = opts.WithNumCompactors(options.NumCompactors)
+	}
+	if options.LevelSizeMultiplier > 0 {
+		opts = opts.WithLevelSizeMultiplier(options.LevelSizeMultiplier)
+	}
+	if options.NumLevelZeroTables > 0 {
+		opts = opts.WithNumLevelZeroTables(options.NumLevelZeroTables).
+			WithNumLevelZeroTablesStall(options.NumLevelZeroTables * 2)
+	}
+	return opts
+}
+
+// valueLogGCHandler periodically rewrites value log files to reclaim space
+// taken by deleted or overwritten values.
+func (b *badgerDB) valueLogGCHandler() {
+	defer b.wg.Done()
+	ticker := time.NewTicker(valueLogGCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.quit:
+			return
+		case <-ticker.C:
+			for b.db.RunValueLogGC(valueLogGCDiscardRatio) == nil {
+				select {
+				case <-b.quit:
+					return
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (b *badgerDB) Close() error {
+	close(b.quit)
+	b.wg.Wait()
+	return b.db.Close()
+}
+
+func (b *badgerDB) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := b.db.View(func(txn *badger.Txn) (err error) {
+		value, err = getFromTxn(txn, key)
+		return err
+	})
+	return value, err
+}
+
+// getFromTxn returns storage.ErrNotFound if key not exist.
+func getFromTxn(txn *badger.Txn, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	item, err := txn.Get(key)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return item.ValueCopy(nil)
+}
+
+// hasFromGet converts result of Get to that of Has.
+func hasFromGet(err error) (bool, error) {
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (b *badgerDB) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return storage.ErrInvalidKey
+	}
+	return b.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, value)
+	})
+}
+
+func (b *badgerDB) Has(key []byte) (bool, error) {
+	_, err := b.Get(key)
+	return hasFromGet(err)
+}
+
+func (b *badgerDB) Delete(key []byte) error {
+	if len(key) == 0 {
+		return nil
+	}
+	return b.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+func (b *badgerDB) NewBatch() storage.Batch {
+	return &badgerBatch{}
+}
+
+// Write applies batch atomically in a single transaction.
+// storage.ErrBatchTooLarge is returned and nothing is written if the batch
+// exceeds the transaction size limit of badger.
+func (b *badgerDB) Write(batch storage.Batch) error {
+	bb, ok := batch.(*badgerBatch)
+	if !ok {
+		return storage.ErrInvalidBatch
+	}
+
+	txn := b.db.NewTransaction(true)
+	defer txn.Discard()
+	for _, op := range bb.ops {
+		var err error
+		if op.delete {
+			err = txn.Delete(op.key)
+		} else {
+			err = txn.Set(op.key, op.value)
+		}
+		if err == badger.ErrTxnTooBig {
+			logging.CPrint(logging.ERROR, "badger batch too large", logging.LogFormat{"count": len(bb.ops)})
+			return storage.ErrBatchTooLarge
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
+func (b *badgerDB) NewIterator(slice *storage.Range) storage.Iterator {
+	it := newBadgerIterator(b.db.NewTransaction(false), slice)
+	it.ownTxn = true
+	return it
+}
+
+// GetSnapshot returns a read-only transaction as snapshot, which pins the
+// versions it reads from being discarded by compaction until released.
+func (b *badgerDB) GetSnapshot() (storage.Snapshot, error) {
+	return &badgerSnapshot{txn: b.db.NewTransaction(false)}, nil
+}
+
+func newBadgerIterator(txn *badger.Txn, slice *storage.Range) *badgerIterator {
+	if slice == nil {
+		slice = &storage.Range{}
+	} else {
+		if len(slice.Start) == 0 {
+			slice.Start = nil
+		}
+		if len(slice.Limit) == 0 {
+			slice.Limit = nil
+		}
+	}
+
+	opts := badger.DefaultIteratorOptions
+	if slice.Start != nil && slice.IsPrefix() {
+		opts.Prefix = slice.Start
+	}
+	return &badgerIterator{
+		txn:   txn,
+		iter:  txn.NewIterator(opts),
+		slice: slice,
+	}
+}
+
+// -------------badgerSnapshot-------------
+
+func (s *badgerSnapshot) Get(key []byte) ([]byte, error) {
+	return getFromTxn(s.txn, key)
+}
+
+func (s *badgerSnapshot) Has(key []byte) (bool, error) {
+	_, err := getFromTxn(s.txn, key)
+	return hasFromGet(err)
+}
+
+func (s *badgerSnapshot) NewIterator(slice *storage.Range) storage.Iterator {
+	return newBadgerIterator(s.txn, slice)
+}
+
+func (s *badgerSnapshot) Release() {
+	s.txn.Discard()
+}
+
+// -------------badgerBatch-------------
+
+func (b *badgerBatch) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return storage.ErrInvalidKey
+	}
+	b.ops = append(b.ops, badgerOp{
+		key:   append([]byte{}, key...),
+		value: append([]byte{}, value...),
+	})
+	return nil
+}
+
+func (b *badgerBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return storage.ErrInvalidKey
+	}
+	b.ops = append(b.ops, badgerOp{
+		key:    append([]byte{}, key...),
+		delete: true,
+	})
+	return nil
+}
+
+func (b *badgerBatch) Reset() {
+	b.ops = b.ops[:0]
+}
+
+func (b *badgerBatch) Release() {
+	b.ops = nil
+}
+
+// -----------------badgerIterator-----------------
+
+// valid returns whether the iterator is positioned at an entry in range.
+func (it *badgerIterator) valid() bool {
+	if it.err != nil || !it.iter.Valid() {
+		return false
+	}
+	return it.slice.Limit == nil || bytes.Compare(it.iter.Item().Key(), it.slice.Limit) < 0
+}
+
+func (it *badgerIterator) Seek(key []byte) bool {
+	if it.slice.Start != nil && bytes.Compare(key, it.slice.Start) < 0 {
+		key = it.slice.Start
+	}
+	it.started = true
+	it.iter.Seek(key)
+	return it.valid()
+}
+
+func (it *badgerIterator) Next() bool {
+	if !it.started {
+		it.started = true
+		it.iter.Seek(it.slice.Start)
+		return it.valid()
+	}
+	if !it.valid() {
+		return false
+	}
+	it.iter.Next()
+	return it.valid()
+}
+
+func (it *badgerIterator) Key() []byte {
+	if !it.valid() {
+		return nil
+	}
+	return it.iter.Item().KeyCopy(nil)
+}
+
+func (it *badgerIterator) Value() []byte {
+	if !it.valid() {
+		return nil
+	}
+	value, err := it.iter.Item().ValueCopy(nil)
+	if err != nil {
+		it.err = err
+		return nil
+	}
+	return value
+}
+
+func (it *badgerIterator) Release() {
+	it.iter.Close()
+	if it.ownTxn {
+		it.txn.Discard()
+	}
+}
+
+func (it *badgerIterator) Error() error {
+	return it.err
+}
+
+// -----------------badgerLogger-----------------
+
+// badgerLogger redirects badger logs to CPrint.
+type badgerLogger struct{}
+
+func (badgerLogger) Errorf(format string, args ...interface{}) {
+	logging.CPrint(logging.ERROR, badgerMessage(format, args))
+}
+
+func (badgerLogger) Warningf(format string, args ...interface{}) {
+	logging.CPrint(logging.WARN, badgerMessage(format, args))
+}
+
+func (badgerLogger) Infof(format string, args ...interface{}) {
+	logging.CPrint(logging.DEBUG, badgerMessage(format, args))
+}
+
+func (badgerLogger) Debugf(format string, args ...interface{}) {
+	logging.CPrint(logging.DEBUG, badgerMessage(format, args))
+}
+
+func badgerMessage(format string, args []interface{}) string {
+	return "badger: " + strings.TrimSpace(fmt.Sprintf(format, args...))
+}
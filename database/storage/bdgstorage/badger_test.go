@@ -0,0 +1,165 @@
+package bdgstorage
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"massnet.org/mass/database"
+	"massnet.org/mass/database/storage"
+	_ "massnet.org/mass/database/storage/ldbstorage"
+)
+
+// newTestDB creates a badger storage at "db" under a temp dir, which should be removed by caller.
+func newTestDB(t *testing.T, options *Options) (*badgerDB, string) {
+	dir, err := ioutil.TempDir("", "bdgstorage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := CreateDB(filepath.Join(dir, "db"), options)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return db.(*badgerDB), dir
+}
+
+func testKey(i int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(i))
+	return key
+}
+
+func TestBadgerOptions(t *testing.T) {
+	def := badger.DefaultOptions("db")
+	opts := badgerOptions("db", &Options{})
+	if opts.NumCompactors != def.NumCompactors || opts.LevelSizeMultiplier != def.LevelSizeMultiplier ||
+		opts.NumLevelZeroTables != def.NumLevelZeroTables || opts.NumLevelZeroTablesStall != def.NumLevelZeroTablesStall {
+		t.Errorf("zero options should keep badger defaults, %+v", opts)
+	}
+	if opts.SyncWrites || !opts.Truncate {
+		t.Errorf("unexpected sync writes %v, truncate %v", opts.SyncWrites, opts.Truncate)
+	}
+
+	opts = badgerOptions("db", parseOptions([]interface{}{"ignored", &Options{
+		NumCompactors:       3,
+		LevelSizeMultiplier: 5,
+		NumLevelZeroTables:  2,
+	}}))
+	if opts.NumCompactors != 3 || opts.LevelSizeMultiplier != 5 || opts.NumLevelZeroTables != 2 || opts.NumLevelZeroTablesStall != 4 {
+		t.Errorf("options not applied, %+v", opts)
+	}
+
+	if parsed := parseOptions([]interface{}{(*Options)(nil)}); *parsed != (Options{}) {
+		t.Errorf("nil options should be zero, %+v", parsed)
+	}
+}
+
+func TestCreateOpenDB(t *testing.T) {
+	db, dir := newTestDB(t, &Options{NumCompactors: 3})
+	defer os.RemoveAll(dir)
+	if err := db.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "db")
+	if _, err := CreateDB(path); !os.IsExist(err) {
+		t.Errorf("create on existing db, %v", err)
+	}
+	if _, err := OpenDB(filepath.Join(dir, "none")); err != database.ErrDbDoesNotExist {
+		t.Errorf("open on non-existent db, %v", err)
+	}
+	reopened, err := OpenDB(path, &Options{NumCompactors: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+	value, err := reopened.Get([]byte("key"))
+	if err != nil || string(value) != "value" {
+		t.Errorf("unexpected value %s, %v", value, err)
+	}
+}
+
+func TestWriteBatchTooLarge(t *testing.T) {
+	db, dir := newTestDB(t, nil)
+	defer os.RemoveAll(dir)
+	defer db.Close()
+
+	src, err := storage.CreateStorage("leveldb", filepath.Join(dir, "src"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+
+	batch, srcBatch := db.NewBatch(), src.NewBatch()
+	count := int(db.db.MaxBatchCount())
+	for i := 0; i <= count; i++ {
+		batch.Put(testKey(i), testKey(i))
+		srcBatch.Put(testKey(i), testKey(i))
+	}
+	if err = db.Write(batch); err != storage.ErrBatchTooLarge {
+		t.Fatalf("unexpected error on large batch, %v", err)
+	}
+	// nothing is written
+	iter := db.NewIterator(nil)
+	if iter.Next() {
+		t.Errorf("large batch is partially written, %x", iter.Key())
+	}
+	iter.Release()
+
+	// split by Copy
+	if err = src.Write(srcBatch); err != nil {
+		t.Fatal(err)
+	}
+	copied, err := storage.Copy(db, src, count+1)
+	if err != nil || copied != count+1 {
+		t.Fatalf("fail to copy large batch, copied %d, %v", copied, err)
+	}
+	srcCount, dstCount, err := storage.Verify(db, src)
+	if err != nil || srcCount != count+1 || dstCount != count+1 {
+		t.Errorf("copied entries mismatched, %d, %d, %v", srcCount, dstCount, err)
+	}
+}
+
+func TestCloseStopsValueLogGC(t *testing.T) {
+	db, dir := newTestDB(t, nil)
+	defer os.RemoveAll(dir)
+
+	closed := make(chan error)
+	go func() {
+		closed <- db.Close()
+	}()
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("close blocked by value log gc")
+	}
+	select {
+	case <-db.quit:
+	default:
+		t.Error("value log gc is not notified to quit")
+	}
+
+	// the handler returns at once after quit
+	db.wg.Add(1)
+	done := make(chan struct{})
+	go func() {
+		db.valueLogGCHandler()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("value log gc handler not stopped")
+	}
+}
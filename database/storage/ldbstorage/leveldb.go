@@ -14,6 +14,10 @@ type levelDB struct {
 	db *leveldb.DB
 }
 
+type levelSnapshot struct {
+	snap *leveldb.Snapshot
+}
+
 type levelBatch struct {
 	b *leveldb.Batch
 }
@@ -123,6 +127,23 @@ func (l *levelDB) Write(batch storage.Batch) error {
 }
 
 func (l *levelDB) NewIterator(slice *storage.Range) storage.Iterator {
+	return newLevelIterator(l.db, slice)
+}
+
+func (l *levelDB) GetSnapshot() (storage.Snapshot, error) {
+	snap, err := l.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &levelSnapshot{snap: snap}, nil
+}
+
+// levelReader is implemented by both leveldb.DB and leveldb.Snapshot.
+type levelReader interface {
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+func newLevelIterator(reader levelReader, slice *storage.Range) storage.Iterator {
 	if slice == nil {
 		slice = &storage.Range{}
 	} else {
@@ -135,13 +156,38 @@ func (l *levelDB) NewIterator(slice *storage.Range) storage.Iterator {
 	}
 	return &levelIterator{
 		slice: slice,
-		iter: l.db.NewIterator(&util.Range{
+		iter: reader.NewIterator(&util.Range{
 			Start: slice.Start,
 			Limit: slice.Limit,
 		}, nil),
 	}
 }
 
+// -------------levelSnapshot-------------
+
+func (s *levelSnapshot) Get(key []byte) ([]byte, error) {
+	value, err := s.snap.Get(key, nil)
+	if err != nil {
+		if err == leveldb.ErrNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return value, nil
+}
+
+func (s *levelSnapshot) Has(key []byte) (bool, error) {
+	return s.snap.Has(key, nil)
+}
+
+func (s *levelSnapshot) NewIterator(slice *storage.Range) storage.Iterator {
+	return newLevelIterator(s.snap, slice)
+}
+
+func (s *levelSnapshot) Release() {
+	s.snap.Release()
+}
+
 // -------------levelBatch-------------
 
 func (b *levelBatch) Put(key, value []byte) error {
@@ -0,0 +1,115 @@
+package storage
+
+import "bytes"
+
+type copyEntry struct {
+	key   []byte
+	value []byte
+}
+
+// Copy writes all entries of src into dst, in batches of batchSize entries,
+// and returns the number of entries copied.  A batch rejected by dst with
+// ErrBatchTooLarge is split into halves, as dst is not in use while copying.
+func Copy(dst, src Storage, batchSize int) (count int, err error) {
+	batch := dst.NewBatch()
+	defer batch.Release()
+
+	iter := src.NewIterator(nil)
+	defer iter.Release()
+
+	entries := make([]copyEntry, 0, batchSize)
+	for iter.Next() {
+		entries = append(entries, copyEntry{key: iter.Key(), value: iter.Value()})
+		if len(entries) == batchSize {
+			if err = writeEntries(dst, batch, entries); err != nil {
+				return count, err
+			}
+			count += len(entries)
+			entries = entries[:0]
+		}
+	}
+	if err = iter.Error(); err != nil {
+		return count, err
+	}
+	if err = writeEntries(dst, batch, entries); err != nil {
+		return count, err
+	}
+	return count + len(entries), nil
+}
+
+// writeEntries writes entries into dst by batch, and splits entries into halves
+// if they are too large for a single batch.
+func writeEntries(dst Storage, batch Batch, entries []copyEntry) error {
+	batch.Reset()
+	for _, entry := range entries {
+		if err := batch.Put(entry.key, entry.value); err != nil {
+			return err
+		}
+	}
+	err := dst.Write(batch)
+	if err != ErrBatchTooLarge || len(entries) < 2 {
+		return err
+	}
+	half := len(entries) / 2
+	if err = writeEntries(dst, batch, entries[:half]); err != nil {
+		return err
+	}
+	return writeEntries(dst, batch, entries[half:])
+}
+
+// Verify walks src and dst side by side, and returns the number of entries of
+// each.  ErrStorageMismatch is returned if any key or value differs.
+func Verify(dst, src Storage) (srcCount, dstCount int, err error) {
+	srcIter := src.NewIterator(nil)
+	defer srcIter.Release()
+	dstIter := dst.NewIterator(nil)
+	defer dstIter.Release()
+
+	var srcKey, dstKey []byte
+	srcOk, dstOk := srcIter.Next(), dstIter.Next()
+	if srcOk {
+		srcKey = srcIter.Key()
+	}
+	if dstOk {
+		dstKey = dstIter.Key()
+	}
+
+	mismatch := false
+	for srcOk || dstOk {
+		cmp := 0
+		switch {
+		case !dstOk:
+			cmp = -1
+		case !srcOk:
+			cmp = 1
+		default:
+			cmp = bytes.Compare(srcKey, dstKey)
+		}
+
+		if cmp != 0 || !bytes.Equal(srcIter.Value(), dstIter.Value()) {
+			mismatch = true
+		}
+		if cmp <= 0 {
+			srcCount++
+			if srcOk = srcIter.Next(); srcOk {
+				srcKey = srcIter.Key()
+			}
+		}
+		if cmp >= 0 {
+			dstCount++
+			if dstOk = dstIter.Next(); dstOk {
+				dstKey = dstIter.Key()
+			}
+		}
+	}
+	if err = srcIter.Error(); err != nil {
+		return srcCount, dstCount, err
+	}
+	if err = dstIter.Error(); err != nil {
+		return srcCount, dstCount, err
+	}
+	if mismatch {
+		return srcCount, dstCount, ErrStorageMismatch
+	}
+	return srcCount, dstCount, nil
+}
@@ -0,0 +1,67 @@
+package storage_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass/database/storage"
+)
+
+func TestCopyVerify(t *testing.T) {
+	types := storage.RegisteredDbTypes()
+	for _, srcType := range types {
+		for _, dstType := range types {
+			t.Run(srcType+"->"+dstType, func(t *testing.T) {
+				dir, err := ioutil.TempDir("", "Tst_Copy")
+				assert.Nil(t, err)
+				defer os.RemoveAll(dir)
+
+				src, err := storage.CreateStorage(srcType, filepath.Join(dir, "src"))
+				assert.Nil(t, err)
+				defer src.Close()
+				dst, err := storage.CreateStorage(dstType, filepath.Join(dir, "dst"))
+				assert.Nil(t, err)
+				defer dst.Close()
+
+				for i := 0; i < 250; i++ {
+					key := []byte(fmt.Sprintf("key%03d", i))
+					assert.Nil(t, src.Put(key, key[i%4:]))
+				}
+				assert.Nil(t, src.Put([]byte{0xff, 0xff}, nil))
+
+				count, err := storage.Copy(dst, src, 100)
+				assert.Nil(t, err)
+				assert.Equal(t, 251, count)
+				srcCount, dstCount, err := storage.Verify(dst, src)
+				assert.Nil(t, err)
+				assert.Equal(t, 251, srcCount)
+				assert.Equal(t, 251, dstCount)
+
+				// missing and changed entries
+				assert.Nil(t, dst.Delete([]byte("key100")))
+				srcCount, dstCount, err = storage.Verify(dst, src)
+				assert.Equal(t, storage.ErrStorageMismatch, err)
+				assert.Equal(t, 251, srcCount)
+				assert.Equal(t, 250, dstCount)
+
+				assert.Nil(t, dst.Put([]byte("key100"), []byte("changed")))
+				srcCount, dstCount, err = storage.Verify(dst, src)
+				assert.Equal(t, storage.ErrStorageMismatch, err)
+				assert.Equal(t, 251, srcCount)
+				assert.Equal(t, 251, dstCount)
+
+				// extra entry
+				assert.Nil(t, dst.Put([]byte("key100"), []byte("key100")[100%4:]))
+				assert.Nil(t, dst.Put([]byte("key2500"), nil))
+				srcCount, dstCount, err = storage.Verify(dst, src)
+				assert.Equal(t, storage.ErrStorageMismatch, err)
+				assert.Equal(t, 251, srcCount)
+				assert.Equal(t, 252, dstCount)
+			})
+		}
+	}
+}
@@ -30,6 +30,8 @@ var (
 	ErrNotFound           = errors.New("not found")
 	ErrUnsupportedVersion = errors.New("unsupported version")
 	ErrUpgradeRequired    = errors.New("storage need upgrade")
+	ErrStorageMismatch    = errors.New("storages mismatch")
+	ErrBatchTooLarge      = errors.New("batch too large")
 )
 
 // Range is a key range.
@@ -86,6 +88,17 @@ type Batch interface {
 	Reset()
 }
 
+// Snapshot is a read-only view of a storage frozen at the time it is taken,
+// which is not affected by later writes.  Iterators of a snapshot should be
+// released before the snapshot.
+type Snapshot interface {
+	Release()
+	// Get returns ErrNotFound if key not exist
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	NewIterator(slice *Range) Iterator
+}
+
 type Storage interface {
 	Close() error
 	// Get returns ErrNotFound if key not exist
@@ -96,6 +109,9 @@ type Storage interface {
 	Write(batch Batch) error
 	NewBatch() Batch
 	NewIterator(slice *Range) Iterator
+	// GetSnapshot returns a snapshot of current state, which should be
+	// released after use.
+	GetSnapshot() (Snapshot, error)
 }
 
 type StorageDriver struct {
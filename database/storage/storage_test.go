@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"massnet.org/mass/database"
 	"massnet.org/mass/database/storage"
+	_ "massnet.org/mass/database/storage/bdgstorage"
 	_ "massnet.org/mass/database/storage/ldbstorage"
 )
 
@@ -26,6 +27,7 @@ func TestAll(t *testing.T) {
 		testIterator(t)
 		testOverwrite(t)
 		testSeek(t)
+		testSnapshot(t)
 	}
 }
 
@@ -738,3 +740,55 @@ func testSeek(t *testing.T) {
 		})
 	}
 }
+
+func testSnapshot(t *testing.T) {
+	store, tearDown, err := GetStorage("Tst_Snapshot")
+	if err != nil {
+		t.Errorf("init db error:%v", err)
+		t.FailNow()
+	}
+	defer tearDown()
+
+	assert.Nil(t, store.Put([]byte("a123"), []byte("a123")))
+	assert.Nil(t, store.Put([]byte("b456"), []byte("b456")))
+
+	snap, err := store.GetSnapshot()
+	assert.Nil(t, err)
+	defer snap.Release()
+
+	// writes after snapshot
+	batch := store.NewBatch()
+	defer batch.Release()
+	assert.Nil(t, batch.Put([]byte("a123"), []byte("new a123")))
+	assert.Nil(t, batch.Delete([]byte("b456")))
+	assert.Nil(t, batch.Put([]byte("c789"), []byte("c789")))
+	assert.Nil(t, store.Write(batch))
+
+	v, err := snap.Get([]byte("a123"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("a123"), v)
+	exist, err := snap.Has([]byte("b456"))
+	assert.Nil(t, err)
+	assert.True(t, exist)
+	_, err = snap.Get([]byte("c789"))
+	assert.Equal(t, storage.ErrNotFound, err)
+	exist, err = snap.Has([]byte("c789"))
+	assert.Nil(t, err)
+	assert.False(t, exist)
+
+	actual := make(map[string]string)
+	iter := snap.NewIterator(nil)
+	for iter.Next() {
+		actual[string(iter.Key())] = string(iter.Value())
+	}
+	assert.Nil(t, iter.Error())
+	iter.Release()
+	assert.Equal(t, map[string]string{"a123": "a123", "b456": "b456"}, actual)
+
+	v, err = store.Get([]byte("a123"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new a123"), v)
+	exist, err = store.Has([]byte("b456"))
+	assert.Nil(t, err)
+	assert.False(t, exist)
+}
@@ -15,6 +15,7 @@ import (
 	"massnet.org/mass/database"
 	_ "massnet.org/mass/database/ldb"
 	"massnet.org/mass/database/storage"
+	"massnet.org/mass/database/storage/bdgstorage"
 	_ "massnet.org/mass/database/storage/ldbstorage"
 	"massnet.org/mass/limits"
 	"massnet.org/mass/logging"
@@ -61,6 +62,21 @@ func massMain(serverChan chan<- *server) error {
 	// Show version at startup.
 	logging.CPrint(logging.INFO, fmt.Sprintf("version %s", version.GetVersion()))
 
+	// Check block database and exit if requested.
+	if cfg.CheckDb || cfg.RepairDb {
+		return checkBlockDB(cfg.RepairDb)
+	}
+
+	// Copy block database into another storage engine and exit if requested.
+	if cfg.MigrateDb != "" {
+		return migrateBlockDB(cfg.MigrateDb, cfg.MigrateDir)
+	}
+
+	// Convert massdb.v1 files in proof dirs into massdb.v2 and exit if requested.
+	if cfg.ConvertMassDB {
+		return convertMassDB(cfg.Miner.ProofDir)
+	}
+
 	// Init Miner Keystore
 	//if cfg.Init {
 	//	return InitPoCWallet(cfg)
@@ -115,6 +131,17 @@ func massMain(serverChan chan<- *server) error {
 		logging.CPrint(logging.ERROR, "unable to start server on address", logging.LogFormat{"addr": cfg.Network.P2P.ListenAddress, "err": err})
 		return err
 	}
+
+	// Reconnect stored blocks if the block database is being reindexed.
+	interrupt, stopInterrupt := scopedInterrupt()
+	err = server.chain.Reindex(interrupt)
+	stopInterrupt()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to reindex block database", logging.LogFormat{"err": err})
+		db.Close()
+		return err
+	}
+
 	addInterruptHandler(func() {
 		logging.CPrint(logging.INFO, "Stopping server...")
 		server.Stop()
@@ -230,6 +257,15 @@ func warnMultipeDBs() {
 	}
 }
 
+// storageOptions returns storage engine options from the db section of config.
+func storageOptions() *bdgstorage.Options {
+	return &bdgstorage.Options{
+		NumCompactors:       int(cfg.Db.Compactors),
+		LevelSizeMultiplier: int(cfg.Db.LevelSizeMultiplier),
+		NumLevelZeroTables:  int(cfg.Db.LevelZeroTables),
+	}
+}
+
 // setupBlockDB loads (or creates when needed) the block database taking into
 // account the selected database backend.  It also contains additional logic
 // such warning the user if there are multiple databases which consume space on
@@ -263,10 +299,10 @@ func setupBlockDB() (database.Db, bool, error) {
 		return nil, false, err
 	}
 
-	db, err := database.OpenDB(cfg.Db.DbType, dbPath)
+	db, err := database.OpenDB(cfg.Db.DbType, dbPath, storageOptions())
 	if err != nil {
 		logging.CPrint(logging.WARN, "open db failed", logging.LogFormat{"err": err, "path": dbPath})
-		db, err = database.CreateDB(cfg.Db.DbType, dbPath)
+		db, err = database.CreateDB(cfg.Db.DbType, dbPath, storageOptions())
 		if err != nil {
 			logging.CPrint(logging.ERROR, "create db failed", logging.LogFormat{"err": err, "path": dbPath})
 			return nil, false, err
@@ -302,6 +338,16 @@ func loadBlockDB() (database.Db, error) {
 		height = 0
 	}
 
+	// Drop chain state if reindexing is requested, or resume an interrupted
+	// one.  Stored blocks are reconnected once the chain is loaded.
+	if _, _, err := db.FetchReindexTarget(); cfg.Reindex || err == nil {
+		if err := db.StartReindex(); err != nil {
+			db.Close()
+			logging.CPrint(logging.ERROR, "StartReindex error", logging.LogFormat{"err": err})
+			return nil, err
+		}
+	}
+
 	if needUpgrade {
 		err = db.IndexPubkbl(false)
 		if err != nil {
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"massnet.org/mass/database/storage"
+	"massnet.org/mass/logging"
+)
+
+// migrateBatchSize is the number of entries written at once by migrateBlockDB.
+const migrateBatchSize = 10000
+
+var errMigrateDirInUse = errors.New("migratedir already contains a block database")
+
+// migrateBlockDB copies every entry of the block database into a new database
+// of dbType under dataDir, and verifies both databases hold the same entries.
+// The original database is left untouched.
+func migrateBlockDB(dbType, dataDir string) error {
+	srcPath := blockDbPath(cfg.Db.DbType)
+	if err := storage.CheckVersion(cfg.Db.DbType, cfg.Db.DataDir, false); err != nil {
+		logging.CPrint(logging.ERROR, "check db version failed", logging.LogFormat{"err": err, "path": srcPath})
+		return err
+	}
+
+	dstPath := filepath.Join(dataDir, blockDbNamePrefix+".db")
+	if FileExists(dstPath) || FileExists(filepath.Join(dataDir, ".ver")) {
+		logging.CPrint(logging.ERROR, "migratedir in use", logging.LogFormat{"dir": dataDir})
+		return errMigrateDirInUse
+	}
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return err
+	}
+
+	src, err := storage.OpenStorage(cfg.Db.DbType, srcPath)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "open block database failed", logging.LogFormat{"err": err, "path": srcPath})
+		return err
+	}
+	defer src.Close()
+	dst, err := storage.CreateStorage(dbType, dstPath, storageOptions())
+	if err != nil {
+		logging.CPrint(logging.ERROR, "create block database failed", logging.LogFormat{"err": err, "path": dstPath})
+		return err
+	}
+	defer dst.Close()
+
+	logging.CPrint(logging.INFO, "migrating block database", logging.LogFormat{
+		"from": cfg.Db.DbType, "src": srcPath, "to": dbType, "dst": dstPath})
+	count, err := storage.Copy(dst, src, migrateBatchSize)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to copy block database", logging.LogFormat{"err": err, "copied": count})
+		return err
+	}
+
+	srcCount, dstCount, err := storage.Verify(dst, src)
+	fmt.Printf("copied %d entries, source has %d entries, destination has %d entries\n", count, srcCount, dstCount)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to verify migrated block database", logging.LogFormat{"err": err})
+		return err
+	}
+	if srcCount != count || dstCount != count {
+		return storage.ErrStorageMismatch
+	}
+
+	// written last, so that an incomplete database is never loaded
+	if err = storage.WriteVersion(filepath.Join(dataDir, ".ver"), dbType, storage.CurrentStorageVersion); err != nil {
+		return err
+	}
+	fmt.Printf("block database migrated to %s, set data_dir to %s and db_type to %s to use it\n", dbType, dataDir, dbType)
+	return nil
+}
@@ -1,6 +1,7 @@
 package mining
 
 import (
+	"context"
 	"reflect"
 
 	"massnet.org/mass/logging"
@@ -8,6 +9,7 @@ import (
 	_ "massnet.org/mass/poc/engine/pocminer/miner"
 	"massnet.org/mass/poc/engine/spacekeeper"
 	"massnet.org/mass/poc/engine/spacekeeper/capacity"
+	"massnet.org/mass/poc/engine/spacekeeper/poolmanager"
 )
 
 type SpaceKeeper interface {
@@ -16,6 +18,15 @@ type SpaceKeeper interface {
 	ConfigureByBitLength(BlCount map[int]int, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error)
 	ConfigureBySize(targetSize uint64, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error)
 	AvailableDiskSize() (uint64, error)
+	PlotQueue() ([]capacity.PlotQueueItem, bool, error)
+	PausePlotQueue() error
+	ResumePlotQueue() error
+	ReorderPlotQueue(sids []string) error
+	PlanCapacity() (*capacity.CapacityPlan, error)
+	ApplyCapacityPlan(planID string, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error)
+	BenchmarkProofs(ctx context.Context, challenges int) (*capacity.ProofBenchmark, error)
+	SpaceStates() (map[string]capacity.SpaceState, error)
+	SetSpaceLabels(sid string, tags []string, payoutAddress string) (capacity.SpaceState, error)
 }
 
 type ConfigurableSpaceKeeper struct {
@@ -61,10 +72,97 @@ func (csk *ConfigurableSpaceKeeper) AvailableDiskSize() (uint64, error) {
 	return sk.AvailableDiskSize(), nil
 }
 
+func (csk *ConfigurableSpaceKeeper) PlotQueue() ([]capacity.PlotQueueItem, bool, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, false, err
+	}
+	items, paused := sk.PlotQueue()
+	return items, paused, nil
+}
+
+func (csk *ConfigurableSpaceKeeper) PausePlotQueue() error {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return err
+	}
+	sk.PausePlotQueue()
+	return nil
+}
+
+func (csk *ConfigurableSpaceKeeper) ResumePlotQueue() error {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return err
+	}
+	sk.ResumePlotQueue()
+	return nil
+}
+
+func (csk *ConfigurableSpaceKeeper) ReorderPlotQueue(sids []string) error {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return err
+	}
+	return sk.ReorderPlotQueue(sids)
+}
+
+func (csk *ConfigurableSpaceKeeper) PlanCapacity() (*capacity.CapacityPlan, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.PlanCapacity()
+}
+
+func (csk *ConfigurableSpaceKeeper) ApplyCapacityPlan(planID string, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.ApplyCapacityPlan(planID, execPlot, execMine)
+}
+
+func (csk *ConfigurableSpaceKeeper) BenchmarkProofs(ctx context.Context, challenges int) (*capacity.ProofBenchmark, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.BenchmarkProofs(ctx, challenges)
+}
+
+func (csk *ConfigurableSpaceKeeper) SpaceStates() (map[string]capacity.SpaceState, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.SpaceStates(), nil
+}
+
+func (csk *ConfigurableSpaceKeeper) SetSpaceLabels(sid string, tags []string, payoutAddress string) (capacity.SpaceState, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return capacity.SpaceState{}, err
+	}
+	return sk.SetSpaceLabels(sid, tags, payoutAddress)
+}
+
 func getInstance(sk spacekeeper.SpaceKeeper) (*capacity.SpaceKeeper, error) {
-	ins, ok := sk.(*capacity.SpaceKeeper)
-	if !ok {
+	switch ins := sk.(type) {
+	case *capacity.SpaceKeeper:
+		return ins, nil
+	case *poolmanager.PoolManager:
+		return ins.SpaceKeeper, nil
+	default:
 		return nil, spacekeeper.ErrUnimplemented
 	}
-	return ins, nil
 }
@@ -145,6 +145,13 @@ func (bk *blockKeeper) fastBlockSync(checkPoint *config.Checkpoint) error {
 		}
 	}
 
+	// blocks to the assume-valid block are known before being connected
+	headers := make([]*wire.BlockHeader, 0, bk.headerList.Len())
+	for e := bk.headerList.Front(); e != nil; e = e.Next() {
+		headers = append(headers, e.Value.(*wire.BlockHeader))
+	}
+	bk.chain.AddAssumeValidHeaders(headers)
+
 	fastHeader := bk.headerList.Front()
 	for bk.chain.BestBlockHeight() < checkPoint.Height {
 		locator := bk.blockLocator()
@@ -157,6 +164,8 @@ func (bk *blockKeeper) fastBlockSync(checkPoint *config.Checkpoint) error {
 			return errors.Wrap(errPeerMisbehave, "requireBlocks return empty list")
 		}
 
+		// validate scripts of blocks in parallel before connecting them one by one
+		bk.chain.PrevalidateBlocks(blocks)
 		for _, block := range blocks {
 			if fastHeader = fastHeader.Next(); fastHeader == nil {
 				return errors.New("get block that is higher than checkpoint")
@@ -223,6 +232,7 @@ func (bk *blockKeeper) batchBlockSync(targetHeight uint64) error {
 			return errors.Wrap(errPeerMisbehave, "requireBlocks return empty list")
 		}
 
+		bk.chain.PrevalidateBlocks(blocks)
 		for _, block := range blocks {
 			if batchHeader = batchHeader.Next(); batchHeader == nil {
 				return errors.New("get block that is higher than target height")
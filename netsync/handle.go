@@ -29,6 +29,8 @@ type Chain interface {
 	GetHeaderByHeight(uint64) (*wire.BlockHeader, error)
 	InMainChain(wire.Hash) bool
 	ProcessBlock(*massutil.Block) (bool, error)
+	PrevalidateBlocks([]*massutil.Block)
+	AddAssumeValidHeaders([]*wire.BlockHeader)
 	ProcessTx(*massutil.Tx) (bool, error)
 	ChainID() *wire.Hash
 }
@@ -372,6 +374,12 @@ func (sm *SyncManager) handleTransactionMsg(peer *peer, msg *TransactionMessage)
 		if err == errors.ErrTxAlreadyExists {
 			return
 		}
+		if blockchain.IsReplacementRejected(err) {
+			// Peers may have different views of replaceable transactions,
+			// so it is not regarded as misbehavior.
+			logging.CPrint(logging.DEBUG, "reject replacement tx", logging.LogFormat{"err": err, "txid": tx.Hash().String()})
+			return
+		}
 		logging.CPrint(logging.ERROR, "process tx fail", logging.LogFormat{"err": err, "txid": tx.Hash().String()})
 		sm.peers.addBanScore(peer.ID(), 10, 0, "fail on process transaction")
 	}
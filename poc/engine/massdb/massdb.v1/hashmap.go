@@ -296,6 +296,14 @@ func (hm *HashMap) Checkpoint() pocutil.PoCValue {
 	return hm.checkpoint
 }
 
+func (hm *HashMap) BitLength() int {
+	return hm.bl
+}
+
+func (hm *HashMap) PubKey() *pocec.PublicKey {
+	return hm.pk
+}
+
 func CreateHashMap(filePath string, typ MapType, bl int, pubKey *pocec.PublicKey) error {
 	f, err := createMapFile(filePath, typ, bl, pubKey)
 	if err != nil {
@@ -35,6 +35,11 @@ type MassDBV1 struct {
 	plotting   int32 // atomic
 	stopPlotCh chan struct{}
 	wg         sync.WaitGroup
+
+	// destB is the HashMapB in root dir when plotting in temp dir,
+	// plotted HashMapB would be copied into destB before it gets ready.
+	destB     *HashMapB
+	destPathB string
 }
 
 func (mdb *MassDBV1) Type() string {
@@ -50,6 +55,9 @@ func (mdb *MassDBV1) Close() error {
 	if mdb.HashMapB != nil {
 		mdb.HashMapB.Close()
 	}
+	if mdb.destB != nil {
+		mdb.destB.Close()
+	}
 	return nil
 }
 
@@ -63,7 +71,7 @@ func (mdb *MassDBV1) Plot() chan error {
 		return result
 	}
 
-	if mdb.HashMapA == nil {
+	if mdb.HashMapA == nil && mdb.destB == nil {
 		result <- nil
 		return result
 	}
@@ -94,7 +102,7 @@ func (mdb *MassDBV1) StopPlot() chan error {
 
 func (mdb *MassDBV1) Ready() bool {
 	plotted, _ := mdb.HashMapB.Progress()
-	return plotted
+	return plotted && mdb.destB == nil
 }
 
 func (mdb *MassDBV1) BitLength() int {
@@ -109,6 +117,11 @@ func (mdb *MassDBV1) PubKey() *pocec.PublicKey {
 	return mdb.pubKey
 }
 
+// FilePath returns the path of HashMapB, which is in temp dir before copying finished.
+func (mdb *MassDBV1) FilePath() string {
+	return mdb.filePathB
+}
+
 func (mdb *MassDBV1) Get(z pocutil.PoCValue) (x, xp pocutil.PoCValue, err error) {
 	var bl = mdb.bl
 	xb, xpb, err := mdb.HashMapB.Get(z)
@@ -137,18 +150,31 @@ func (mdb *MassDBV1) GetProof(challenge pocutil.Hash) (*poc.Proof, error) {
 }
 
 func (mdb *MassDBV1) Progress() (prePlotted, plotted bool, progress float64) {
-	if mdb.HashMapA != nil {
-		prePlotted, progA := mdb.HashMapA.Progress()
-		plotted, progB := mdb.HashMapB.Progress()
-
-		totalRecord := mdb.HashMapA.volume + mdb.HashMapB.volume
-		currentRecord := progA + progB*2
-		progress = float64(currentRecord*100) / float64(totalRecord)
+	if mdb.HashMapA == nil && mdb.destB == nil {
+		return true, true, 100
+	}
 
-		return prePlotted, plotted, progress
+	var totalRecord, currentRecord pocutil.PoCValue
+	if mdb.HashMapA != nil {
+		var progA, progB pocutil.PoCValue
+		prePlotted, progA = mdb.HashMapA.Progress()
+		plotted, progB = mdb.HashMapB.Progress()
+		totalRecord = mdb.HashMapA.volume + mdb.HashMapB.volume
+		currentRecord = progA + progB*2
 	} else {
-		return true, true, 100
+		prePlotted, plotted = true, true
+		totalRecord = mdb.HashMapB.volume * 2
+		currentRecord = totalRecord
+	}
+	// copying HashMapB from temp dir is counted as half of the plotting work on HashMapB
+	if mdb.destB != nil {
+		plotted = false
+		totalRecord += mdb.destB.volume / 2
+		currentRecord += mdb.destB.checkpoint
 	}
+	progress = float64(currentRecord*100) / float64(totalRecord)
+
+	return prePlotted, plotted, progress
 }
 
 func (mdb *MassDBV1) Delete() chan error {
@@ -167,6 +193,9 @@ func (mdb *MassDBV1) Delete() chan error {
 		mdb.HashMapA.Close()
 	}
 	mdb.HashMapB.Close()
+	if mdb.destB != nil {
+		mdb.destB.Close()
+	}
 
 	go func() {
 		var errA, errB error
@@ -174,6 +203,11 @@ func (mdb *MassDBV1) Delete() chan error {
 			errA = os.Remove(mdb.filePathA)
 		}
 		errB = os.Remove(mdb.filePathB)
+		if mdb.destB != nil {
+			if err := os.Remove(mdb.destPathB); err != nil && errB == nil {
+				errB = err
+			}
+		}
 
 		if errA == nil && errB == nil {
 			sendResult(nil)
@@ -198,37 +232,60 @@ func OpenDB(args ...interface{}) (massdb.MassDB, error) {
 	}
 
 	pathA, pathB := getPath(dbPath, int(ordinal), pubKey, bitLength)
-	hmBi, err := LoadHashMap(pathB)
+	hmB, err := loadHashMapB(pathB)
 	if err != nil {
 		return nil, err
 	}
-	hmB, ok := hmBi.(*HashMapB)
-	if !ok {
-		return nil, ErrDBWrongType
+	mdb := &MassDBV1{
+		HashMapB:   hmB,
+		filePathA:  pathA,
+		filePathB:  pathB,
+		bl:         bitLength,
+		pubKey:     pubKey,
+		pubKeyHash: pocutil.PubKeyHash(pubKey),
+	}
+
+	tempDir := getPlotTempDir(dbPath)
+	if plotted, _ := hmB.Progress(); plotted {
+		if tempDir != "" {
+			// remove HashMapB left in temp dir if copying finished before last exit
+			_, tempPathB := getTempPath(tempDir, int(ordinal), pubKey, bitLength)
+			removeTempFile(tempPathB)
+		}
+		return mdb, nil
 	}
 
-	var hmA *HashMapA
-	hmA = nil
-	if plotted, _ := hmB.Progress(); !plotted {
-		hmAi, err := LoadHashMap(pathA)
+	// HashMapA in dbPath means plotting in place
+	hmA, err := loadHashMapA(pathA)
+	if err == nil {
+		mdb.HashMapA = hmA
+		return mdb, nil
+	}
+	if err != massdb.ErrDBDoesNotExist || tempDir == "" {
+		hmB.Close()
+		return nil, err
+	}
+
+	// otherwise HashMapB in dbPath is the copy destination of plotting in temp dir
+	tempPathA, tempPathB := getTempPath(tempDir, int(ordinal), pubKey, bitLength)
+	tempB, err := loadHashMapB(tempPathB)
+	if err != nil {
+		hmB.Close()
+		return nil, err
+	}
+	if plotted, _ := tempB.Progress(); !plotted {
+		tempA, err := loadHashMapA(tempPathA)
 		if err != nil {
+			hmB.Close()
+			tempB.Close()
 			return nil, err
 		}
-		hmA, ok = hmAi.(*HashMapA)
-		if !ok {
-			return nil, ErrDBWrongType
-		}
+		mdb.HashMapA = tempA
 	}
+	mdb.HashMapB, mdb.filePathA, mdb.filePathB = tempB, tempPathA, tempPathB
+	mdb.destB, mdb.destPathB = hmB, pathB
 
-	return &MassDBV1{
-		HashMapA:   hmA,
-		HashMapB:   hmB,
-		filePathA:  pathA,
-		filePathB:  pathB,
-		bl:         bitLength,
-		pubKey:     pubKey,
-		pubKeyHash: pocutil.PubKeyHash(pubKey),
-	}, nil
+	return mdb, nil
 }
 
 func CreateDB(args ...interface{}) (massdb.MassDB, error) {
@@ -238,27 +295,55 @@ func CreateDB(args ...interface{}) (massdb.MassDB, error) {
 	}
 
 	pathA, pathB := getPath(dbPath, int(ordinal), pubKey, bitLength)
-	if err := CreateHashMap(pathA, MapTypeHashMapA, bitLength, pubKey); err != nil && err != massdb.ErrDBAlreadyExists {
-		return nil, err
+	tempDir := getPlotTempDir(dbPath)
+	if tempDir == "" {
+		mdb, err := createDB(pathA, pathB, pubKey, bitLength)
+		if err != nil {
+			return nil, err
+		}
+		return mdb, nil
 	}
+
+	// plot HashMapA and HashMapB in temp dir, and create HashMapB in dbPath as copy destination
 	if err := CreateHashMap(pathB, MapTypeHashMapB, bitLength, pubKey); err != nil && err != massdb.ErrDBAlreadyExists {
 		return nil, err
 	}
-	hmAi, err := LoadHashMap(pathA)
+	destB, err := loadHashMapB(pathB)
 	if err != nil {
 		return nil, err
 	}
-	hmBi, err := LoadHashMap(pathB)
+	tempPathA, tempPathB := getTempPath(tempDir, int(ordinal), pubKey, bitLength)
+	mdb, err := createDB(tempPathA, tempPathB, pubKey, bitLength)
 	if err != nil {
+		destB.Close()
 		return nil, err
 	}
-	hmA, ok := hmAi.(*HashMapA)
-	if !ok {
-		return nil, ErrDBWrongType
+	if plotted, _ := mdb.HashMapB.Progress(); !plotted && destB.ReadCheckpoint() != 0 {
+		// copying cannot be resumed without plotted HashMapB
+		destB.checkpoint = 0
+		destB.UpdateCheckpoint()
+		destB.data.Sync()
 	}
-	hmB, ok := hmBi.(*HashMapB)
-	if !ok {
-		return nil, ErrDBWrongType
+	mdb.destB, mdb.destPathB = destB, pathB
+
+	return mdb, nil
+}
+
+func createDB(pathA, pathB string, pubKey *pocec.PublicKey, bitLength int) (*MassDBV1, error) {
+	if err := CreateHashMap(pathA, MapTypeHashMapA, bitLength, pubKey); err != nil && err != massdb.ErrDBAlreadyExists {
+		return nil, err
+	}
+	if err := CreateHashMap(pathB, MapTypeHashMapB, bitLength, pubKey); err != nil && err != massdb.ErrDBAlreadyExists {
+		return nil, err
+	}
+	hmA, err := loadHashMapA(pathA)
+	if err != nil {
+		return nil, err
+	}
+	hmB, err := loadHashMapB(pathB)
+	if err != nil {
+		hmA.Close()
+		return nil, err
 	}
 
 	return &MassDBV1{
@@ -272,6 +357,32 @@ func CreateDB(args ...interface{}) (massdb.MassDB, error) {
 	}, nil
 }
 
+func loadHashMapA(filePath string) (*HashMapA, error) {
+	hmi, err := LoadHashMap(filePath)
+	if err != nil {
+		return nil, err
+	}
+	hm, ok := hmi.(*HashMapA)
+	if !ok {
+		hmi.(*HashMapB).Close()
+		return nil, ErrDBWrongType
+	}
+	return hm, nil
+}
+
+func loadHashMapB(filePath string) (*HashMapB, error) {
+	hmi, err := LoadHashMap(filePath)
+	if err != nil {
+		return nil, err
+	}
+	hm, ok := hmi.(*HashMapB)
+	if !ok {
+		hmi.(*HashMapA).Close()
+		return nil, ErrDBWrongType
+	}
+	return hm, nil
+}
+
 func getPath(rootPath string, ordinal int, pubKey *pocec.PublicKey, bitLength int) (pathA, pathB string) {
 	pubKeyString := hex.EncodeToString(pubKey.SerializeCompressed())
 	pathA = strings.Join([]string{strconv.Itoa(ordinal), pubKeyString, strconv.Itoa(bitLength), "a"}, "_") + ".massdb"
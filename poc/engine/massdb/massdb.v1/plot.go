@@ -81,29 +81,44 @@ func (mdb *MassDBV1) executePlot(result chan error) {
 
 	logging.CPrint(logging.INFO, "start plotting",
 		logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed())})
-	if err = mdb.prePlotWork(cache); err != nil {
-		if err == ErrStopPlotting {
-			err = nil
+	if mdb.HashMapA != nil {
+		if err = mdb.prePlotWork(cache); err != nil {
+			if err == ErrStopPlotting {
+				err = nil
+				return
+			}
+			logging.CPrint(logging.ERROR, "pre plot fail",
+				logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "err": err})
+			return
+		}
+		if err = mdb.plotWork(cache); err != nil {
+			if err == ErrStopPlotting {
+				err = nil
+				return
+			}
+			logging.CPrint(logging.ERROR, "plot fail",
+				logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "err": err})
 			return
 		}
-		logging.CPrint(logging.ERROR, "pre plot fail",
-			logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "err": err})
-		return
+		logging.CPrint(logging.INFO, "remove hashMapA",
+			logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed())})
+		mdb.HashMapA.Close()
+		os.Remove(mdb.filePathA)
+		mdb.HashMapA = nil
 	}
-	if err = mdb.plotWork(cache); err != nil {
-		if err == ErrStopPlotting {
-			err = nil
+	if mdb.destB != nil {
+		cache.Release()
+		if err = mdb.copyWork(); err != nil {
+			if err == ErrStopPlotting {
+				err = nil
+				return
+			}
+			logging.CPrint(logging.ERROR, "copy fail",
+				logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "err": err})
 			return
 		}
-		logging.CPrint(logging.ERROR, "plot fail",
-			logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "err": err})
-		return
+		mdb.finishCopy()
 	}
-	logging.CPrint(logging.INFO, "remove hashMapA",
-		logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed())})
-	mdb.HashMapA.Close()
-	os.Remove(mdb.filePathA)
-	mdb.HashMapA = nil
 	logging.CPrint(logging.INFO, "plot finished",
 		logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed())})
 }
@@ -0,0 +1,132 @@
+package massdb_v1
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/pocec"
+)
+
+const copyBlockSize = 64 * poc.MiB // 64 MiB, size of data copied between two checkpoints
+
+var (
+	plotTempDir     string
+	plotTempDirLock sync.RWMutex
+)
+
+// SetPlotTempDir sets the dir (usually on SSD or tmpfs) to place HashMapA and
+// in-progress HashMapB for newly created MassDB, plotted HashMapB is copied
+// into root dir afterwards. Empty dir means plotting in root dir.
+func SetPlotTempDir(dir string) {
+	plotTempDirLock.Lock()
+	defer plotTempDirLock.Unlock()
+	plotTempDir = dir
+}
+
+// getPlotTempDir returns the plot temp dir for MassDB in rootPath,
+// empty string is returned if MassDB should be plotted in rootPath.
+func getPlotTempDir(rootPath string) string {
+	plotTempDirLock.RLock()
+	defer plotTempDirLock.RUnlock()
+	if plotTempDir == "" || filepath.Clean(plotTempDir) == filepath.Clean(rootPath) {
+		return ""
+	}
+	return plotTempDir
+}
+
+// getTempPath returns file paths in temp dir, the name of HashMapB is suffixed with `_b`
+// so that it would not be taken as a plotted MassDB.
+func getTempPath(tempDir string, ordinal int, pubKey *pocec.PublicKey, bitLength int) (pathA, pathB string) {
+	pathA, pathB = getPath(tempDir, ordinal, pubKey, bitLength)
+	pathB = strings.TrimSuffix(pathB, ".massdb") + "_b.massdb"
+	return pathA, pathB
+}
+
+// copyWork copies plotted HashMapB in temp dir to destB block by block,
+// checkpoint of destB is updated after each block is synced to disk,
+// so that copying could be resumed from the last checkpoint.
+func (mdb *MassDBV1) copyWork() error {
+	var src, dst = mdb.HashMapB, mdb.destB
+	var half = src.volume / 2
+	var unitSize = int64(src.recordSize) * 4 // data size covered by one checkpoint of HashMapB
+	var blockUnits = pocutil.PoCValue(copyBlockSize / unitSize)
+	var buf = make([]byte, int64(blockUnits)*unitSize)
+
+	var checkpoint = dst.ReadCheckpoint()
+	logging.CPrint(logging.INFO, fmt.Sprintf("load copy checkpoint for HashMapB: %d/%d", checkpoint, half),
+		logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "dest": mdb.destPathB})
+
+	for startPoint := checkpoint; startPoint < half; {
+		select {
+		case <-mdb.stopPlotCh:
+			logging.CPrint(logging.INFO, "copy aborted",
+				logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed())})
+			return ErrStopPlotting
+		default:
+		}
+
+		endPoint := startPoint + blockUnits
+		if endPoint > half {
+			endPoint = half
+		}
+		block := buf[:int64(endPoint-startPoint)*unitSize]
+		offset := int64(src.offset) + int64(startPoint)*unitSize
+		n, err := src.data.ReadAt(block, offset)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		// tail of HashMapB might not be written if all records are empty
+		for i := n; i < len(block); i++ {
+			block[i] = 0
+		}
+		if _, err = dst.data.WriteAt(block, offset); err != nil {
+			logging.CPrint(logging.ERROR, "fail on writing copied data to file", logging.LogFormat{"err": err, "dest": mdb.destPathB})
+			return err
+		}
+		// write copied data first
+		if err = dst.data.Sync(); err != nil {
+			logging.CPrint(logging.ERROR, "fail on syncing copied data to file", logging.LogFormat{"err": err, "dest": mdb.destPathB})
+			return err
+		}
+
+		// then update checkpoint
+		dst.checkpoint = endPoint
+		dst.UpdateCheckpoint()
+		if err = dst.data.Sync(); err != nil {
+			logging.CPrint(logging.ERROR, "fail on syncing copy checkpoint to file", logging.LogFormat{"err": err, "dest": mdb.destPathB})
+			return err
+		}
+		startPoint = endPoint
+	}
+
+	dst.checkpoint = half
+	dst.UpdateCheckpoint()
+	return dst.data.Sync()
+}
+
+// finishCopy removes HashMapB in temp dir, and takes destB as HashMapB.
+// Failure on removing is only logged since destB is complete, the file left
+// in temp dir is removed again on next load.
+func (mdb *MassDBV1) finishCopy() {
+	logging.CPrint(logging.INFO, "remove hashMapB in temp dir",
+		logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "path": mdb.filePathB})
+	mdb.HashMapB.Close()
+	removeTempFile(mdb.filePathB)
+	mdb.HashMapB, mdb.filePathB = mdb.destB, mdb.destPathB
+	mdb.destB, mdb.destPathB = nil, ""
+}
+
+// removeTempFile removes file left in temp dir, failure is logged.
+func removeTempFile(path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		logging.CPrint(logging.WARN, "fail to remove file in plot temp dir", logging.LogFormat{"path": path, "err": err})
+	}
+}
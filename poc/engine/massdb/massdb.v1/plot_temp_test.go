@@ -0,0 +1,162 @@
+package massdb_v1
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"massnet.org/mass/pocec"
+)
+
+const testTempPlotBL = 16
+
+func mustTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "massdb_v1_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// plotInPlace returns HashMapB file content plotted without temp dir.
+func plotInPlace(t *testing.T, pk *pocec.PublicKey) []byte {
+	rootDir := mustTempDir(t)
+	defer os.RemoveAll(rootDir)
+
+	mdb, err := NewMassDBV1(rootDir, 1, pk, testTempPlotBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mdb.Close()
+	if err = <-mdb.Plot(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(mdb.filePathB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestPlotInTempDir(t *testing.T) {
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := sk.PubKey()
+	expected := plotInPlace(t, pk)
+
+	rootDir, tempDir := mustTempDir(t), mustTempDir(t)
+	defer os.RemoveAll(rootDir)
+	defer os.RemoveAll(tempDir)
+	SetPlotTempDir(tempDir)
+	defer SetPlotTempDir("")
+
+	mdb, err := NewMassDBV1(rootDir, 1, pk, testTempPlotBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mdb.Close()
+	pathA, pathB := getPath(rootDir, 1, pk, testTempPlotBL)
+	tempPathA, tempPathB := getTempPath(tempDir, 1, pk, testTempPlotBL)
+	if mdb.filePathA != tempPathA || mdb.filePathB != tempPathB || mdb.destPathB != pathB {
+		t.Fatalf("unexpected paths, a: %s, b: %s, dest: %s", mdb.filePathA, mdb.filePathB, mdb.destPathB)
+	}
+
+	if err = <-mdb.Plot(); err != nil {
+		t.Fatal(err)
+	}
+	if !mdb.Ready() || mdb.filePathB != pathB {
+		t.Fatalf("massdb not ready after plotting, path: %s", mdb.filePathB)
+	}
+	for _, p := range []string{pathA, tempPathA, tempPathB} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Errorf("file should be removed after plotting: %s", p)
+		}
+	}
+	data, err := ioutil.ReadFile(pathB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, expected) {
+		t.Error("HashMapB plotted in temp dir differs from plotted in place")
+	}
+}
+
+func TestResumeCopyFromTempDir(t *testing.T) {
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := sk.PubKey()
+	expected := plotInPlace(t, pk)
+
+	rootDir, tempDir := mustTempDir(t), mustTempDir(t)
+	defer os.RemoveAll(rootDir)
+	defer os.RemoveAll(tempDir)
+	SetPlotTempDir(tempDir)
+	defer SetPlotTempDir("")
+
+	// plot in temp dir, and copy first half of HashMapB as if interrupted
+	mdb, err := NewMassDBV1(rootDir, 1, pk, testTempPlotBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewMemCache(0)
+	if err = mdb.prePlotWork(cache); err != nil {
+		t.Fatal(err)
+	}
+	if err = mdb.plotWork(cache); err != nil {
+		t.Fatal(err)
+	}
+	cache.Release()
+	mdb.HashMapA.Close()
+	os.Remove(mdb.filePathA)
+	mdb.HashMapA = nil
+
+	src, dst := mdb.HashMapB, mdb.destB
+	checkpoint := src.volume / 4
+	size := int(checkpoint) * src.recordSize * 4
+	buf := make([]byte, size)
+	if _, err = src.data.ReadAt(buf, int64(src.offset)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dst.data.WriteAt(buf, int64(dst.offset)); err != nil {
+		t.Fatal(err)
+	}
+	dst.checkpoint = checkpoint
+	dst.UpdateCheckpoint()
+	mdb.Close()
+
+	// reopen and resume copying
+	mdb, err = NewMassDBV1(rootDir, 1, pk, testTempPlotBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mdb.Close()
+	if mdb.HashMapA != nil || mdb.destB == nil {
+		t.Fatal("massdb should be loaded in copying stage")
+	}
+	prePlotted, plotted, progress := mdb.Progress()
+	if !prePlotted || plotted || progress <= 80 || progress >= 100 {
+		t.Fatalf("unexpected progress, prePlotted: %v, plotted: %v, progress: %f", prePlotted, plotted, progress)
+	}
+	if mdb.Ready() {
+		t.Fatal("massdb should not be ready before copying finished")
+	}
+
+	if err = <-mdb.Plot(); err != nil {
+		t.Fatal(err)
+	}
+	if !mdb.Ready() {
+		t.Fatal("massdb not ready after copying")
+	}
+	data, err := ioutil.ReadFile(mdb.filePathB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, expected) {
+		t.Error("resumed HashMapB differs from plotted in place")
+	}
+}
@@ -0,0 +1,176 @@
+package massdb_v2
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/bits"
+	"os"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/poc/engine/massdb"
+	"massnet.org/mass/poc/engine/massdb/massdb.v1"
+	"massnet.org/mass/poc/pocutil"
+)
+
+const (
+	convertBufferSize      = 64 * poc.MiB // 64 MiB, buffer size for reading massdb.v1 file
+	convertCheckpointBlock = 1024         // update checkpoint every 1024 blocks
+)
+
+// ConvertFromV1 converts a plotted massdb.v1 file to massdb.v2 file,
+// the conversion is resumed if dstPath is a partially converted massdb.v2 file.
+func ConvertFromV1(srcPath, dstPath string) error {
+	src, err := loadSource(srcPath)
+	if err != nil {
+		return err
+	}
+	src.Close()
+
+	t, err := loadTable(dstPath)
+	if err == massdb.ErrDBDoesNotExist {
+		t, err = createTable(dstPath, src.BitLength(), src.PubKey())
+	}
+	if err != nil {
+		return err
+	}
+	defer t.Close()
+
+	return t.convertFrom(srcPath, nil)
+}
+
+// loadSource loads and checks the massdb.v1 HashMapB to be converted.
+func loadSource(srcPath string) (*massdb_v1.HashMapB, error) {
+	hmi, err := massdb_v1.LoadHashMap(srcPath)
+	if err != nil {
+		return nil, err
+	}
+	hmB, ok := hmi.(*massdb_v1.HashMapB)
+	if !ok {
+		hmi.(*massdb_v1.HashMapA).Close()
+		return nil, ErrSourceWrongType
+	}
+	if plotted, _ := hmB.Progress(); !plotted {
+		hmB.Close()
+		return nil, ErrSourceNotPlotted
+	}
+	return hmB, nil
+}
+
+// convertFrom reads proofs from massdb.v1 HashMapB in srcPath, packs proofs into blocks
+// from the last checkpoint, and updates checkpoint every convertCheckpointBlock blocks or on quit.
+func (t *table) convertFrom(srcPath string, quit chan struct{}) error {
+	src, err := loadSource(srcPath)
+	if err != nil {
+		return err
+	}
+	src.Close()
+	if src.BitLength() != t.bl || !src.PubKey().IsEqual(t.pk) {
+		return ErrSourceNotMatched
+	}
+	if converted, _ := t.Progress(); converted {
+		return nil
+	}
+
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var bl = t.bl
+	var recordSize = pocutil.RecordSize(bl)
+	var blockSize = 1 << uint(t.blockBits)
+	var srcBlockSize = blockSize * recordSize * 2
+	var record = make([]byte, t.indexSize)
+	var srcBuf = make([]byte, srcBlockSize)
+	var dataBuf = make([]byte, t.blockDataSize(blockSize)+8)
+
+	// find the end of converted block data
+	var dataOffset int64
+	if t.checkpoint > 0 {
+		if err = t.readIndex(t.checkpoint-1, record); err != nil {
+			return err
+		}
+		count := 0
+		for _, b := range record[LenDataOffset:] {
+			count += bits.OnesCount8(b)
+		}
+		dataOffset = int64(binary.LittleEndian.Uint64(record[:LenDataOffset])) + int64(t.blockDataSize(count))
+	}
+	logging.CPrint(logging.INFO, fmt.Sprintf("load checkpoint for converting: %d/%d", t.checkpoint, t.blocks),
+		logging.LogFormat{"bit_length": bl, "pub_key": hex.EncodeToString(t.pk.SerializeCompressed()), "src": srcPath})
+
+	if _, err = f.Seek(massdb_v1.PosProofData+int64(t.checkpoint)*int64(srcBlockSize), io.SeekStart); err != nil {
+		return err
+	}
+	rd := bufio.NewReaderSize(f, convertBufferSize)
+
+	for block := t.checkpoint; block < t.blocks; block++ {
+		if n, err := io.ReadFull(rd, srcBuf); err != nil {
+			if err != io.ErrUnexpectedEOF && err != io.EOF {
+				return err
+			}
+			// tail of HashMapB might not be written if all records are empty
+			for i := n; i < len(srcBuf); i++ {
+				srcBuf[i] = 0
+			}
+		}
+
+		for i := range record {
+			record[i] = 0
+		}
+		for i := range dataBuf {
+			dataBuf[i] = 0
+		}
+		count := 0
+		for i := 0; i < blockSize; i++ {
+			x := pocutil.Bytes2PoCValue(srcBuf[i*recordSize*2:i*recordSize*2+recordSize], bl)
+			xp := pocutil.Bytes2PoCValue(srcBuf[i*recordSize*2+recordSize:(i+1)*recordSize*2], bl)
+			if x == 0 && xp == 0 {
+				continue
+			}
+			record[LenDataOffset+i/8] |= 1 << uint(i%8)
+			pos := uint64(count) * 2 * uint64(bl)
+			putBits(dataBuf, pos, bl, x)
+			putBits(dataBuf, pos+uint64(bl), bl, xp)
+			count++
+		}
+		binary.LittleEndian.PutUint64(record[:LenDataOffset], uint64(dataOffset))
+
+		size := t.blockDataSize(count)
+		if _, err = t.data.WriteAt(dataBuf[:size], t.dataStart+dataOffset); err != nil {
+			return err
+		}
+		if _, err = t.data.WriteAt(record, PosBlockIndex+int64(block)*int64(t.indexSize)); err != nil {
+			return err
+		}
+		dataOffset += int64(size)
+
+		var stopped bool
+		select {
+		case <-quit:
+			stopped = true
+		default:
+		}
+		if stopped || (block+1)%convertCheckpointBlock == 0 || block+1 == t.blocks {
+			if err = t.data.Sync(); err != nil { // write block data first
+				return err
+			}
+			t.checkpoint = block + 1
+			if err = t.updateCheckpoint(); err != nil { // then update checkpoint
+				return err
+			}
+		}
+		if stopped {
+			logging.CPrint(logging.INFO, "convert aborted",
+				logging.LogFormat{"bit_length": bl, "pub_key": hex.EncodeToString(t.pk.SerializeCompressed())})
+			return ErrStopConverting
+		}
+	}
+
+	return nil
+}
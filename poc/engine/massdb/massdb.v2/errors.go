@@ -0,0 +1,21 @@
+package massdb_v2
+
+import (
+	"errors"
+)
+
+var (
+	ErrDBWrongFileSize   = errors.New("db file size is invalid")
+	ErrDBWrongFileCode   = errors.New("db file code not matched")
+	ErrDBWrongVersion    = errors.New("db version not allowed")
+	ErrDBWrongPubKeyHash = errors.New("db pubKey hash is not matched with pubKey")
+	ErrDBWrongBlockBits  = errors.New("db block bits is not valid")
+	ErrDBNotReady        = errors.New("db is not ready")
+
+	ErrSourceWrongType  = errors.New("source db is not a massdb.v1 HashMapB")
+	ErrSourceNotPlotted = errors.New("source db is not fully plotted")
+	ErrSourceNotMatched = errors.New("source db is not matched with converting db")
+
+	ErrAlreadyPlotting = errors.New("db already been plotting")
+	ErrStopConverting  = errors.New("db stop converting")
+)
@@ -0,0 +1,345 @@
+package massdb_v2
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/poc/engine/massdb"
+	"massnet.org/mass/poc/engine/massdb/massdb.v1"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/pocec"
+)
+
+const (
+	TypeMassDBV2 = "massdb.v2"
+	suffixV2     = ".massdb2"
+)
+
+// MassDBV2 stores proofs in bit-packed blocks, it takes less space than massdb.v1
+// at the cost of an extra read on index for each proof.
+// MassDBV2 is plotted as massdb.v1 first, then converted into massdb.v2.
+type MassDBV2 struct {
+	table      *table              // nil before converting starts
+	plotDB     *massdb_v1.MassDBV1 // nil after converting finished
+	filePath   string
+	bl         int
+	pubKey     *pocec.PublicKey
+	pubKeyHash pocutil.Hash
+	plotting   int32 // atomic
+	stopPlotCh chan struct{}
+	wg         sync.WaitGroup
+}
+
+func (mdb *MassDBV2) Type() string {
+	return TypeMassDBV2
+}
+
+func (mdb *MassDBV2) Close() error {
+	<-mdb.StopPlot()
+
+	if mdb.plotDB != nil {
+		mdb.plotDB.Close()
+	}
+	if mdb.table != nil {
+		mdb.table.Close()
+	}
+	return nil
+}
+
+// Plot is concurrent safe, it plots massdb.v1 and converts it into massdb.v2,
+// running actual plot func as a thread
+func (mdb *MassDBV2) Plot() chan error {
+	result := make(chan error, 1)
+
+	if !atomic.CompareAndSwapInt32(&mdb.plotting, 0, 1) {
+		result <- ErrAlreadyPlotting
+		return result
+	}
+
+	if mdb.plotDB == nil {
+		atomic.StoreInt32(&mdb.plotting, 0)
+		result <- nil
+		return result
+	}
+
+	mdb.stopPlotCh = make(chan struct{})
+	mdb.wg.Add(1)
+	go mdb.executePlot(result)
+
+	return result
+}
+
+func (mdb *MassDBV2) executePlot(result chan error) {
+	var err error
+	defer func() {
+		result <- err
+		atomic.StoreInt32(&mdb.plotting, 0)
+		mdb.wg.Done()
+	}()
+
+	if !mdb.plotDB.Ready() {
+		if err = <-mdb.plotDB.Plot(); err != nil || !mdb.plotDB.Ready() {
+			// plotting is stopped or failed
+			return
+		}
+	}
+
+	if mdb.table == nil {
+		if mdb.table, err = createTable(mdb.filePath, mdb.bl, mdb.pubKey); err != nil {
+			logging.CPrint(logging.ERROR, "fail on creating massdb.v2 file",
+				logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "err": err})
+			return
+		}
+	}
+	if err = mdb.table.convertFrom(mdb.plotDB.FilePath(), mdb.stopPlotCh); err != nil {
+		if err == ErrStopConverting {
+			err = nil
+			return
+		}
+		logging.CPrint(logging.ERROR, "convert fail",
+			logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "err": err})
+		return
+	}
+
+	logging.CPrint(logging.INFO, "remove massdb.v1 files",
+		logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed())})
+	if errDel := <-mdb.plotDB.Delete(); errDel != nil {
+		logging.CPrint(logging.WARN, "fail on removing massdb.v1 files",
+			logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed()), "err": errDel})
+	}
+	mdb.plotDB = nil
+	logging.CPrint(logging.INFO, "plot finished",
+		logging.LogFormat{"bit_length": mdb.bl, "pub_key": hex.EncodeToString(mdb.pubKey.SerializeCompressed())})
+}
+
+// StopPlot stops plot process
+func (mdb *MassDBV2) StopPlot() chan error {
+	result := make(chan error, 1)
+
+	if atomic.LoadInt32(&mdb.plotting) == 0 {
+		result <- nil
+		return result
+	}
+
+	go func() {
+		close(mdb.stopPlotCh)
+		if plotDB := mdb.plotDB; plotDB != nil {
+			<-plotDB.StopPlot()
+		}
+		mdb.wg.Wait()
+		result <- nil
+	}()
+	return result
+}
+
+func (mdb *MassDBV2) Ready() bool {
+	return mdb.plotDB == nil
+}
+
+func (mdb *MassDBV2) BitLength() int {
+	return mdb.bl
+}
+
+func (mdb *MassDBV2) PubKeyHash() pocutil.Hash {
+	return mdb.pubKeyHash
+}
+
+func (mdb *MassDBV2) PubKey() *pocec.PublicKey {
+	return mdb.pubKey
+}
+
+func (mdb *MassDBV2) Get(z pocutil.PoCValue) (x, xp pocutil.PoCValue, err error) {
+	if !mdb.Ready() {
+		return 0, 0, ErrDBNotReady
+	}
+	return mdb.table.Get(z)
+}
+
+func (mdb *MassDBV2) GetProof(challenge pocutil.Hash) (*poc.Proof, error) {
+	var bl = mdb.bl
+	x, xp, err := mdb.Get(pocutil.CutHash(challenge, bl))
+	if err != nil {
+		return nil, err
+	}
+	proof := &poc.Proof{
+		X:         pocutil.PoCValue2Bytes(x, bl),
+		XPrime:    pocutil.PoCValue2Bytes(xp, bl),
+		BitLength: bl,
+	}
+	err = poc.VerifyProof(proof, mdb.pubKeyHash, challenge)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// Progress counts converting as half of the plotting work on HashMapB of massdb.v1.
+func (mdb *MassDBV2) Progress() (prePlotted, plotted bool, progress float64) {
+	plotDB := mdb.plotDB
+	if plotDB == nil {
+		return true, true, 100
+	}
+
+	var volume = float64(uint64(1) << uint(mdb.bl))
+	var plotProgress float64
+	prePlotted, _, plotProgress = plotDB.Progress()
+	current := plotProgress / 100 * volume * 2
+	if t := mdb.table; t != nil {
+		_, checkpoint := t.Progress()
+		current += float64(checkpoint<<uint(t.blockBits)) / 2
+	}
+	return prePlotted, false, current * 100 / (volume * 2.5)
+}
+
+func (mdb *MassDBV2) Delete() chan error {
+	result := make(chan error, 1)
+
+	if atomic.LoadInt32(&mdb.plotting) != 0 {
+		result <- ErrAlreadyPlotting
+		return result
+	}
+
+	var errV1 = make(chan error, 1)
+	if mdb.plotDB != nil {
+		errV1 = mdb.plotDB.Delete()
+	} else {
+		errV1 <- nil
+	}
+	if mdb.table != nil {
+		mdb.table.Close()
+	}
+
+	go func() {
+		errs := make([]string, 0, 2)
+		if err := <-errV1; err != nil {
+			errs = append(errs, "v1 "+err.Error())
+		}
+		if err := os.Remove(mdb.filePath); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, "v2 "+err.Error())
+		}
+		if len(errs) == 0 {
+			result <- nil
+		} else {
+			result <- errors.New(strings.Join(errs, " "))
+		}
+	}()
+	return result
+}
+
+func OpenDB(args ...interface{}) (massdb.MassDB, error) {
+	dbPath, ordinal, pubKey, bitLength, err := parseArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	mdb := &MassDBV2{
+		filePath:   getPath(dbPath, int(ordinal), pubKey, bitLength),
+		bl:         bitLength,
+		pubKey:     pubKey,
+		pubKeyHash: pocutil.PubKeyHash(pubKey),
+	}
+	t, err := loadTable(mdb.filePath)
+	switch err {
+	case nil:
+		mdb.table = t
+		if converted, _ := t.Progress(); converted {
+			return mdb, nil
+		}
+	case massdb.ErrDBDoesNotExist:
+	default:
+		return nil, err
+	}
+
+	// massdb.v1 is required before converting finished
+	plotDB, err := massdb.OpenDB(massdb_v1.TypeMassDBV1, args...)
+	if err != nil {
+		if t != nil {
+			t.Close()
+		}
+		return nil, err
+	}
+	mdb.plotDB = plotDB.(*massdb_v1.MassDBV1)
+
+	return mdb, nil
+}
+
+func CreateDB(args ...interface{}) (massdb.MassDB, error) {
+	dbPath, ordinal, pubKey, bitLength, err := parseArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+	if bitLength < defaultBlockBits {
+		return nil, massdb.ErrUnsupportedBitLength
+	}
+
+	// partially converted file is useless without massdb.v1
+	filePath := getPath(dbPath, int(ordinal), pubKey, bitLength)
+	if t, err := loadTable(filePath); err == nil {
+		converted, _ := t.Progress()
+		t.Close()
+		if converted {
+			return nil, massdb.ErrDBAlreadyExists
+		}
+		if err = os.Remove(filePath); err != nil {
+			return nil, err
+		}
+	}
+	plotDB, err := massdb.CreateDB(massdb_v1.TypeMassDBV1, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MassDBV2{
+		plotDB:     plotDB.(*massdb_v1.MassDBV1),
+		filePath:   filePath,
+		bl:         bitLength,
+		pubKey:     pubKey,
+		pubKeyHash: pocutil.PubKeyHash(pubKey),
+	}, nil
+}
+
+func getPath(rootPath string, ordinal int, pubKey *pocec.PublicKey, bitLength int) string {
+	pubKeyString := hex.EncodeToString(pubKey.SerializeCompressed())
+	fileName := strings.Join([]string{strconv.Itoa(ordinal), pubKeyString, strconv.Itoa(bitLength)}, "_") + suffixV2
+	return filepath.Join(rootPath, fileName)
+}
+
+func parseArgs(args ...interface{}) (string, int64, *pocec.PublicKey, int, error) {
+	if len(args) != 4 {
+		return "", 0, nil, 0, massdb.ErrInvalidDBArgs
+	}
+	dbPath, ok := args[0].(string)
+	if !ok {
+		return "", 0, nil, 0, massdb.ErrInvalidDBArgs
+	}
+	ordinal, ok := args[1].(int64)
+	if !ok {
+		return "", 0, nil, 0, massdb.ErrInvalidDBArgs
+	}
+	pubKey, ok := args[2].(*pocec.PublicKey)
+	if !ok {
+		return "", 0, nil, 0, massdb.ErrInvalidDBArgs
+	}
+	bitLength, ok := args[3].(int)
+	if !ok {
+		return "", 0, nil, 0, massdb.ErrInvalidDBArgs
+	}
+
+	return dbPath, ordinal, pubKey, bitLength, nil
+}
+
+func init() {
+	massdb.AddDBBackend(massdb.DBBackend{
+		Typ:      TypeMassDBV2,
+		OpenDB:   OpenDB,
+		CreateDB: CreateDB,
+	})
+}
@@ -0,0 +1,271 @@
+package massdb_v2
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc/engine/massdb"
+	"massnet.org/mass/poc/engine/massdb/massdb.v1"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/pocec"
+)
+
+const (
+	testBL      = 16
+	benchBL     = 22
+	testOrdinal = int64(1)
+)
+
+var (
+	testDirs   []string
+	benchOnce  sync.Once
+	benchV1    *massdb_v1.MassDBV1
+	benchV2    *MassDBV2
+	benchError error
+)
+
+func init() {
+	logging.Init("/tmp", "tmp-mass.log", logging.DebugLevel, 1, false)
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if benchV1 != nil {
+		benchV1.Close()
+	}
+	if benchV2 != nil {
+		benchV2.Close()
+	}
+	for _, dir := range testDirs {
+		os.RemoveAll(dir)
+	}
+	os.Exit(code)
+}
+
+func newTestDir(tb testing.TB) string {
+	dir, err := ioutil.TempDir("", "massdb_v2_test")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	testDirs = append(testDirs, dir)
+	return dir
+}
+
+func newTestPubKey(tb testing.TB) *pocec.PublicKey {
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return sk.PubKey()
+}
+
+// plotV1 plots massdb.v1 in a new dir.
+func plotV1(tb testing.TB, pk *pocec.PublicKey, bl int) *massdb_v1.MassDBV1 {
+	mdb, err := massdb_v1.NewMassDBV1(newTestDir(tb), testOrdinal, pk, bl)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if err = <-mdb.Plot(); err != nil {
+		tb.Fatal(err)
+	}
+	return mdb
+}
+
+func checkSameProofs(t *testing.T, v1 *massdb_v1.MassDBV1, v2 *MassDBV2) {
+	for z := pocutil.PoCValue(0); z < 1<<uint(v1.BitLength()); z++ {
+		x1, xp1, err := v1.Get(z)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x2, xp2, err := v2.Get(z)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x1 != x2 || xp1 != xp2 {
+			t.Fatalf("proof not matched for z %d, v1 (%d, %d), v2 (%d, %d)", z, x1, xp1, x2, xp2)
+		}
+	}
+}
+
+func TestBits(t *testing.T) {
+	for bl := 12; bl <= 40; bl++ {
+		buf := make([]byte, 64)
+		values := make([]pocutil.PoCValue, 8)
+		for i := range values {
+			values[i] = pocutil.PoCValue(rand.Uint64() & (1<<uint(bl) - 1))
+			putBits(buf, uint64(i*bl), bl, values[i])
+		}
+		for i, v := range values {
+			if got := getBits(buf, uint64(i*bl), bl); got != v {
+				t.Errorf("bl %d, index %d, expected %d, got %d", bl, i, v, got)
+			}
+		}
+	}
+}
+
+func TestConvertFromV1(t *testing.T) {
+	pk := newTestPubKey(t)
+	v1 := plotV1(t, pk, testBL)
+	defer v1.Close()
+
+	dir := newTestDir(t)
+	dstPath := getPath(dir, int(testOrdinal), pk, testBL)
+	if err := ConvertFromV1(v1.FilePath(), dstPath); err != nil {
+		t.Fatal(err)
+	}
+	v2, err := OpenDB(dir, testOrdinal, pk, testBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer v2.Close()
+	if !v2.Ready() {
+		t.Fatal("converted massdb.v2 is not ready")
+	}
+	checkSameProofs(t, v1, v2.(*MassDBV2))
+
+	fi1, err := os.Stat(v1.FilePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi2, err := os.Stat(dstPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi2.Size() >= fi1.Size() {
+		t.Errorf("massdb.v2 is not smaller than massdb.v1, v1 %d, v2 %d", fi1.Size(), fi2.Size())
+	}
+	t.Logf("bl %d, v1 size %d, v2 size %d (%.2f%%)", testBL, fi1.Size(), fi2.Size(), float64(fi2.Size())*100/float64(fi1.Size()))
+
+	if err = ConvertFromV1(v1.FilePath(), v1.FilePath()); err != ErrDBWrongVersion {
+		t.Errorf("converting into massdb.v1 file, expected %v, got %v", ErrDBWrongVersion, err)
+	}
+}
+
+func TestResumeConvert(t *testing.T) {
+	pk := newTestPubKey(t)
+	v1 := plotV1(t, pk, testBL)
+	defer v1.Close()
+
+	dir := newTestDir(t)
+	dstPath := getPath(dir, int(testOrdinal), pk, testBL)
+	tb, err := createTable(dstPath, testBL, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// stop converting at the first checkpoint
+	quit := make(chan struct{})
+	close(quit)
+	if err = tb.convertFrom(v1.FilePath(), quit); err != ErrStopConverting {
+		t.Fatalf("expected %v, got %v", ErrStopConverting, err)
+	}
+	tb.Close()
+
+	tb, err = loadTable(dstPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if converted, checkpoint := tb.Progress(); converted || checkpoint == 0 {
+		t.Fatalf("unexpected checkpoint %d/%d", checkpoint, tb.blocks)
+	}
+	tb.Close()
+
+	if err = ConvertFromV1(v1.FilePath(), dstPath); err != nil {
+		t.Fatal(err)
+	}
+	v2, err := OpenDB(dir, testOrdinal, pk, testBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer v2.Close()
+	checkSameProofs(t, v1, v2.(*MassDBV2))
+}
+
+func TestPlot(t *testing.T) {
+	pk := newTestPubKey(t)
+	v1 := plotV1(t, pk, testBL)
+	defer v1.Close()
+
+	dir := newTestDir(t)
+	mdb, err := massdb.CreateDB(TypeMassDBV2, dir, testOrdinal, pk, testBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mdb.Ready() {
+		t.Fatal("massdb.v2 should not be ready before plotting")
+	}
+	if err = <-mdb.Plot(); err != nil {
+		t.Fatal(err)
+	}
+	if !mdb.Ready() {
+		t.Fatal("massdb.v2 is not ready after plotting")
+	}
+	if _, plotted, progress := mdb.Progress(); !plotted || progress != 100 {
+		t.Errorf("unexpected progress %v %f", plotted, progress)
+	}
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fis) != 1 || fis[0].Name() != getPath("", int(testOrdinal), pk, testBL) {
+		t.Errorf("massdb.v1 files should be removed after plotting, %d files left", len(fis))
+	}
+	mdb.Close()
+
+	mdb, err = massdb.OpenDB(TypeMassDBV2, dir, testOrdinal, pk, testBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mdb.Close()
+	checkSameProofs(t, v1, mdb.(*MassDBV2))
+	if _, err = massdb.CreateDB(TypeMassDBV2, dir, testOrdinal, pk, testBL); err != massdb.ErrDBAlreadyExists {
+		t.Errorf("expected %v, got %v", massdb.ErrDBAlreadyExists, err)
+	}
+}
+
+func prepareBench(b *testing.B) {
+	benchOnce.Do(func() {
+		pk := newTestPubKey(b)
+		benchV1 = plotV1(b, pk, benchBL)
+		dir := newTestDir(b)
+		if benchError = ConvertFromV1(benchV1.FilePath(), getPath(dir, int(testOrdinal), pk, benchBL)); benchError != nil {
+			return
+		}
+		var mdb massdb.MassDB
+		if mdb, benchError = OpenDB(dir, testOrdinal, pk, benchBL); benchError == nil {
+			benchV2 = mdb.(*MassDBV2)
+		}
+	})
+	if benchError != nil {
+		b.Fatal(benchError)
+	}
+}
+
+func benchChallenges(n int) []pocutil.Hash {
+	challenges := make([]pocutil.Hash, n)
+	for i := range challenges {
+		rand.Read(challenges[i][:])
+	}
+	return challenges
+}
+
+func BenchmarkGetProofV1(b *testing.B) {
+	prepareBench(b)
+	challenges := benchChallenges(b.N)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchV1.GetProof(challenges[i])
+	}
+}
+
+func BenchmarkGetProofV2(b *testing.B) {
+	prepareBench(b)
+	challenges := benchChallenges(b.N)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchV2.GetProof(challenges[i])
+	}
+}
@@ -0,0 +1,268 @@
+package massdb_v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/bits"
+	"os"
+	"path/filepath"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc/engine/massdb"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/pocec"
+)
+
+// File struct for massdb.v2
+// | Position | Length in Bytes |     Name    |         Rule         |
+// | :------: | :-------------: | :---------: | :------------------ |
+// |    0     |        32       | FileCode    | Fixed hex string `52A7AD74C4929DEC7B5C8D46CC3BAFA81FC96129283B3A6923CD12F41A30B3AC` |
+// |   32     |         8       | Version     | DB version, currently use 2 |
+// |   40     |         1       | BitLength   | BitLength for table |
+// |   41     |         1       | BlockBits   | Count of Z in one block is `1 << BlockBits` |
+// |   42     |         8       | Checkpoint  | Count of converted blocks, Little Endian Encoded |
+// |   50     |        32       | PubKeyHash  | SHA256(SHA256(PubKey)) |
+// |   82     |        33       | PubKey      | PubKey |
+// |  115     |      3981       | AlignHolder | Fill `\0` to align to 4096 Bytes |
+// |  4096    |      I * N      | BlockIndex  | N records of block index, N = 1 << (BitLength - BlockBits) |
+// |  ...     |       ...      | BlockData   | Bit-packed proof data of blocks |
+type table struct {
+	data       *os.File
+	bl         int
+	blockBits  int
+	blocks     uint64
+	checkpoint uint64
+	indexSize  int   // I, size of one block index record
+	dataStart  int64 // position of BlockData
+	pk         *pocec.PublicKey
+	pkHash     pocutil.Hash
+}
+
+// Block index record, length in bytes is I = 8 + (1 << BlockBits) / 8
+// | Offset | Length in Bytes |     Name     |         Rule        |
+// | :----: | :-------------: | :----------- | :------------------ |
+// |    0   |         8       | DataOffset   | Offset of block data from BlockData, Little Endian Encoded |
+// |    8   |     I - 8       | Bitmap       | Bit `z` is set if Z(`z`) has proof, LSB first |
+//
+// Block data
+// Define `k` as the count of set bits before `z` in Bitmap, L = BitLength
+// |  Offset in Bits  | Length in Bits |     Name     |         Rule        |
+// | :--------------: | :------------: | :----------- | :------------------ |
+// |       2Lk        |        L       | Z(`z`) -> X  | Value X for `Z = z`, LSB first |
+// |     2Lk + L      |        L       | Z(`z`) -> XP | Value XP for `Z = z`, LSB first |
+// Block data starts at byte boundary, and is padded with `0` to byte boundary.
+
+const (
+	dbVersion = 2
+
+	defaultBlockBits = 12
+
+	// Positions for meta info in file
+	PosFileCode    = 0
+	PosVersion     = 32
+	PosBitLength   = 40
+	PosBlockBits   = 41
+	PosCheckpoint  = 42
+	PosPubKeyHash  = 50
+	PosPubKey      = 82
+	PosAlignHolder = 115
+	PosBlockIndex  = 4096
+
+	// Lengths for meta info in file
+	LenFileCode    = 32
+	LenVersion     = 8
+	LenBitLength   = 1
+	LenBlockBits   = 1
+	LenCheckpoint  = 8
+	LenPubKeyHash  = 32
+	LenPubKey      = 33
+	LenAlignHolder = 3981
+	LenMetaInfo    = 4096
+	LenDataOffset  = 8
+)
+
+func newTable(f *os.File, bl, blockBits int, pk *pocec.PublicKey, pkHash pocutil.Hash) *table {
+	t := &table{
+		data:      f,
+		bl:        bl,
+		blockBits: blockBits,
+		blocks:    1 << uint(bl-blockBits),
+		indexSize: LenDataOffset + (1<<uint(blockBits))/8,
+		pk:        pk,
+		pkHash:    pkHash,
+	}
+	t.dataStart = PosBlockIndex + int64(t.blocks)*int64(t.indexSize)
+	return t
+}
+
+func createTable(filePath string, bl int, pubKey *pocec.PublicKey) (*table, error) {
+	// If file already exists, return error
+	_, err := os.Stat(filePath)
+	if err == nil || os.IsExist(err) {
+		return nil, massdb.ErrDBAlreadyExists
+	}
+
+	var blockBits = defaultBlockBits
+	if bl < blockBits {
+		return nil, massdb.ErrUnsupportedBitLength
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0666)
+	if nil != err {
+		return nil, err
+	}
+
+	var (
+		fileHeaderBytes [LenMetaInfo]byte
+		b8              [8]byte
+	)
+	pkHash := pocutil.PubKeyHash(pubKey)
+	copy(fileHeaderBytes[PosFileCode:], massdb.DBFileCode)
+	binary.LittleEndian.PutUint64(b8[:], dbVersion)
+	copy(fileHeaderBytes[PosVersion:], b8[:])
+	fileHeaderBytes[PosBitLength] = byte(bl)
+	fileHeaderBytes[PosBlockBits] = byte(blockBits)
+	binary.LittleEndian.PutUint64(b8[:], 0)
+	copy(fileHeaderBytes[PosCheckpoint:], b8[:])
+	copy(fileHeaderBytes[PosPubKeyHash:], pkHash[:])
+	copy(fileHeaderBytes[PosPubKey:], pubKey.SerializeCompressed())
+
+	if _, err = f.WriteAt(fileHeaderBytes[:], 0); err != nil {
+		f.Close()
+		os.Remove(filePath)
+		return nil, err
+	}
+
+	return newTable(f, bl, blockBits, pubKey, pkHash), nil
+}
+
+func loadTable(filePath string) (*table, error) {
+	if _, err := os.Stat(filePath); err != nil && !os.IsExist(err) {
+		return nil, massdb.ErrDBDoesNotExist
+	}
+	f, err := os.OpenFile(filePath, os.O_RDWR, 0666)
+	if nil != err {
+		return nil, err
+	}
+
+	var failureReturn = func(err error) (*table, error) {
+		f.Close()
+		return nil, err
+	}
+
+	var fileHeaderBytes [LenMetaInfo]byte
+	if n, err := f.ReadAt(fileHeaderBytes[:], 0); err != nil {
+		logging.CPrint(logging.ERROR, "massdb fileSize is smaller than expected", logging.LogFormat{"expected": LenMetaInfo, "actual": n})
+		return failureReturn(ErrDBWrongFileSize)
+	}
+
+	// Check fileCode
+	if !bytes.Equal(fileHeaderBytes[PosFileCode:PosFileCode+LenFileCode], massdb.DBFileCode) {
+		return failureReturn(ErrDBWrongFileCode)
+	}
+	// Check dbVersion
+	if ver := binary.LittleEndian.Uint64(fileHeaderBytes[PosVersion:]); ver != dbVersion {
+		logging.CPrint(logging.ERROR, "massdb version not matched", logging.LogFormat{"expected": dbVersion, "actual": ver})
+		return failureReturn(ErrDBWrongVersion)
+	}
+	bl := int(fileHeaderBytes[PosBitLength])
+	blockBits := int(fileHeaderBytes[PosBlockBits])
+	if blockBits < 6 || blockBits > defaultBlockBits || blockBits > bl {
+		return failureReturn(ErrDBWrongBlockBits)
+	}
+	var pkHash pocutil.Hash
+	copy(pkHash[:], fileHeaderBytes[PosPubKeyHash:])
+	pk, err := pocec.ParsePubKey(fileHeaderBytes[PosPubKey:PosPubKey+LenPubKey], pocec.S256())
+	if err != nil {
+		return failureReturn(err)
+	}
+	// check pubKey with pubKeyHash
+	if pkHash != pocutil.PubKeyHash(pk) {
+		return failureReturn(ErrDBWrongPubKeyHash)
+	}
+
+	t := newTable(f, bl, blockBits, pk, pkHash)
+	t.checkpoint = binary.LittleEndian.Uint64(fileHeaderBytes[PosCheckpoint:])
+	return t, nil
+}
+
+func (t *table) Close() error {
+	return t.data.Close()
+}
+
+// Progress returns true if all blocks are converted.
+func (t *table) Progress() (bool, uint64) {
+	checkpoint := t.checkpoint
+	return checkpoint >= t.blocks, checkpoint
+}
+
+func (t *table) updateCheckpoint() error {
+	var checkpointByte [LenCheckpoint]byte
+	binary.LittleEndian.PutUint64(checkpointByte[:], t.checkpoint)
+	if _, err := t.data.WriteAt(checkpointByte[:], PosCheckpoint); err != nil {
+		return err
+	}
+	return t.data.Sync()
+}
+
+func (t *table) readIndex(block uint64, record []byte) error {
+	if n, _ := t.data.ReadAt(record[:t.indexSize], PosBlockIndex+int64(block)*int64(t.indexSize)); n < t.indexSize {
+		return massdb.ErrDBCorrupted
+	}
+	return nil
+}
+
+// blockDataSize returns size of block data with count of proofs.
+func (t *table) blockDataSize(count int) int {
+	return (count*2*t.bl + 7) / 8
+}
+
+// Get returns (X, XP) for Z, both are zero if Z has no proof.
+func (t *table) Get(z pocutil.PoCValue) (x, xp pocutil.PoCValue, err error) {
+	var record [LenDataOffset + (1<<defaultBlockBits)/8]byte
+	var block, pos = uint64(z) >> uint(t.blockBits), uint64(z) & (1<<uint(t.blockBits) - 1)
+	if err = t.readIndex(block, record[:]); err != nil {
+		return 0, 0, err
+	}
+
+	bitmap := record[LenDataOffset:t.indexSize]
+	if bitmap[pos/8]&(1<<(pos%8)) == 0 {
+		return 0, 0, nil
+	}
+	rank := 0
+	for i := uint64(0); i < pos/64; i++ {
+		rank += bits.OnesCount64(binary.LittleEndian.Uint64(bitmap[i*8:]))
+	}
+	if rem := pos % 64; rem != 0 {
+		rank += bits.OnesCount64(binary.LittleEndian.Uint64(bitmap[pos/64*8:]) & (1<<rem - 1))
+	}
+
+	var buf [24]byte
+	startBit := uint64(rank) * 2 * uint64(t.bl)
+	shift := startBit % 8
+	size := (int(shift) + 2*t.bl + 7) / 8
+	target := t.dataStart + int64(binary.LittleEndian.Uint64(record[:LenDataOffset])) + int64(startBit/8)
+	if n, _ := t.data.ReadAt(buf[:size], target); n < size {
+		return 0, 0, massdb.ErrDBCorrupted
+	}
+	return getBits(buf[:], shift, t.bl), getBits(buf[:], shift+uint64(t.bl), t.bl), nil
+}
+
+// getBits reads bl bits started from bit pos in buf, buf should have
+// at least 8 bytes after byte pos/8.
+func getBits(buf []byte, pos uint64, bl int) pocutil.PoCValue {
+	v := binary.LittleEndian.Uint64(buf[pos/8:]) >> (pos % 8)
+	return pocutil.PoCValue(v & (1<<uint(bl) - 1))
+}
+
+// putBits writes bl bits of v started from bit pos in zero-filled buf, buf should have
+// at least 8 bytes after byte pos/8.
+func putBits(buf []byte, pos uint64, bl int, v pocutil.PoCValue) {
+	w := binary.LittleEndian.Uint64(buf[pos/8:])
+	w |= (uint64(v) & (1<<uint(bl) - 1)) << (pos % 8)
+	binary.LittleEndian.PutUint64(buf[pos/8:], w)
+}
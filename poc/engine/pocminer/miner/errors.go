@@ -9,4 +9,10 @@ var (
 	errAvoidDoubleMining = errors.New("sleep mining for 1 second to avoid double mining")
 	errBestChainSwitched = errors.New("best chain has been switched")
 	ErrNoPayoutAddresses = errors.New("can not mine without payout addresses")
+	ErrGenerateOnMining  = errors.New("can not generate blocks while miner is running")
+	ErrBlockRejected     = errors.New("generated block is rejected")
+
+	ErrSimulateInvalidCount      = errors.New("count of simulated blocks is out of range")
+	ErrSimulateNoBlocks          = errors.New("no block to simulate")
+	ErrSimulateChallengeMismatch = errors.New("recomputed challenge differs from recorded")
 )
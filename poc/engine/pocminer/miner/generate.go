@@ -0,0 +1,169 @@
+package miner
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"time"
+
+	"massnet.org/mass/blockchain"
+	"massnet.org/mass/config"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/wire"
+)
+
+// GenerateBlocks generates count blocks on top of the best chain and pays to
+// payoutAddress. Proofs are taken from plotted spaces (ready or mining), and
+// the earliest slot with a qualified proof is used without waiting for it, so
+// it is only intended for regression tests.
+func (m *PoCMiner) GenerateBlocks(count int, payoutAddress massutil.Address) ([]*wire.Hash, error) {
+	m.generateLock.Lock()
+	defer m.generateLock.Unlock()
+
+	if m.mining {
+		return nil, ErrGenerateOnMining
+	}
+	if !m.SpaceKeeper.Started() {
+		if err := m.SpaceKeeper.Start(); err != nil {
+			return nil, err
+		}
+	}
+
+	quit := make(chan struct{})
+	hashes := make([]*wire.Hash, 0, count)
+	for i := 0; i < count; i++ {
+		newBlock, minerReward, err := m.generateBlock(payoutAddress, quit)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "fail to generate block", logging.LogFormat{"err": err, "generated": len(hashes)})
+			return hashes, err
+		}
+		block := massutil.NewBlock(newBlock)
+		isOrphan, err := m.chain.ProcessBlock(block)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "generated block rejected",
+				logging.LogFormat{"err": err, "hash": block.Hash(), "height": block.Height()})
+			return hashes, err
+		}
+		if isOrphan {
+			logging.CPrint(logging.ERROR, "generated block is an orphan",
+				logging.LogFormat{"hash": block.Hash(), "height": block.Height()})
+			return hashes, ErrBlockRejected
+		}
+		logging.CPrint(logging.INFO, "generated block accepted",
+			logging.LogFormat{
+				"height":     block.Height(),
+				"hash":       block.Hash(),
+				"amount":     minerReward,
+				"public_key": hex.EncodeToString(block.MsgBlock().Header.PubKey.SerializeCompressed()),
+				"bit_length": block.MsgBlock().Header.Proof.BitLength,
+			})
+
+		// send block to NetSync to broadcast it, without blocking generating
+		select {
+		case m.newBlockCh <- block.Hash():
+		default:
+			logging.CPrint(logging.WARN, "generated block is not broadcast",
+				logging.LogFormat{"hash": block.Hash(), "height": block.Height()})
+		}
+		hashes = append(hashes, block.Hash())
+	}
+
+	return hashes, nil
+}
+
+// generateBlock works like solveBlock, except that it searches qualified proof
+// from the slot after previous block, until the last slot by maxGenerateSlot.
+func (m *PoCMiner) generateBlock(payoutAddress massutil.Address, quit chan struct{}) (*wire.MsgBlock, massutil.Amount, error) {
+	templateCh := make(chan interface{}, 2)
+	if err := m.chain.NewBlockTemplate(payoutAddress, templateCh); err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+
+	pocTemplateI, err := getTemplate(quit, templateCh, reflect.TypeOf(&blockchain.PoCTemplate{}))
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	pocTemplate := pocTemplateI.(*blockchain.PoCTemplate)
+
+	tProof, err := m.generateBestProof(pocTemplate)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+
+	blockTemplateI, err := getTemplate(quit, templateCh, reflect.TypeOf(&blockchain.BlockTemplate{}))
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	blockTemplate := blockTemplateI.(*blockchain.BlockTemplate)
+
+	block, minerReward, err := assembleFullBlock(blockTemplate, pocTemplate, tProof, nil)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	pocHash, err := block.Header.PoCHash()
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	block.Header.Signature, err = m.SpaceKeeper.SignHash(tProof.proof.SpaceID, pocHash)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+
+	return block, minerReward, nil
+}
+
+func (m *PoCMiner) generateBestProof(pocTemplate *blockchain.PoCTemplate) (*ProofTemplate, error) {
+	var challenge = pocutil.Hash(pocTemplate.Challenge)
+	skProofs, err := m.SpaceKeeper.GetProofs(context.TODO(), engine.SFReady|engine.SFMining, challenge)
+	if err != nil {
+		return nil, err
+	}
+	proofs := getValidProofs(skProofs)
+	if len(proofs) == 0 {
+		return nil, errNoValidProof
+	}
+
+	timestamp := pocTemplate.Timestamp
+	lastSlot := maxGenerateSlot(time.Now())
+	for workSlot := uint64(timestamp.Unix()) / pocSlot; workSlot <= lastSlot; workSlot++ {
+		qualities, err := getQualities(proofs, challenge, workSlot, pocTemplate.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		var bestProofIndex int
+		var bestQuality = big.NewInt(0)
+		for i, quality := range qualities {
+			if quality.Cmp(bestQuality) > 0 {
+				bestQuality = quality
+				bestProofIndex = i
+			}
+		}
+
+		if bestQuality.Cmp(pocTemplate.GetTarget(timestamp)) > 0 {
+			return &ProofTemplate{
+				proof:   proofs[bestProofIndex],
+				time:    timestamp,
+				quality: bestQuality,
+			}, nil
+		}
+		timestamp = timestamp.Add(pocSlot * time.Second)
+	}
+
+	return nil, errNoValidProof
+}
+
+// maxGenerateSlot returns the last slot to search proofs for generating. On
+// regtest slots are allowed to be ahead of now, as long as the block time is
+// not too far in the future to be accepted, so that many blocks are generated
+// at once.
+func maxGenerateSlot(now time.Time) uint64 {
+	if config.ChainParams.Name == config.RegressionNetParams.Name {
+		return uint64(now.Unix()+blockchain.MaxTimeOffsetSeconds) / pocSlot
+	}
+	return uint64(now.Unix())/pocSlot + allowAhead
+}
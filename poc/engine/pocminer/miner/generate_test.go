@@ -0,0 +1,200 @@
+package miner
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"massnet.org/mass/blockchain"
+	"massnet.org/mass/config"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/engine/spacekeeper"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/pocec"
+	"massnet.org/mass/wire"
+)
+
+// proof of testPubKey on testChallenge
+var (
+	testChallenge = "f17a8b5534fb1a9d34c831d0766fbc77b0b718500412c6647f48fda0dd8fa780"
+	testPubKey    = "02be7ff1bbbd42b808cb6b7de2d22cd53dea771c9c599fb034c7b15bae0ec53eb3"
+	testProof     = &poc.Proof{
+		X:         []byte{0xeb, 0xd0, 0x8b, 0xeb},
+		XPrime:    []byte{0x98, 0x87, 0x63, 0x0a},
+		BitLength: 32,
+	}
+)
+
+// mockChain always gives templates of testChallenge, the next block is one
+// slot after the best block.
+type mockChain struct {
+	challenge wire.Hash
+	best      *wire.BlockHeader
+	bestHash  wire.Hash
+	processed []*massutil.Block
+}
+
+func newMockChain(t *testing.T, timestamp time.Time) *mockChain {
+	challenge, err := pocutil.DecodeStringToHash(testChallenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &mockChain{
+		challenge: wire.Hash(challenge),
+		best:      &wire.BlockHeader{Timestamp: timestamp},
+	}
+}
+
+func (c *mockChain) BestBlockNode() *blockchain.BlockNode {
+	return blockchain.NewBlockNode(c.best, &c.bestHash, blockchain.BFNoPoCCheck)
+}
+
+func (c *mockChain) BestBlockHash() *wire.Hash {
+	return &c.bestHash
+}
+
+func (c *mockChain) BestBlockHeight() uint64 {
+	return c.best.Height
+}
+
+func (c *mockChain) GetHeaderByHeight(height uint64) (*wire.BlockHeader, error) {
+	return c.best, nil
+}
+
+func (c *mockChain) ProcessBlock(block *massutil.Block) (bool, error) {
+	c.best = &block.MsgBlock().Header
+	c.bestHash = *block.Hash()
+	c.processed = append(c.processed, block)
+	return false, nil
+}
+
+func (c *mockChain) ChainID() *wire.Hash {
+	return &wire.Hash{}
+}
+
+func (c *mockChain) BlockWaiter(height uint64) (<-chan *blockchain.BlockNode, error) {
+	return nil, nil
+}
+
+func (c *mockChain) NewBlockTemplate(addr massutil.Address, ch chan interface{}) error {
+	getCoinbase := func(pk *pocec.PublicKey, fee massutil.Amount, bl int) (*massutil.Tx, error) {
+		coinbase := wire.NewMsgTx()
+		coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil))
+		coinbase.AddTxOut(wire.NewTxOut(int64(bl), nil))
+		return massutil.NewTx(coinbase), nil
+	}
+	coinbase, _ := getCoinbase(nil, massutil.ZeroAmount(), 0)
+	height := c.best.Height + 1
+	block := wire.NewEmptyMsgBlock()
+	block.Header.Height = height
+	block.Header.Previous = c.bestHash
+	block.Transactions = []*wire.MsgTx{coinbase.MsgTx()}
+
+	ch <- &blockchain.PoCTemplate{
+		Height:      height,
+		Timestamp:   c.best.Timestamp.Add(pocSlot * time.Second),
+		Previous:    c.bestHash,
+		Challenge:   c.challenge,
+		GetTarget:   func(time.Time) *big.Int { return big.NewInt(1) },
+		GetCoinbase: getCoinbase,
+	}
+	ch <- &blockchain.BlockTemplate{
+		Block:              block,
+		Height:             height,
+		MerkleCache:        []*wire.Hash{coinbase.Hash()},
+		WitnessMerkleCache: []*wire.Hash{coinbase.WitnessHash()},
+	}
+	return nil
+}
+
+// mockSpaceKeeper provides testProof of testPubKey for any challenge.
+type mockSpaceKeeper struct {
+	spacekeeper.SpaceKeeper
+	pubKey  *pocec.PublicKey
+	signKey *pocec.PrivateKey
+}
+
+func newMockSpaceKeeper(t *testing.T) *mockSpaceKeeper {
+	pkBytes, _ := hex.DecodeString(testPubKey)
+	pubKey, err := pocec.ParsePubKey(pkBytes, pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	signKey, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &mockSpaceKeeper{pubKey: pubKey, signKey: signKey}
+}
+
+func (sk *mockSpaceKeeper) Started() bool {
+	return true
+}
+
+func (sk *mockSpaceKeeper) GetProofs(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) ([]*engine.WorkSpaceProof, error) {
+	return []*engine.WorkSpaceProof{{SpaceID: "test", Proof: testProof, PublicKey: sk.pubKey}}, nil
+}
+
+func (sk *mockSpaceKeeper) SignHash(sid string, hash [32]byte) (*pocec.Signature, error) {
+	return sk.signKey.Sign(hash[:])
+}
+
+func TestGenerateBlocks(t *testing.T) {
+	params := config.ChainParams
+	config.ChainParams = config.RegressionNetParams
+	defer func() { config.ChainParams = params }()
+
+	chain := newMockChain(t, time.Now())
+	m := NewPoCMiner(TypeSyncMiner, false, chain, nil, newMockSpaceKeeper(t), make(chan *wire.Hash), nil)
+	hashes, err := m.GenerateBlocks(12, nil)
+	if err != nil {
+		t.Fatalf("fail to generate blocks, generated %d, %v", len(hashes), err)
+	}
+	if len(hashes) != 12 || len(chain.processed) != 12 {
+		t.Fatalf("generated blocks mismatched, got %d, processed %d", len(hashes), len(chain.processed))
+	}
+	for i, block := range chain.processed {
+		header := block.MsgBlock().Header
+		if header.Height != uint64(i+1) || !hashes[i].IsEqual(block.Hash()) {
+			t.Errorf("%d, generated block mismatched, height %d, hash %v", i, header.Height, hashes[i])
+		}
+		if i > 0 && !header.Timestamp.After(chain.processed[i-1].MsgBlock().Header.Timestamp) {
+			t.Errorf("%d, block time not increased, %v", i, header.Timestamp)
+		}
+	}
+	if ahead := time.Until(chain.best.Timestamp); ahead > blockchain.MaxTimeOffsetSeconds*time.Second {
+		t.Errorf("block time too far ahead, %v", ahead)
+	}
+}
+
+func TestGenerateExcludesMining(t *testing.T) {
+	m := NewPoCMiner(TypeSyncMiner, false, newMockChain(t, time.Now()), nil, newMockSpaceKeeper(t), make(chan *wire.Hash), nil)
+
+	m.mining = true
+	if _, err := m.GenerateBlocks(1, nil); err != ErrGenerateOnMining {
+		t.Errorf("generate while mining, expected %v, got %v", ErrGenerateOnMining, err)
+	}
+}
+
+func TestMaxGenerateSlot(t *testing.T) {
+	params := config.ChainParams
+	defer func() { config.ChainParams = params }()
+	now := time.Now()
+
+	if slot := maxGenerateSlot(now); slot != uint64(now.Unix())/pocSlot+allowAhead {
+		t.Errorf("unexpected last slot on mainnet, %d", slot)
+	}
+
+	config.ChainParams = config.RegressionNetParams
+	slot := maxGenerateSlot(now)
+	if slot*pocSlot > uint64(now.Unix())+blockchain.MaxTimeOffsetSeconds {
+		t.Errorf("last slot on regtest exceeds max time offset, %d", slot)
+	}
+	if slot <= uint64(now.Unix())/pocSlot+allowAhead {
+		t.Errorf("last slot on regtest not ahead of now, %d", slot)
+	}
+}
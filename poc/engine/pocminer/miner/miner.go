@@ -11,12 +11,14 @@ import (
 	"time"
 
 	"massnet.org/mass/blockchain"
+	"massnet.org/mass/config"
 	"massnet.org/mass/logging"
 	"massnet.org/mass/massutil"
 	"massnet.org/mass/massutil/service"
 	"massnet.org/mass/poc"
 	"massnet.org/mass/poc/engine"
 	"massnet.org/mass/poc/engine/spacekeeper"
+	"massnet.org/mass/txscript"
 	"massnet.org/mass/wire"
 )
 
@@ -29,6 +31,7 @@ type Chain interface {
 	BestBlockNode() *blockchain.BlockNode
 	BestBlockHash() *wire.Hash
 	BestBlockHeight() uint64
+	GetHeaderByHeight(height uint64) (*wire.BlockHeader, error)
 	ProcessBlock(*massutil.Block) (bool, error)
 	ChainID() *wire.Hash
 	BlockWaiter(height uint64) (<-chan *blockchain.BlockNode, error)
@@ -46,6 +49,12 @@ type ProofTemplate struct {
 	quality *big.Int
 }
 
+// payoutAddressKeeper is implemented by SpaceKeepers keeping preferred payout
+// address of workSpaces.
+type payoutAddressKeeper interface {
+	PayoutAddress(sid string) string
+}
+
 type PoCMiner struct {
 	*service.BaseService
 	quit            chan struct{}
@@ -58,6 +67,8 @@ type PoCMiner struct {
 	newBlockCh      chan *wire.Hash
 	payoutAddresses []massutil.Address
 	getBestProof    func(pocTemplate *blockchain.PoCTemplate, quit chan struct{}) (*ProofTemplate, error)
+	generateLock    sync.Mutex // excludes generating and mining, guards mining
+	mining          bool
 }
 
 func NewPoCMiner(name string, allowSolo bool, chain Chain, syncManager SyncManager, sk spacekeeper.SpaceKeeper, newBlockCh chan *wire.Hash, payoutAddresses []massutil.Address) *PoCMiner {
@@ -79,6 +90,8 @@ func (m *PoCMiner) OnStart() error {
 		logging.CPrint(logging.ERROR, "can not start mining", logging.LogFormat{"err": ErrNoPayoutAddresses})
 		return ErrNoPayoutAddresses
 	}
+	m.generateLock.Lock()
+	defer m.generateLock.Unlock()
 
 	if !m.SpaceKeeper.Started() {
 		if err := m.SpaceKeeper.Start(); err != nil {
@@ -88,6 +101,7 @@ func (m *PoCMiner) OnStart() error {
 
 	m.quit = make(chan struct{})
 	go m.generateBlocks(m.quit)
+	m.mining = true
 
 	logging.CPrint(logging.INFO, "PoC miner started")
 	return nil
@@ -97,6 +111,10 @@ func (m *PoCMiner) OnStop() error {
 	close(m.quit)
 	m.wg.Wait()
 
+	m.generateLock.Lock()
+	m.mining = false
+	m.generateLock.Unlock()
+
 	logging.CPrint(logging.INFO, "PoC miner stopped")
 	return nil
 }
@@ -257,7 +275,7 @@ func (m *PoCMiner) solveBlock(payoutAddress massutil.Address, quit chan struct{}
 
 	// Step 6: assemble full block
 	logging.CPrint(logging.INFO, "Step 6: assemble full block")
-	block, minerReward, err := assembleFullBlock(blockTemplate, pocTemplate, tProof)
+	block, minerReward, err := assembleFullBlock(blockTemplate, pocTemplate, tProof, m.payoutScript(tProof.proof.SpaceID))
 	if err != nil {
 		return failure(err)
 	}
@@ -277,6 +295,32 @@ func (m *PoCMiner) solveBlock(payoutAddress massutil.Address, quit chan struct{}
 	return block, minerReward, nil
 }
 
+// payoutScript returns pkScript of preferred payout address of workSpace sid,
+// nil to keep the payout address chosen for template.
+func (m *PoCMiner) payoutScript(sid string) []byte {
+	keeper, ok := m.SpaceKeeper.(payoutAddressKeeper)
+	if !ok {
+		return nil
+	}
+	address := keeper.PayoutAddress(sid)
+	if address == "" {
+		return nil
+	}
+	addr, err := massutil.DecodeAddress(address, &config.ChainParams)
+	if err != nil {
+		logging.CPrint(logging.WARN, "fail to decode preferred payout address",
+			logging.LogFormat{"sid": sid, "address": address, "err": err})
+		return nil
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		logging.CPrint(logging.WARN, "fail to create script for preferred payout address",
+			logging.LogFormat{"sid": sid, "address": address, "err": err})
+		return nil
+	}
+	return script
+}
+
 func getTemplate(quit chan struct{}, ch chan interface{}, typ reflect.Type) (interface{}, error) {
 	select {
 	case <-quit:
@@ -308,7 +352,9 @@ func getTemplate(quit chan struct{}, ch chan interface{}, typ reflect.Type) (int
 	}
 }
 
-func assembleFullBlock(blockTemplate *blockchain.BlockTemplate, pocTemplate *blockchain.PoCTemplate, tProof *ProofTemplate) (*wire.MsgBlock, massutil.Amount, error) {
+// assembleFullBlock fills block template with proof, the miner reward is paid to
+// payoutScript if provided.
+func assembleFullBlock(blockTemplate *blockchain.BlockTemplate, pocTemplate *blockchain.PoCTemplate, tProof *ProofTemplate, payoutScript []byte) (*wire.MsgBlock, massutil.Amount, error) {
 	var block = blockTemplate.Block
 	var workProof, pocProof = tProof.proof, tProof.proof.Proof
 
@@ -324,6 +370,11 @@ func assembleFullBlock(blockTemplate *blockchain.BlockTemplate, pocTemplate *blo
 			logging.LogFormat{"pubkey": workProof.PublicKey, "err": err})
 		return nil, massutil.Amount{}, err
 	}
+	if payoutScript != nil {
+		// miner reward is the last output, see reCreateCoinbaseTx
+		txOut := coinbaseTx.MsgTx().TxOut
+		txOut[len(txOut)-1].PkScript = payoutScript
+	}
 
 	if coinbaseTx.MsgTx().TxHash() != *blockTemplate.MerkleCache[0] {
 		block.Transactions[0] = coinbaseTx.MsgTx()
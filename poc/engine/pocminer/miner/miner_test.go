@@ -0,0 +1,79 @@
+package miner
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"massnet.org/mass/blockchain"
+	"massnet.org/mass/config"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/txscript"
+	"massnet.org/mass/wire"
+)
+
+const testPayoutAddress = "ms1qq7xrd32awhvaj9lkgn6tzm2n76gcu5zglxguzyu3kxrs9pz7tk32qvw70ky"
+
+// payoutSpaceKeeper keeps preferred payout address of workSpaces.
+type payoutSpaceKeeper struct {
+	*mockSpaceKeeper
+	payouts map[string]string
+}
+
+func (sk *payoutSpaceKeeper) PayoutAddress(sid string) string {
+	return sk.payouts[sid]
+}
+
+func TestPreferredPayoutAddress(t *testing.T) {
+	addr, err := massutil.DecodeAddress(testPayoutAddress, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chain := newMockChain(t, time.Now())
+	sk := &payoutSpaceKeeper{
+		mockSpaceKeeper: newMockSpaceKeeper(t),
+		payouts:         map[string]string{"test": testPayoutAddress, "invalid": "ms1qqinvalid"},
+	}
+	m := NewPoCMiner(TypeSyncMiner, false, chain, nil, sk, make(chan *wire.Hash), nil)
+	if script := m.payoutScript("test"); !bytes.Equal(script, expected) {
+		t.Errorf("unexpected payout script %x", script)
+	}
+	for _, sid := range []string{"invalid", "none"} {
+		if script := m.payoutScript(sid); script != nil {
+			t.Errorf("%s, expected no payout script, got %x", sid, script)
+		}
+	}
+	m.SpaceKeeper = sk.mockSpaceKeeper
+	if script := m.payoutScript("test"); script != nil {
+		t.Errorf("expected no payout script without preferred address, got %x", script)
+	}
+
+	// miner reward is paid to preferred address
+	templateCh := make(chan interface{}, 2)
+	if err = chain.NewBlockTemplate(nil, templateCh); err != nil {
+		t.Fatal(err)
+	}
+	pocTemplate := (<-templateCh).(*blockchain.PoCTemplate)
+	blockTemplate := (<-templateCh).(*blockchain.BlockTemplate)
+	tProof := &ProofTemplate{
+		proof: &engine.WorkSpaceProof{SpaceID: "test", Proof: testProof, PublicKey: sk.pubKey},
+		time:  pocTemplate.Timestamp,
+	}
+	block, _, err := assembleFullBlock(blockTemplate, pocTemplate, tProof, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coinbase := block.Transactions[0]
+	if script := coinbase.TxOut[len(coinbase.TxOut)-1].PkScript; !bytes.Equal(script, expected) {
+		t.Errorf("miner reward paid to %x", script)
+	}
+	if block.Header.TransactionRoot != coinbase.TxHash() {
+		t.Error("transaction root not updated")
+	}
+}
@@ -0,0 +1,160 @@
+package miner
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"massnet.org/mass/consensus/challenge"
+	"massnet.org/mass/consensus/difficulty"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/engine/pocminer"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/wire"
+)
+
+// maxSimulateBlocks limits the count of blocks replayed by one simulation.
+const maxSimulateBlocks = 10000
+
+// Simulate replays the last count headers of the best chain against plotted spaces (ready or mining),
+// or only spaces in spaceIDs if it is not empty. For each header, the challenge is recomputed from
+// previous headers, and proofs are qualified from the slot after previous block to the slot of the
+// recorded block. A block is counted as won if a proof beats the target required at an earlier slot,
+// or beats the recorded proof in the same slot.
+func (m *PoCMiner) Simulate(count int, spaceIDs []string) (*pocminer.SimulationResult, error) {
+	if count <= 0 || count > maxSimulateBlocks {
+		return nil, ErrSimulateInvalidCount
+	}
+	bestHeight := m.chain.BestBlockHeight()
+	if bestHeight == 0 {
+		return nil, ErrSimulateNoBlocks
+	}
+	if uint64(count) > bestHeight {
+		count = int(bestHeight)
+	}
+	startHeight := bestHeight - uint64(count) + 1
+
+	// headers referred by the challenge of startHeight are also required
+	var firstHeight = startHeight - 1
+	if firstHeight >= challenge.MaxReferredBlocks-1 {
+		firstHeight -= challenge.MaxReferredBlocks - 1
+	} else {
+		firstHeight = 0
+	}
+	headers := make([]*wire.BlockHeader, 0, bestHeight-firstHeight+1)
+	for height := firstHeight; height <= bestHeight; height++ {
+		header, err := m.chain.GetHeaderByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, header)
+	}
+	var getHeader = func(height uint64) *wire.BlockHeader {
+		return headers[height-firstHeight]
+	}
+
+	var selected map[string]struct{}
+	if len(spaceIDs) > 0 {
+		selected = make(map[string]struct{}, len(spaceIDs))
+		for _, sid := range spaceIDs {
+			selected[sid] = struct{}{}
+		}
+	}
+
+	logging.CPrint(logging.INFO, "start mining simulation",
+		logging.LogFormat{"start_height": startHeight, "end_height": bestHeight, "spaces": len(spaceIDs)})
+	result := &pocminer.SimulationResult{
+		StartHeight: startHeight,
+		EndHeight:   bestHeight,
+		Blocks:      count,
+		Won:         make([]pocminer.SimulatedBlock, 0),
+	}
+	var spaces = make(map[string]struct{})
+	for height := startHeight; height <= bestHeight; height++ {
+		header, prev := getHeader(height), getHeader(height-1)
+
+		var size uint64 = challenge.MaxReferredBlocks
+		if prev.Height+1 < size {
+			size = prev.Height + 1
+		}
+		referred := make([]*wire.BlockHeader, size)
+		for i := range referred {
+			referred[i] = getHeader(prev.Height - uint64(i))
+		}
+		nextChallenge, err := challenge.CalcNextChallenge(prev, referred)
+		if err != nil {
+			return nil, err
+		}
+		if *nextChallenge != header.Challenge {
+			logging.CPrint(logging.ERROR, "recomputed challenge differs from recorded",
+				logging.LogFormat{"height": height, "recorded": header.Challenge, "recomputed": nextChallenge})
+			return nil, ErrSimulateChallengeMismatch
+		}
+
+		var challengeHash = pocutil.Hash(*nextChallenge)
+		skProofs, err := m.SpaceKeeper.GetProofs(context.TODO(), engine.SFReady|engine.SFMining, challengeHash)
+		if err != nil {
+			return nil, err
+		}
+		proofs := make([]*engine.WorkSpaceProof, 0, len(skProofs))
+		for _, proof := range getValidProofs(skProofs) {
+			if selected != nil {
+				if _, ok := selected[proof.SpaceID]; !ok {
+					continue
+				}
+			}
+			spaces[proof.SpaceID] = struct{}{}
+			proofs = append(proofs, proof)
+		}
+		if len(proofs) == 0 {
+			continue
+		}
+
+		recordedSlot := uint64(header.Timestamp.Unix()) / pocSlot
+		recordedQuality := header.Proof.GetQuality(recordedSlot, height)
+		for slot := uint64(prev.Timestamp.Unix())/pocSlot + 1; slot <= recordedSlot; slot++ {
+			qualities, err := getQualities(proofs, challengeHash, slot, height)
+			if err != nil {
+				return nil, err
+			}
+			var bestProofIndex int
+			var bestQuality = big.NewInt(0)
+			for i, quality := range qualities {
+				if quality.Cmp(bestQuality) > 0 {
+					bestQuality = quality
+					bestProofIndex = i
+				}
+			}
+			// the target depends on the block time, i.e. the slot
+			target, err := difficulty.CalcNextRequiredDifficulty(prev, time.Unix(int64(slot*pocSlot), 0))
+			if err != nil {
+				return nil, err
+			}
+			if bestQuality.Cmp(target) <= 0 {
+				continue
+			}
+			if slot == recordedSlot && bestQuality.Cmp(recordedQuality) <= 0 {
+				break
+			}
+			result.Won = append(result.Won, pocminer.SimulatedBlock{
+				Height:     height,
+				SpaceID:    proofs[bestProofIndex].SpaceID,
+				Slot:       slot,
+				SlotsAhead: recordedSlot - slot,
+				Quality:    bestQuality,
+				Target:     target,
+			})
+			break
+		}
+	}
+
+	result.Spaces = len(spaces)
+	result.WinRate = float64(len(result.Won)) / float64(count)
+	if span := getHeader(bestHeight).Timestamp.Sub(getHeader(startHeight - 1).Timestamp); span > 0 {
+		result.ExpectedPerDay = float64(len(result.Won)) * float64(24*time.Hour) / float64(span)
+	}
+	logging.CPrint(logging.INFO, "mining simulation finished",
+		logging.LogFormat{"blocks": count, "spaces": result.Spaces, "won": len(result.Won), "expected_per_day": result.ExpectedPerDay})
+	return result, nil
+}
@@ -0,0 +1,150 @@
+package miner
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"massnet.org/mass/consensus/challenge"
+	"massnet.org/mass/consensus/difficulty"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/engine/massdb"
+	"massnet.org/mass/poc/engine/massdb/massdb.v1"
+	"massnet.org/mass/poc/engine/spacekeeper"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/pocec"
+	"massnet.org/mass/testutil"
+	"massnet.org/mass/wire"
+)
+
+// headerChain provides recorded headers to simulate on.
+type headerChain struct {
+	Chain
+	headers []*wire.BlockHeader
+}
+
+func (c *headerChain) BestBlockHeight() uint64 {
+	return uint64(len(c.headers) - 1)
+}
+
+func (c *headerChain) GetHeaderByHeight(height uint64) (*wire.BlockHeader, error) {
+	return c.headers[height], nil
+}
+
+// plottedSpaceKeeper provides proofs of a single plotted space.
+type plottedSpaceKeeper struct {
+	spacekeeper.SpaceKeeper
+	mdb massdb.MassDB
+}
+
+func (sk *plottedSpaceKeeper) GetProofs(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) ([]*engine.WorkSpaceProof, error) {
+	proof, err := sk.mdb.GetProof(challenge)
+	return []*engine.WorkSpaceProof{{SpaceID: "plotted", Proof: proof, PublicKey: sk.mdb.PubKey(), Error: err}}, nil
+}
+
+func newPlottedSpaceKeeper(t *testing.T) (*plottedSpaceKeeper, func()) {
+	dir, err := ioutil.TempDir("", "simulate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mdb, err := massdb_v1.NewMassDBV1(dir, engine.UnknownOrdinal, sk.PubKey(), 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = <-mdb.Plot(); err != nil {
+		t.Fatal(err)
+	}
+	return &plottedSpaceKeeper{mdb: mdb}, func() {
+		mdb.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// newSimulateHeaders returns count headers after genesis, each block is gap
+// slots after its previous one, and is mined by a proof of higher quality
+// than spaces of bit length 24.
+func newSimulateHeaders(t *testing.T, count int, gap uint64, target *big.Int) []*wire.BlockHeader {
+	genesis := wire.NewEmptyBlockHeader()
+	genesis.Timestamp = time.Unix(int64(uint64(time.Now().Unix())/pocSlot*pocSlot), 0)
+	genesis.Target = target
+	genesis.Proof = testProof
+	headers := []*wire.BlockHeader{genesis}
+	for height := 1; height <= count; height++ {
+		prev := headers[height-1]
+		size := challenge.MaxReferredBlocks
+		if height < size {
+			size = height
+		}
+		referred := make([]*wire.BlockHeader, size)
+		for i := range referred {
+			referred[i] = headers[height-1-i]
+		}
+		nextChallenge, err := challenge.CalcNextChallenge(prev, referred)
+		if err != nil {
+			t.Fatal(err)
+		}
+		header := wire.NewEmptyBlockHeader()
+		header.Height = uint64(height)
+		header.Previous = prev.BlockHash()
+		header.Challenge = *nextChallenge
+		header.Timestamp = prev.Timestamp.Add(time.Duration(gap*pocSlot) * time.Second)
+		if header.Target, err = difficulty.CalcNextRequiredDifficulty(prev, header.Timestamp); err != nil {
+			t.Fatal(err)
+		}
+		header.Proof = testProof
+		headers = append(headers, header)
+	}
+	return headers
+}
+
+func TestSimulate(t *testing.T) {
+	testutil.SkipCI(t)
+
+	sk, cleanup := newPlottedSpaceKeeper(t)
+	defer cleanup()
+
+	// blocks are far apart, so that targets at earlier slots are higher
+	// than the recorded ones
+	const count, gap = 20, 100
+	headers := newSimulateHeaders(t, count, gap, big.NewInt(300000000))
+	m := NewPoCMiner(TypeSyncMiner, false, &headerChain{headers: headers}, nil, sk, nil, nil)
+
+	result, err := m.Simulate(count, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.StartHeight != 1 || result.EndHeight != count || result.Blocks != count {
+		t.Errorf("unexpected range, %d - %d, %d blocks", result.StartHeight, result.EndHeight, result.Blocks)
+	}
+	if len(result.Won) == 0 || result.Spaces != 1 {
+		t.Fatalf("no blocks won by %d spaces", result.Spaces)
+	}
+	for _, won := range result.Won {
+		prev := headers[won.Height-1]
+		target, err := difficulty.CalcNextRequiredDifficulty(prev, time.Unix(int64(won.Slot*pocSlot), 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if won.Target.Cmp(target) != 0 {
+			t.Errorf("height %d, target of slot %d mismatched, got %d, want %d", won.Height, won.Slot, won.Target, target)
+		}
+		if won.Quality.Cmp(target) <= 0 {
+			t.Errorf("height %d, quality %d not above target %d", won.Height, won.Quality, target)
+		}
+		if won.SlotsAhead == 0 || won.Slot+won.SlotsAhead != uint64(headers[won.Height].Timestamp.Unix())/pocSlot {
+			t.Errorf("height %d, unexpected slot %d, slots ahead %d", won.Height, won.Slot, won.SlotsAhead)
+		}
+	}
+
+	_, err = m.Simulate(maxSimulateBlocks+1, nil)
+	if err != ErrSimulateInvalidCount {
+		t.Errorf("unexpected error on invalid count, %v", err)
+	}
+}
@@ -2,8 +2,10 @@ package pocminer
 
 import (
 	"errors"
+	"math/big"
 
 	"massnet.org/mass/massutil"
+	"massnet.org/mass/wire"
 )
 
 type PoCMiner interface {
@@ -12,6 +14,30 @@ type PoCMiner interface {
 	Started() bool
 	Type() string
 	SetPayoutAddresses(addresses []massutil.Address) error
+	GenerateBlocks(count int, payoutAddress massutil.Address) ([]*wire.Hash, error)
+	Simulate(count int, spaceIDs []string) (*SimulationResult, error)
+}
+
+// SimulationResult reports blocks that given spaces would have won,
+// if they had been mining while the last Blocks blocks were mined.
+type SimulationResult struct {
+	StartHeight    uint64
+	EndHeight      uint64
+	Blocks         int
+	Spaces         int // count of spaces providing proofs
+	Won            []SimulatedBlock
+	WinRate        float64 // won blocks per simulated block
+	ExpectedPerDay float64 // won blocks per day, by the time span of simulated blocks
+}
+
+// SimulatedBlock is a block that would have been won by a space.
+type SimulatedBlock struct {
+	Height     uint64
+	SpaceID    string
+	Slot       uint64
+	SlotsAhead uint64 // count of slots earlier than the recorded block
+	Quality    *big.Int
+	Target     *big.Int
 }
 
 var (
@@ -0,0 +1,224 @@
+package capacity
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"time"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/engine/massdb"
+	"massnet.org/mass/poc/pocutil"
+)
+
+const (
+	// ProofBudget is the time for mining workSpaces to answer a challenge,
+	// syncGetBestProof waits for proofs before trying to solve in the slot.
+	ProofBudget = poc.PoCSlot * time.Second
+
+	// proofBudgetWarnRatio warns when the worst case latency exceeds this ratio of ProofBudget.
+	proofBudgetWarnRatio = 0.5
+
+	defaultBenchmarkChallenges = 100
+	maxBenchmarkChallenges     = 10000
+)
+
+// LatencyStats summarizes latencies of proof lookups.
+type LatencyStats struct {
+	Count int
+	P50   time.Duration
+	P95   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// SpaceLatency is the latency of looking up proofs from a single workSpace.
+type SpaceLatency struct {
+	SpaceID   string
+	Dir       string
+	Errors    int // count of failed lookups, other than challenges without proof and corrupted records
+	Corrupted int // count of records failing verification
+	LatencyStats
+}
+
+// DirLatency is the latency of looking up proofs from all workSpaces in a proof dir.
+type DirLatency struct {
+	Dir string
+	LatencyStats
+}
+
+// ProofBenchmark is the result of BenchmarkProofs.
+type ProofBenchmark struct {
+	Challenges int
+	Budget     time.Duration
+	GetProofs  LatencyStats // latency of GetProofs on all mining workSpaces
+	Spaces     []SpaceLatency
+	Dirs       []DirLatency
+	Warnings   []string
+}
+
+// BenchmarkProofs fires random challenges at mining workSpaces, and measures the latency
+// of looking up proof from each workSpace, and of GetProofs on all of them as mining does.
+// Warnings are given if the worst case approaches ProofBudget.
+func (sk *SpaceKeeper) BenchmarkProofs(ctx context.Context, challenges int) (*ProofBenchmark, error) {
+	if !sk.Started() {
+		return nil, ErrSpaceKeeperIsNotRunning
+	}
+	if challenges <= 0 {
+		challenges = defaultBenchmarkChallenges
+	}
+	if challenges > maxBenchmarkChallenges {
+		return nil, ErrBenchmarkTooManyChallenges
+	}
+
+	sk.stateLock.RLock()
+	miningList := getWsByFlags(sk.workSpaceList, engine.SFMining)
+	sk.stateLock.RUnlock()
+
+	wsList := make([]*WorkSpace, 0)
+	for _, ws := range miningList {
+		if ws.Available() {
+			wsList = append(wsList, ws)
+		}
+	}
+	if len(wsList) == 0 {
+		return nil, ErrBenchmarkNoMiningSpace
+	}
+	sort.Slice(wsList, func(i, j int) bool { return wsList[i].id.String() < wsList[j].id.String() })
+
+	logging.CPrint(logging.INFO, "start proof benchmark", logging.LogFormat{"challenges": challenges, "spaces": len(wsList)})
+	var spaceSamples = make([][]time.Duration, len(wsList))
+	var spaceErrors = make([]int, len(wsList))
+	var spaceCorrupted = make([]int, len(wsList))
+	var allSamples = make([]time.Duration, 0, challenges)
+	for c := 0; c < challenges; c++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		var challenge pocutil.Hash
+		if _, err := rand.Read(challenge[:]); err != nil {
+			return nil, err
+		}
+		// look up each workSpace one by one, to measure its own disk latency
+		for i, ws := range wsList {
+			start := time.Now()
+			_, err := ws.db.GetProof(challenge)
+			spaceSamples[i] = append(spaceSamples[i], time.Since(start))
+			switch {
+			case err == nil:
+			case isProofInvalid(err):
+				if !isRecordEmpty(ws.db, challenge) {
+					spaceCorrupted[i]++
+				}
+			default:
+				spaceErrors[i]++
+			}
+		}
+		start := time.Now()
+		if _, err := sk.GetProofs(ctx, engine.SFMining, challenge); err != nil {
+			return nil, err
+		}
+		allSamples = append(allSamples, time.Since(start))
+	}
+
+	result := &ProofBenchmark{
+		Challenges: challenges,
+		Budget:     ProofBudget,
+		GetProofs:  newLatencyStats(allSamples),
+		Spaces:     make([]SpaceLatency, len(wsList)),
+		Warnings:   make([]string, 0),
+	}
+	var warnAt = time.Duration(float64(ProofBudget) * proofBudgetWarnRatio)
+	var dirSamples = make(map[string][]time.Duration)
+	var dirs = make([]string, 0)
+	for i, ws := range wsList {
+		sl := SpaceLatency{
+			SpaceID:      ws.id.String(),
+			Dir:          ws.rootDir,
+			Errors:       spaceErrors[i],
+			Corrupted:    spaceCorrupted[i],
+			LatencyStats: newLatencyStats(spaceSamples[i]),
+		}
+		result.Spaces[i] = sl
+		if _, exists := dirSamples[sl.Dir]; !exists {
+			dirs = append(dirs, sl.Dir)
+		}
+		dirSamples[sl.Dir] = append(dirSamples[sl.Dir], spaceSamples[i]...)
+		if sl.Max >= warnAt {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("space %s in %s takes %v at most to look up proof, budget is %v", sl.SpaceID, sl.Dir, sl.Max, ProofBudget))
+		}
+		if sl.Errors > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("space %s in %s fails on %d of %d challenges", sl.SpaceID, sl.Dir, sl.Errors, challenges))
+		}
+		if sl.Corrupted > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("space %s in %s has corrupted records on %d of %d challenges, it should be replotted", sl.SpaceID, sl.Dir, sl.Corrupted, challenges))
+		}
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		result.Dirs = append(result.Dirs, DirLatency{Dir: dir, LatencyStats: newLatencyStats(dirSamples[dir])})
+	}
+	if result.GetProofs.Max >= warnAt {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("GetProofs on %d spaces takes %v at most, budget is %v", len(wsList), result.GetProofs.Max, ProofBudget))
+	}
+
+	for _, warning := range result.Warnings {
+		logging.CPrint(logging.WARN, warning)
+	}
+	logging.CPrint(logging.INFO, "proof benchmark finished", logging.LogFormat{
+		"challenges": challenges,
+		"spaces":     len(wsList),
+		"p50":        result.GetProofs.P50,
+		"p99":        result.GetProofs.P99,
+		"max":        result.GetProofs.Max,
+		"warnings":   len(result.Warnings),
+	})
+	return result, nil
+}
+
+// recordGetter is implemented by MassDBs giving the record stored for a challenge.
+type recordGetter interface {
+	Get(z pocutil.PoCValue) (x, xp pocutil.PoCValue, err error)
+}
+
+// isProofInvalid returns true if err is from verifying the proof read from MassDB.
+func isProofInvalid(err error) bool {
+	return err == poc.ErrProofInvalidFlipValue || err == poc.ErrProofInvalidChallenge
+}
+
+// isRecordEmpty tells whether the invalid proof of challenge is read from an empty record,
+// which means that the challenge has no proof, rather than that the record is corrupted.
+// Records of MassDBs not giving them are taken as empty.
+func isRecordEmpty(db massdb.MassDB, challenge pocutil.Hash) bool {
+	getter, ok := db.(recordGetter)
+	if !ok {
+		return true
+	}
+	x, xp, err := getter.Get(pocutil.CutHash(challenge, db.BitLength()))
+	return err == nil && x == 0 && xp == 0
+}
+
+// newLatencyStats sorts samples and returns the nearest-rank percentiles.
+func newLatencyStats(samples []time.Duration) LatencyStats {
+	var stats = LatencyStats{Count: len(samples)}
+	if len(samples) == 0 {
+		return stats
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	var percentile = func(p int) time.Duration {
+		rank := (len(samples)*p + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+		return samples[rank-1]
+	}
+	stats.P50, stats.P95, stats.P99 = percentile(50), percentile(95), percentile(99)
+	stats.Max = samples[len(samples)-1]
+	return stats
+}
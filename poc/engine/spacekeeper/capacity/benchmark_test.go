@@ -0,0 +1,144 @@
+package capacity
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/panjf2000/ants"
+	"massnet.org/mass/massutil/service"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/engine/massdb/massdb.v1"
+	"massnet.org/mass/pocec"
+	"massnet.org/mass/testutil"
+)
+
+func TestNewLatencyStats(t *testing.T) {
+	if stats := newLatencyStats(nil); stats != (LatencyStats{}) {
+		t.Errorf("expected empty stats, got %+v", stats)
+	}
+
+	samples := make([]time.Duration, 200)
+	for i := range samples {
+		samples[i] = time.Duration(i+1) * time.Millisecond
+	}
+	rand.Shuffle(len(samples), func(i, j int) { samples[i], samples[j] = samples[j], samples[i] })
+	expected := LatencyStats{
+		Count: 200,
+		P50:   100 * time.Millisecond,
+		P95:   190 * time.Millisecond,
+		P99:   198 * time.Millisecond,
+		Max:   200 * time.Millisecond,
+	}
+	if stats := newLatencyStats(samples); stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+
+	expected = LatencyStats{Count: 1, P50: time.Second, P95: time.Second, P99: time.Second, Max: time.Second}
+	if stats := newLatencyStats([]time.Duration{time.Second}); stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+}
+
+// newMiningSpaceKeeper returns a started SpaceKeeper mining a plotted workSpace in dir,
+// without running plotter or watcher.
+func newMiningSpaceKeeper(t *testing.T, dir string) (*SpaceKeeper, *WorkSpace) {
+	pk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws, err := NewWorkSpace(massdb_v1.TypeMassDBV1, dir, engine.UnknownOrdinal, pk.PubKey(), 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ws.Plot(); err != nil {
+		t.Fatal(err)
+	}
+	ws.state = engine.Mining
+	workerPool, err := ants.NewPoolPreMalloc(maxPoolWorker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk := &SpaceKeeper{
+		workSpaceList: []*WorkSpace{ws},
+		workerPool:    workerPool,
+	}
+	sk.BaseService = service.NewBaseService(service.NewBaseService(nil, ""), TypeSpaceKeeperV1)
+	if err = sk.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return sk, ws
+}
+
+func TestBenchmarkProofs(t *testing.T) {
+	testutil.SkipCI(t)
+
+	dir, err := ioutil.TempDir("", "benchmark")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sk, ws := newMiningSpaceKeeper(t, dir)
+	defer ws.db.Close()
+	defer sk.workerPool.Release()
+
+	if _, err = sk.BenchmarkProofs(context.Background(), maxBenchmarkChallenges+1); err != ErrBenchmarkTooManyChallenges {
+		t.Errorf("expected %v, got %v", ErrBenchmarkTooManyChallenges, err)
+	}
+
+	result, err := sk.BenchmarkProofs(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Challenges != defaultBenchmarkChallenges || result.GetProofs.Count != defaultBenchmarkChallenges {
+		t.Errorf("unexpected challenges %d, get proofs %d", result.Challenges, result.GetProofs.Count)
+	}
+	if len(result.Spaces) != 1 || len(result.Dirs) != 1 || result.Dirs[0].Dir != dir {
+		t.Fatalf("unexpected spaces %+v, dirs %+v", result.Spaces, result.Dirs)
+	}
+	space := result.Spaces[0]
+	if space.SpaceID != ws.id.String() || space.Count != defaultBenchmarkChallenges {
+		t.Errorf("unexpected space %+v", space)
+	}
+	// challenges without proof are neither errors nor corrupted records
+	if space.Errors != 0 || space.Corrupted != 0 {
+		t.Errorf("unexpected errors %d, corrupted %d", space.Errors, space.Corrupted)
+	}
+
+	// overwrite all records with garbage
+	mdb := ws.db.(*massdb_v1.MassDBV1)
+	f, err := os.OpenFile(mdb.FilePath(), os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.WriteAt(bytes.Repeat([]byte{0xff}, int(fi.Size()-massdb_v1.PosProofData)), massdb_v1.PosProofData)
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = sk.BenchmarkProofs(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if space = result.Spaces[0]; space.Errors != 0 || space.Corrupted != 10 {
+		t.Errorf("expected 10 corrupted records, got errors %d, corrupted %d", space.Errors, space.Corrupted)
+	}
+	if len(result.Warnings) == 0 {
+		t.Error("expected warning on corrupted records")
+	}
+
+	// not mining
+	ws.state = engine.Ready
+	if _, err = sk.BenchmarkProofs(context.Background(), 10); err != ErrBenchmarkNoMiningSpace {
+		t.Errorf("expected %v, got %v", ErrBenchmarkNoMiningSpace, err)
+	}
+}
@@ -38,12 +38,19 @@ type SpaceKeeper struct {
 	configuring           int32 // atomic
 	configured            int32 // atomic
 	allowGenerateNewSpace bool
+	autoMine              bool
+	proofDirs             []string // configured dirs, including those not mounted yet, written under stateLock
 	dbDirs                []string
 	dbType                string
 	wallet                PoCWallet
 	workSpaceIndex        []*WorkSpaceMap
 	workSpaceList         []*WorkSpace
 	queue                 *plotterQueue
+	plotPerDisk           int
+	plotMemoryLimit       uint64 // in bytes, 0 means unlimited
+	plotTempDir           string // dir for plotting HashMapA, empty means plotting in place
+	devices               deviceCache
+	spaceStates           *spaceStateStore // desired states of workSpaces persisted across restarts
 	newQueuedWorkSpaceCh  chan *queuedWorkSpace
 	workerPool            *ants.Pool
 	generateInitialIndex  func() error
@@ -122,6 +129,9 @@ func (sk *SpaceKeeper) GetProof(ctx context.Context, sid string, challenge pocut
 	}
 
 	if ws, ok := sk.workSpaceIndex[allState].Items()[sid]; ok && ws.using {
+		if !ws.Available() {
+			return nil, ErrWorkSpaceIsUnavailable
+		}
 		return sk.getProof(ws, challenge), nil
 	}
 	return nil, ErrWorkSpaceDoesNotExist
@@ -132,9 +142,16 @@ func (sk *SpaceKeeper) GetProofs(ctx context.Context, flags engine.WorkSpaceStat
 		return nil, ErrSpaceKeeperIsNotRunning
 	}
 
+	sk.stateLock.RLock()
+	wsList := getWsByFlags(sk.workSpaceList, flags)
+	sk.stateLock.RUnlock()
+
 	items := make(map[string]*WorkSpace)
-	for _, ws := range getWsByFlags(sk.workSpaceList, flags) {
-		items[ws.id.String()] = ws
+	for _, ws := range wsList {
+		// skip workSpaces on vanished disks
+		if ws.Available() {
+			items[ws.id.String()] = ws
+		}
 	}
 
 	proofs := sk.getProofs(items, challenge)
@@ -151,6 +168,9 @@ func (sk *SpaceKeeper) GetProofReader(ctx context.Context, sid string, challenge
 	}
 
 	if ws, ok := sk.workSpaceIndex[allState].Items()[sid]; ok && ws.using {
+		if !ws.Available() {
+			return nil, ErrWorkSpaceIsUnavailable
+		}
 		prw := engine.NewProofRW(ctx, 1)
 		go func() {
 			if err := prw.Write(sk.getProof(ws, challenge)); err != nil {
@@ -168,9 +188,16 @@ func (sk *SpaceKeeper) GetProofsReader(ctx context.Context, flags engine.WorkSpa
 		return nil, ErrSpaceKeeperIsNotRunning
 	}
 
+	sk.stateLock.RLock()
+	wsList := getWsByFlags(sk.workSpaceList, flags)
+	sk.stateLock.RUnlock()
+
 	items := make(map[string]*WorkSpace)
-	for _, ws := range getWsByFlags(sk.workSpaceList, flags) {
-		items[ws.id.String()] = ws
+	for _, ws := range wsList {
+		// skip workSpaces on vanished disks
+		if ws.Available() {
+			items[ws.id.String()] = ws
+		}
 	}
 	prw := engine.NewProofRW(ctx, len(items))
 	go func() {
@@ -252,9 +279,14 @@ func (sk *SpaceKeeper) SignHash(sid string, hash [32]byte) (*pocec.Signature, er
 // plotting -> ready
 // ready -> ready
 // mining -> mining
-func (sk *SpaceKeeper) PlotWS(sid string) error {
+func (sk *SpaceKeeper) PlotWS(sid string) (err error) {
 	sk.stateLock.RLock()
 	defer sk.stateLock.RUnlock()
+	defer func() {
+		if err == nil {
+			err = sk.persistDesired(sid, engine.Plot)
+		}
+	}()
 
 	if ws, ok := sk.workSpaceIndex[allState].Get(sid); !ok || !ws.using {
 		return ErrWorkSpaceDoesNotExist
@@ -270,9 +302,8 @@ func (sk *SpaceKeeper) PlotWS(sid string) error {
 
 	// plotting -> ready
 	if _, ok := sk.workSpaceIndex[engine.Plotting].Get(sid); ok {
-		// known that there's no more than one plotting workSpace at the same time
-		qws := sk.queue.PoppedItem()
-		if qws.ws.id.String() != sid {
+		qws := sk.queue.Running(sid)
+		if qws == nil {
 			return ErrWorkSpaceIsNotPlotting
 		}
 		qws.wouldMining = false
@@ -292,9 +323,14 @@ func (sk *SpaceKeeper) PlotWS(sid string) error {
 // For registered workSpace, simply push it into spacePlotter Queue with `wouldMining = true`
 // For plotting workSpace, modify queuedWorkspace with `wouldMining = true`
 // For ready workSpace, convert it to mining state
-func (sk *SpaceKeeper) MineWS(sid string) error {
+func (sk *SpaceKeeper) MineWS(sid string) (err error) {
 	sk.stateLock.Lock()
 	defer sk.stateLock.Unlock()
+	defer func() {
+		if err == nil {
+			err = sk.persistDesired(sid, engine.Mine)
+		}
+	}()
 
 	if ws, ok := sk.workSpaceIndex[allState].Get(sid); !ok || !ws.using {
 		return ErrWorkSpaceDoesNotExist
@@ -309,9 +345,8 @@ func (sk *SpaceKeeper) MineWS(sid string) error {
 
 	// plotting -> mining
 	if _, ok := sk.workSpaceIndex[engine.Plotting].Get(sid); ok {
-		// known that there's no more than one plotting workSpace at the same time
-		qws := sk.queue.PoppedItem()
-		if qws.ws.id.String() != sid {
+		qws := sk.queue.Running(sid)
+		if qws == nil {
 			return ErrWorkSpaceIsNotPlotting
 		}
 		qws.wouldMining = true
@@ -338,9 +373,14 @@ func (sk *SpaceKeeper) MineWS(sid string) error {
 // For all states, clear workSpace out from spacePlotter Queue
 // For plotting workSpace, stop plotting and modify queuedWorkspace with `wouldMining = false`
 // For mining workSpace, convert it to ready state
-func (sk *SpaceKeeper) StopWS(sid string) error {
+func (sk *SpaceKeeper) StopWS(sid string) (err error) {
 	sk.stateLock.Lock()
 	defer sk.stateLock.Unlock()
+	defer func() {
+		if err == nil {
+			err = sk.persistDesired(sid, engine.Stop)
+		}
+	}()
 
 	if ws, ok := sk.workSpaceIndex[allState].Get(sid); !ok || !ws.using {
 		return ErrWorkSpaceDoesNotExist
@@ -349,9 +389,8 @@ func (sk *SpaceKeeper) StopWS(sid string) error {
 	sk.queue.Delete(sid)
 
 	if ws, ok := sk.workSpaceIndex[engine.Plotting].Get(sid); ok {
-		// known that there's no more than one plotting workSpace at the same time
-		qws := sk.queue.PoppedItem()
-		if qws.ws.id.String() != sid {
+		qws := sk.queue.Running(sid)
+		if qws == nil {
 			return ErrWorkSpaceIsNotPlotting
 		}
 		qws.wouldMining = false
@@ -370,9 +409,15 @@ func (sk *SpaceKeeper) StopWS(sid string) error {
 
 // RemoveWS should only be applied on registered/ready workSpace
 // WorkSpace in spaceKeeper workSpaceList would be removed
-func (sk *SpaceKeeper) RemoveWS(sid string) error {
+func (sk *SpaceKeeper) RemoveWS(sid string) (err error) {
 	sk.stateLock.Lock()
 	defer sk.stateLock.Unlock()
+	defer func() {
+		if err == nil {
+			// removed workSpace is neither plotted nor mined after restart
+			err = sk.persistDesired(sid, engine.Stop)
+		}
+	}()
 
 	var ok bool
 	var ws *WorkSpace
@@ -394,9 +439,14 @@ func (sk *SpaceKeeper) RemoveWS(sid string) error {
 
 // DeleteWS should only be applied on registered/ready workSpace
 // WorkSpace in spaceKeeper index and data in MassDB would be both deleted
-func (sk *SpaceKeeper) DeleteWS(sid string) error {
+func (sk *SpaceKeeper) DeleteWS(sid string) (err error) {
 	sk.stateLock.Lock()
 	defer sk.stateLock.Unlock()
+	defer func() {
+		if err == nil {
+			err = sk.spaceStates.Delete(sid)
+		}
+	}()
 
 	var ok bool
 	var ws *WorkSpace
@@ -490,7 +540,10 @@ func (sk *SpaceKeeper) ResetDBDirs(dbDirs []string) error {
 	}
 
 	if len(sk.dbDirs) == 0 {
+		sk.stateLock.Lock()
+		sk.proofDirs = append([]string(nil), dbDirs...)
 		sk.dbDirs = dbDirs
+		sk.stateLock.Unlock()
 		if err := sk.generateInitialIndex(); err != nil {
 			return err
 		}
@@ -516,10 +569,14 @@ func (sk *SpaceKeeper) AvailableDiskSize() uint64 {
 
 // TODO: consider more check items
 func (sk *SpaceKeeper) checkOSDiskSize(requiredBytes int) error {
+	return checkDirDiskSize(sk.dbDirs[0], requiredBytes)
+}
+
+func checkDirDiskSize(dir string, requiredBytes int) error {
 	if requiredBytes < 0 {
 		return ErrInvalidRequiredBytes
 	}
-	info, err := disk.Usage(sk.dbDirs[0])
+	info, err := disk.Usage(dir)
 	if err != nil {
 		return err
 	}
@@ -664,6 +721,7 @@ func (sk *SpaceKeeper) applyConfiguredWorkSpaces(wsList []*WorkSpace, execPlot,
 	if !(execMine || execPlot) {
 		sk.queue.Reset()
 	}
+	sk.applySpaceStates(wsList)
 	// collect workSpaceInfos
 	wsiList := make([]engine.WorkSpaceInfo, len(wsList))
 	for i, ws := range wsList {
@@ -905,6 +963,25 @@ func (sk *SpaceKeeper) generateFillSpaceListBySize(dstList []*WorkSpace, current
 }
 
 func (sk *SpaceKeeper) ConfigureByPubKey(PubKeyBL map[*pocec.PublicKey]int, PubKeyOrdinal map[*pocec.PublicKey]int, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
+	specs := make([]spaceSpec, 0, len(PubKeyBL))
+	for pubKey, bl := range PubKeyBL {
+		specs = append(specs, spaceSpec{ordinal: int64(PubKeyOrdinal[pubKey]), pubKey: pubKey, bitLength: bl})
+	}
+	return sk.configureBySpecs(specs, execPlot, execMine)
+}
+
+// spaceSpec describes a workSpace to be configured in dir, or the first dbDir
+// if dir is empty; a new key is generated from wallet if pubKey is nil.
+type spaceSpec struct {
+	ordinal   int64
+	pubKey    *pocec.PublicKey
+	bitLength int
+	dir       string
+}
+
+// configureBySpecs configures spaceKeeper with exactly the workSpaces in specs,
+// newly created workSpaces are deleted if any of them fails to be created.
+func (sk *SpaceKeeper) configureBySpecs(specs []spaceSpec, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
 	if sk.Started() {
 		return nil, ErrSpaceKeeperIsRunning
 	}
@@ -915,10 +992,15 @@ func (sk *SpaceKeeper) ConfigureByPubKey(PubKeyBL map[*pocec.PublicKey]int, PubK
 		return nil, ErrSpaceKeeperIsConfiguring
 	}
 	defer atomic.StoreInt32(&sk.configuring, 0)
+	return sk.applySpecs(specs, execPlot, execMine)
+}
+
+// applySpecs is not thread safe, should set configuring in upper functions
+func (sk *SpaceKeeper) applySpecs(specs []spaceSpec, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
 	atomic.StoreInt32(&sk.configured, 0)
 
 	var failureReturn = func(err error) ([]engine.WorkSpaceInfo, error) {
-		logging.CPrint(logging.ERROR, "fail on ConfigureByPubKey", logging.LogFormat{
+		logging.CPrint(logging.ERROR, "fail on configuring workSpaces", logging.LogFormat{
 			"exec_plot": execPlot,
 			"exec_mine": execMine,
 		})
@@ -926,7 +1008,7 @@ func (sk *SpaceKeeper) ConfigureByPubKey(PubKeyBL map[*pocec.PublicKey]int, PubK
 	}
 	var resultList = make([]*WorkSpace, 0)
 	var successfullyReturn = func() ([]engine.WorkSpaceInfo, error) {
-		if len(resultList) != len(PubKeyBL) {
+		if len(resultList) != len(specs) {
 			return failureReturn(ErrSpaceKeeperConfiguredNothing)
 		}
 		wsiList, err := sk.applyConfiguredWorkSpaces(resultList, execPlot, execMine)
@@ -937,7 +1019,7 @@ func (sk *SpaceKeeper) ConfigureByPubKey(PubKeyBL map[*pocec.PublicKey]int, PubK
 		return wsiList, nil
 	}
 
-	resultList, err := sk.generateFillSpaceListByPubKey(resultList, PubKeyBL, PubKeyOrdinal)
+	resultList, err := sk.generateFillSpaceListBySpecs(resultList, specs)
 	if err != nil {
 		return failureReturn(err)
 	}
@@ -945,28 +1027,71 @@ func (sk *SpaceKeeper) ConfigureByPubKey(PubKeyBL map[*pocec.PublicKey]int, PubK
 	return successfullyReturn()
 }
 
-func (sk *SpaceKeeper) generateFillSpaceListByPubKey(dstList []*WorkSpace, targetPubKeyBL map[*pocec.PublicKey]int, pubKeyOrdinal map[*pocec.PublicKey]int) ([]*WorkSpace, error) {
-	// check OS disk size
-	var requiredOSDiskSize int
-	for pubKey, bl := range targetPubKeyBL {
-		if _, exists := sk.workSpaceIndex[allState].Get(NewSpaceID(int64(pubKeyOrdinal[pubKey]), pubKey, bl).String()); !exists {
-			requiredOSDiskSize += poc.BitLengthDiskSize[bl]
+func (sk *SpaceKeeper) generateFillSpaceListBySpecs(dstList []*WorkSpace, specs []spaceSpec) ([]*WorkSpace, error) {
+	var specDir = func(spec spaceSpec) string {
+		if spec.dir == "" {
+			return sk.dbDirs[0]
 		}
+		return spec.dir
 	}
-	if err := sk.checkOSDiskSize(requiredOSDiskSize); err != nil {
-		return nil, err
+
+	// check OS disk size of each dir
+	var requiredOSDiskSize = make(map[string]int)
+	for _, spec := range specs {
+		if spec.pubKey != nil {
+			if _, exists := sk.workSpaceIndex[allState].Get(NewSpaceID(spec.ordinal, spec.pubKey, spec.bitLength).String()); exists {
+				continue
+			}
+		}
+		requiredOSDiskSize[specDir(spec)] += poc.BitLengthDiskSize[spec.bitLength]
+	}
+	for dir, requiredBytes := range requiredOSDiskSize {
+		if err := checkDirDiskSize(dir, requiredBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	// generate keys only after checks are passed, so that no key is wasted
+	for i := range specs {
+		if specs[i].pubKey != nil {
+			continue
+		}
+		pubKey, ordinal, err := sk.wallet.GenerateNewPublicKey()
+		if err != nil {
+			return nil, err
+		}
+		specs[i].ordinal, specs[i].pubKey = int64(ordinal), pubKey
+	}
+
+	var newList = make([]*WorkSpace, 0)
+	var rollback = func() {
+		for _, ws := range newList {
+			sid := ws.id.String()
+			sk.workSpaceIndex[ws.state].Delete(sid)
+			sk.workSpaceIndex[allState].Delete(sid)
+			// keep MassDB which has been plotted before
+			if ws.Progress() != 0 {
+				ws.db.Close()
+				continue
+			}
+			if err := ws.Delete(); err != nil {
+				logging.CPrint(logging.WARN, "fail to delete workSpace on rollback", logging.LogFormat{"sid": sid, "err": err})
+			}
+		}
 	}
 
-	for pubKey, bl := range targetPubKeyBL {
-		if ws, exists := sk.workSpaceIndex[allState].Get(NewSpaceID(int64(pubKeyOrdinal[pubKey]), pubKey, bl).String()); exists {
+	for _, spec := range specs {
+		if ws, exists := sk.workSpaceIndex[allState].Get(NewSpaceID(spec.ordinal, spec.pubKey, spec.bitLength).String()); exists {
 			dstList = append(dstList, ws)
 			continue
 		}
-		newWS, err := sk.generateNewWorkSpaceByPubKey(int64(pubKeyOrdinal[pubKey]), pubKey, bl)
+		newWS, err := sk.generateNewWorkSpaceByPubKey(spec.ordinal, spec.pubKey, spec.bitLength, specDir(spec))
 		if err != nil {
+			rollback()
 			return nil, err
 		}
 		sk.addWorkSpaceToIndex(newWS)
+		newList = append(newList, newWS)
 		dstList = append(dstList, newWS)
 	}
 
@@ -974,8 +1099,8 @@ func (sk *SpaceKeeper) generateFillSpaceListByPubKey(dstList []*WorkSpace, targe
 }
 
 // generateNewWorkSpace is not thread safe, should use lock in upper functions
-func (sk *SpaceKeeper) generateNewWorkSpaceByPubKey(ordinal int64, pubKey *pocec.PublicKey, bitLength int) (*WorkSpace, error) {
-	return NewWorkSpace(sk.dbType, sk.dbDirs[0], ordinal, pubKey, bitLength)
+func (sk *SpaceKeeper) generateNewWorkSpaceByPubKey(ordinal int64, pubKey *pocec.PublicKey, bitLength int, dir string) (*WorkSpace, error) {
+	return NewWorkSpace(sk.dbType, dir, ordinal, pubKey, bitLength)
 }
 
 func (sk *SpaceKeeper) ConfigureByFlags(flags engine.WorkSpaceStateFlags, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
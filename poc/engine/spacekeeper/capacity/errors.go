@@ -10,10 +10,13 @@ var (
 	ErrWorkSpaceIsNotMining     = errors.New("non-mining workSpace")
 	ErrWorkSpaceIsNotStill      = errors.New("non-registered or non-ready workSpace")
 	ErrWorkSpaceCannotGenerate  = errors.New("not allowed to generate new workSpace")
+	ErrWorkSpaceIsUnavailable   = errors.New("unavailable workSpace, db file is missing")
+	ErrWorkSpaceIsNotQueued     = errors.New("workSpace is not in plot queue")
 
 	ErrMassDBWrongFileName        = errors.New("db file name not standard")
 	ErrMassDBDuplicate            = errors.New("db file duplicate in root dirs")
 	ErrMassDBDoesNotMatchWithName = errors.New("db file content does not match with name")
+	ErrMassDBUnknownType          = errors.New("unknown massdb type")
 
 	ErrWalletDoesNotContainPubKey = errors.New("wallet does not contain pubKey")
 	ErrWalletIsLocked             = errors.New("wallet is locked")
@@ -27,4 +30,12 @@ var (
 	ErrConfigUnderSizeTarget = errors.New("target disk size is smaller than lower bound")
 	ErrOSDiskSizeNotEnough   = errors.New("os disk size is not enough")
 	ErrInvalidRequiredBytes  = errors.New("required disk size in bytes is not valid")
+
+	ErrCapacityPlanNoDir    = errors.New("no proof dir for capacity plan")
+	ErrCapacityPlanOutdated = errors.New("capacity plan is outdated")
+
+	ErrBenchmarkNoMiningSpace     = errors.New("no mining workSpace for proof benchmark")
+	ErrBenchmarkTooManyChallenges = errors.New("too many challenges for proof benchmark")
+
+	ErrSpaceStateVersion = errors.New("unknown version of space states file")
 )
@@ -0,0 +1,271 @@
+package capacity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"github.com/shirou/gopsutil/disk"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/poc/engine"
+)
+
+const (
+	// planMarginBytes is kept free on each device for file headers and file system overhead.
+	planMarginBytes = 64 * poc.MiB
+)
+
+// DirPlan is the capacity plan for one proof dir.
+// Dirs mounted on the same device share the free space of the device,
+// new workSpaces on a device are all planned into the first dir of it.
+type DirPlan struct {
+	Dir           string
+	Device        string
+	TotalBytes    uint64
+	FreeBytes     uint64
+	ReservedBytes uint64      // reserved for unfinished workSpaces, plotting files and margin
+	PlannedBytes  uint64      // disk size of new workSpaces
+	BitLengths    map[int]int // count of new workSpaces by bitLength
+}
+
+// CapacityPlan proposes new workSpaces for each proof dir,
+// ID identifies the proposed workSpaces and is required to apply the plan.
+type CapacityPlan struct {
+	ID             string
+	Dirs           []*DirPlan
+	PlannedBytes   uint64
+	AvailableBytes uint64
+}
+
+// PlanCapacity inspects the file system of each proof dir, and proposes the mix of
+// bitLengths that makes the most use of the free space on each device.
+func (sk *SpaceKeeper) PlanCapacity() (*CapacityPlan, error) {
+	if len(sk.dbDirs) == 0 {
+		return nil, ErrCapacityPlanNoDir
+	}
+
+	// remaining disk size of unfinished workSpaces in each dir
+	var unfinished = make(map[string]uint64)
+	for _, ws := range sk.workSpaceIndex[allState].Items() {
+		if progress := ws.Progress(); progress < 100 {
+			size := float64(poc.BitLengthDiskSize[ws.BitLength()])
+			unfinished[ws.rootDir] += uint64(size * (100 - progress) / 100)
+		}
+	}
+
+	plan := &CapacityPlan{Dirs: make([]*DirPlan, 0, len(sk.dbDirs))}
+	var planned = make(map[string]*DirPlan) // device -> dir to plan new workSpaces in
+	var devices = make([]string, 0, len(sk.dbDirs))
+	for _, dir := range sk.dbDirs {
+		info, err := disk.Usage(dir)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "fail to get disk usage", logging.LogFormat{"dir": dir, "err": err})
+			return nil, err
+		}
+		dp := &DirPlan{
+			Dir:           dir,
+			Device:        sk.devices.Device(dir),
+			TotalBytes:    info.Total,
+			FreeBytes:     info.Free,
+			ReservedBytes: unfinished[dir],
+			BitLengths:    make(map[int]int),
+		}
+		plan.Dirs = append(plan.Dirs, dp)
+		if _, exists := planned[dp.Device]; !exists {
+			planned[dp.Device] = dp
+			devices = append(devices, dp.Device)
+		}
+	}
+
+	for _, device := range devices {
+		dp := planned[device]
+		dp.ReservedBytes += planMarginBytes
+		var reserved uint64
+		for _, d := range plan.Dirs {
+			if d.Device == device {
+				reserved += d.ReservedBytes
+			}
+		}
+		var available uint64
+		if dp.FreeBytes > reserved {
+			available = dp.FreeBytes - reserved
+		}
+
+		dp.BitLengths = planBitLengths(available, usableBitLength())
+		// HashMapA is plotted beside HashMapB without plot temp dir, it takes half
+		// the size of HashMapB until the workSpace is plotted
+		if sk.plotTempDir == "" {
+			if plotting := sk.plottingReserve(dp.BitLengths); plotting > 0 {
+				if available > plotting {
+					available -= plotting
+				} else {
+					available = 0
+				}
+				dp.ReservedBytes += plotting
+				dp.BitLengths = planBitLengths(available, usableBitLength())
+			}
+		}
+		for bl, count := range dp.BitLengths {
+			dp.PlannedBytes += uint64(count) * uint64(poc.BitLengthDiskSize[bl])
+		}
+		plan.PlannedBytes += dp.PlannedBytes
+		plan.AvailableBytes += available
+	}
+	plan.ID = planID(plan)
+
+	return plan, nil
+}
+
+// plottingReserve returns the disk size taken by HashMapA of workSpaces plotted
+// at the same time on one device.
+func (sk *SpaceKeeper) plottingReserve(blCount map[int]int) uint64 {
+	var bls = make([]int, 0, len(blCount))
+	for bl, count := range blCount {
+		if count > 0 {
+			bls = append(bls, bl)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(bls)))
+
+	var slots = sk.plotPerDisk
+	if slots <= 0 {
+		slots = 1
+	}
+	var reserve uint64
+	for _, bl := range bls {
+		for i := 0; i < blCount[bl] && slots > 0; i++ {
+			reserve += uint64(poc.BitLengthDiskSize[bl] / 2)
+			slots--
+		}
+	}
+	return reserve
+}
+
+// planBitLengths returns the count of workSpaces by bitLength that fills available
+// bytes the most, using as few workSpaces as possible.
+// WorkSpaces of the largest bitLength are taken until the remaining size is less than
+// twice of it, then the remaining size is filled exactly by dynamic programming in
+// units of the greatest common divisor of disk sizes.
+func planBitLengths(available uint64, bls []int) map[int]int {
+	var result = make(map[int]int)
+	if len(bls) == 0 {
+		return result
+	}
+	bls = append([]int(nil), bls...)
+	sort.Sort(sort.Reverse(sort.IntSlice(bls)))
+
+	var unit = uint64(poc.BitLengthDiskSize[bls[0]])
+	for _, bl := range bls[1:] {
+		unit = gcd(unit, uint64(poc.BitLengthDiskSize[bl]))
+	}
+	var sizes = make([]int, len(bls))
+	for i, bl := range bls {
+		sizes[i] = int(uint64(poc.BitLengthDiskSize[bl]) / unit)
+	}
+
+	var units = available / unit
+	if largest := uint64(sizes[0]); units >= 2*largest {
+		n := units/largest - 1
+		result[bls[0]] = int(n)
+		units -= n * largest
+	}
+
+	// count[c] is the least count of workSpaces filling c units exactly, -1 if impossible,
+	// choice[c] is the index of the last workSpace size taken
+	var rem = int(units)
+	var count, choice = make([]int, rem+1), make([]int, rem+1)
+	for c := 1; c <= rem; c++ {
+		count[c] = -1
+		for i, size := range sizes {
+			if size > c || count[c-size] < 0 {
+				continue
+			}
+			if count[c] < 0 || count[c-size]+1 < count[c] {
+				count[c], choice[c] = count[c-size]+1, i
+			}
+		}
+	}
+	var c = rem
+	for count[c] < 0 {
+		c--
+	}
+	for ; c > 0; c -= sizes[choice[c]] {
+		result[bls[choice[c]]]++
+	}
+
+	return result
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// planID returns a digest of new workSpaces proposed in plan.
+func planID(plan *CapacityPlan) string {
+	var h = sha256.New()
+	for _, dp := range plan.Dirs {
+		var bls = make([]int, 0, len(dp.BitLengths))
+		for bl := range dp.BitLengths {
+			bls = append(bls, bl)
+		}
+		sort.Ints(bls)
+		for _, bl := range bls {
+			if dp.BitLengths[bl] > 0 {
+				fmt.Fprintf(h, "%s:%d:%d\n", dp.Dir, bl, dp.BitLengths[bl])
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ApplyCapacityPlan configures spaceKeeper with all available indexed workSpaces and
+// new workSpaces proposed by the plan identified by planID. The plan is proposed again
+// and checked against planID, so that it is applied as it was reviewed.
+// No new workSpace is left if any of them fails to be created.
+func (sk *SpaceKeeper) ApplyCapacityPlan(planID string, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
+	if !sk.allowGenerateNewSpace {
+		return nil, ErrWorkSpaceCannotGenerate
+	}
+	if sk.Started() {
+		return nil, ErrSpaceKeeperIsRunning
+	}
+	if sk.wallet.IsLocked() {
+		return nil, ErrWalletIsLocked
+	}
+	if !atomic.CompareAndSwapInt32(&sk.configuring, 0, 1) {
+		return nil, ErrSpaceKeeperIsConfiguring
+	}
+	defer atomic.StoreInt32(&sk.configuring, 0)
+
+	plan, err := sk.PlanCapacity()
+	if err != nil {
+		return nil, err
+	}
+	if plan.ID != planID {
+		logging.CPrint(logging.WARN, "capacity plan is outdated", logging.LogFormat{"expected": planID, "actual": plan.ID})
+		return nil, ErrCapacityPlanOutdated
+	}
+
+	var specs = make([]spaceSpec, 0)
+	for _, ws := range sk.workSpaceIndex[allState].Items() {
+		if ws.Available() {
+			specs = append(specs, spaceSpec{ordinal: ws.id.Ordinal(), pubKey: ws.id.PubKey(), bitLength: ws.id.BitLength()})
+		}
+	}
+	// keys of new workSpaces are generated by applySpecs
+	for _, dp := range plan.Dirs {
+		for bl, count := range dp.BitLengths {
+			for i := 0; i < count; i++ {
+				specs = append(specs, spaceSpec{bitLength: bl, dir: dp.Dir})
+			}
+		}
+	}
+
+	return sk.applySpecs(specs, execPlot, execMine)
+}
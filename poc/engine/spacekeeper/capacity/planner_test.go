@@ -0,0 +1,130 @@
+package capacity
+
+import (
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"massnet.org/mass/massutil/service"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/pocec"
+)
+
+func planSize(blCount map[int]int) uint64 {
+	var size uint64
+	for bl, count := range blCount {
+		size += uint64(count) * uint64(poc.BitLengthDiskSize[bl])
+	}
+	return size
+}
+
+func TestPlanBitLengths(t *testing.T) {
+	var size = func(bl int) uint64 { return uint64(poc.BitLengthDiskSize[bl]) }
+	tests := []struct {
+		available uint64
+		expected  map[int]int
+	}{
+		{0, map[int]int{}},
+		{size(24) - 1, map[int]int{}},
+		{size(24), map[int]int{24: 1}},
+		{size(26) + size(24)*2 + size(24)/2, map[int]int{26: 1, 24: 2}},
+		{size(28) * 5, map[int]int{28: 5}},
+		{size(28)*3 + size(26)*3 + size(24)*5 + 1, map[int]int{28: 3, 26: 3, 24: 5}},
+		// 6 * 96MiB fills 576MiB better than 512MiB
+		{size(24) * 6, map[int]int{24: 6}},
+	}
+	for i, test := range tests {
+		result := planBitLengths(test.available, usableBitLength())
+		for bl, count := range result {
+			if count != test.expected[bl] {
+				t.Errorf("%d, bitLength %d, expected %d, got %d", i, bl, test.expected[bl], count)
+			}
+		}
+		for bl, count := range test.expected {
+			if result[bl] != count {
+				t.Errorf("%d, bitLength %d, expected %d, got %d", i, bl, count, result[bl])
+			}
+		}
+	}
+}
+
+func TestPlanBitLengthsOptimal(t *testing.T) {
+	// brute force on the count of small bitLengths, which are bounded by replacing
+	// them with larger ones of the same size
+	var unit = uint64(32 * poc.MiB)
+	for available := uint64(0); available < 10*uint64(poc.BitLengthDiskSize[28]); available += unit - 7 {
+		var best uint64
+		for n24 := 0; n24 < 32; n24++ {
+			for n26 := 0; n26 < 8; n26++ {
+				used := planSize(map[int]int{24: n24, 26: n26})
+				if used > available {
+					continue
+				}
+				used += (available - used) / uint64(poc.BitLengthDiskSize[28]) * uint64(poc.BitLengthDiskSize[28])
+				if used > best {
+					best = used
+				}
+			}
+		}
+		if used := planSize(planBitLengths(available, usableBitLength())); used != best {
+			t.Fatalf("available %d, expected %d, got %d", available, best, used)
+		}
+	}
+}
+
+// keyWallet is an unlocked wallet counting generated keys.
+type keyWallet struct {
+	PoCWallet
+	generated int
+}
+
+func (w *keyWallet) GenerateNewPublicKey() (*pocec.PublicKey, uint32, error) {
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		return nil, 0, err
+	}
+	w.generated++
+	return sk.PubKey(), uint32(w.generated), nil
+}
+
+func (w *keyWallet) IsLocked() bool {
+	return false
+}
+
+func TestApplyCapacityPlanKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "planner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	wallet := &keyWallet{}
+	sk := &SpaceKeeper{allowGenerateNewSpace: true, dbDirs: []string{dir}, wallet: wallet}
+	sk.BaseService = service.NewBaseService(sk, TypeSpaceKeeperV1)
+	for s := engine.FirstState; s <= allState; s++ {
+		sk.workSpaceIndex = append(sk.workSpaceIndex, NewWorkSpaceMap())
+	}
+
+	// no key is generated for specs failing checks
+	specs := []spaceSpec{{bitLength: 24, dir: dir}, {bitLength: poc.MaxValidBitLength, dir: dir}}
+	if _, err = sk.configureBySpecs(specs, false, false); err != ErrOSDiskSizeNotEnough {
+		t.Errorf("expected %v, got %v", ErrOSDiskSizeNotEnough, err)
+	}
+	if wallet.generated != 0 {
+		t.Errorf("%d keys generated for specs failing checks", wallet.generated)
+	}
+
+	// configuring is taken by only one caller, and kept by the one failing to take it
+	atomic.StoreInt32(&sk.configuring, 1)
+	if _, err = sk.ApplyCapacityPlan("", false, false); err != ErrSpaceKeeperIsConfiguring {
+		t.Errorf("expected %v, got %v", ErrSpaceKeeperIsConfiguring, err)
+	}
+	if atomic.LoadInt32(&sk.configuring) != 1 {
+		t.Error("configuring is reset by the failed caller")
+	}
+	if wallet.generated != 0 {
+		t.Errorf("%d keys generated while configuring", wallet.generated)
+	}
+}
@@ -0,0 +1,189 @@
+package capacity
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/shirou/gopsutil/disk"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc"
+)
+
+const (
+	// maxConcurrentPlots is the max number of workSpaces plotted at the same time.
+	maxConcurrentPlots = maxPoolWorker
+
+	// maxPlotMemory is the max memory used by plotting a massdb.v1 workSpace.
+	maxPlotMemory = 4 * 1024 * poc.MiB
+)
+
+// estimatePlotMemory returns the memory in bytes expected to be used by plotting
+// a workSpace of bitLength, which is the size of HashMapA limited by maxPlotMemory.
+func estimatePlotMemory(bitLength int) uint64 {
+	size := uint64(poc.BitLengthDiskSize[bitLength] / 2)
+	if size > maxPlotMemory {
+		size = maxPlotMemory
+	}
+	return size
+}
+
+// deviceCache maps proof dirs to the devices they are mounted on.
+// The zero value is ready to use.
+type deviceCache struct {
+	mu      sync.Mutex
+	devices map[string]string
+}
+
+// Device returns the device dir is mounted on. If the device cannot be
+// determined, dir itself is returned, so that dirs are treated as
+// different devices.
+func (dc *deviceCache) Device(dir string) string {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	if device, ok := dc.devices[dir]; ok {
+		return device
+	}
+	if dc.devices == nil {
+		dc.devices = make(map[string]string)
+	}
+	device := lookupDevice(dir)
+	dc.devices[dir] = device
+	return device
+}
+
+// lookupDevice finds the partition with the longest mount point containing dir.
+func lookupDevice(dir string) string {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+	partitions, err := disk.Partitions(true)
+	if err != nil {
+		logging.CPrint(logging.WARN, "fail to get disk partitions", logging.LogFormat{"dir": dir, "err": err})
+		return absDir
+	}
+
+	var device, mountPoint string
+	for _, p := range partitions {
+		if !isSubPath(p.Mountpoint, absDir) || len(p.Mountpoint) <= len(mountPoint) {
+			continue
+		}
+		device, mountPoint = p.Device, p.Mountpoint
+	}
+	if device == "" {
+		return absDir
+	}
+	return device
+}
+
+func isSubPath(parent, path string) bool {
+	rel, err := filepath.Rel(parent, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// plotSlots accounts running plots by device and memory.
+// It is only accessed by spacePlotter goroutine.
+type plotSlots struct {
+	perDevice   int
+	memoryLimit uint64 // 0 means unlimited
+	devices     map[string]int
+	memory      uint64
+	count       int
+}
+
+func newPlotSlots(perDevice int, memoryLimit uint64) *plotSlots {
+	if perDevice <= 0 {
+		perDevice = 1
+	}
+	return &plotSlots{
+		perDevice:   perDevice,
+		memoryLimit: memoryLimit,
+		devices:     make(map[string]int),
+	}
+}
+
+// Fits returns whether a plot on device using memory could be started now.
+// A plot is always allowed if nothing is running, even if it exceeds memoryLimit.
+func (ps *plotSlots) Fits(device string, memory uint64) bool {
+	if ps.count >= maxConcurrentPlots || ps.devices[device] >= ps.perDevice {
+		return false
+	}
+	return ps.count == 0 || ps.memoryLimit == 0 || ps.memory+memory <= ps.memoryLimit
+}
+
+func (ps *plotSlots) Acquire(device string, memory uint64) {
+	ps.devices[device]++
+	ps.memory += memory
+	ps.count++
+}
+
+func (ps *plotSlots) Release(device string, memory uint64) {
+	if ps.devices[device]--; ps.devices[device] <= 0 {
+		delete(ps.devices, device)
+	}
+	ps.memory -= memory
+	ps.count--
+}
+
+// PlotQueueItem describes a workSpace in plot queue.
+type PlotQueueItem struct {
+	SpaceID     string
+	Device      string
+	Running     bool
+	Position    int // position in queue starting from 1, 0 for running workSpaces
+	Progress    float64
+	WouldMining bool
+	Memory      uint64 // estimated memory in bytes used by plotting
+}
+
+// PlotQueue returns running and queued workSpaces, along with whether
+// the queue is paused.
+func (sk *SpaceKeeper) PlotQueue() ([]PlotQueueItem, bool) {
+	sk.stateLock.RLock()
+	defer sk.stateLock.RUnlock()
+
+	running, queued := sk.queue.Items()
+	result := make([]PlotQueueItem, 0, len(running)+len(queued))
+	var newItem = func(qws *queuedWorkSpace) PlotQueueItem {
+		return PlotQueueItem{
+			SpaceID:     qws.ws.id.String(),
+			Device:      sk.devices.Device(qws.ws.rootDir),
+			Progress:    qws.ws.Progress(),
+			WouldMining: qws.wouldMining,
+			Memory:      estimatePlotMemory(qws.ws.id.BitLength()),
+		}
+	}
+	for _, qws := range running {
+		item := newItem(qws)
+		item.Running = true
+		result = append(result, item)
+	}
+	for i, qws := range queued {
+		item := newItem(qws)
+		item.Position = i + 1
+		result = append(result, item)
+	}
+	return result, sk.queue.Paused()
+}
+
+// PausePlotQueue stops starting new plots, running plots are not interrupted.
+func (sk *SpaceKeeper) PausePlotQueue() {
+	sk.queue.Pause()
+	logging.CPrint(logging.INFO, "plot queue paused")
+}
+
+func (sk *SpaceKeeper) ResumePlotQueue() {
+	sk.queue.Resume()
+	logging.CPrint(logging.INFO, "plot queue resumed")
+}
+
+// ReorderPlotQueue moves queued workSpaces of sids to the front of plot queue
+// in given order.
+func (sk *SpaceKeeper) ReorderPlotQueue(sids []string) error {
+	return sk.queue.Reorder(sids)
+}
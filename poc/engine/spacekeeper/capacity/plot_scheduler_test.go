@@ -0,0 +1,108 @@
+package capacity
+
+import (
+	"testing"
+
+	"massnet.org/mass/pocec"
+)
+
+func newTestQueuedWorkSpaces(t *testing.T, count int) []*queuedWorkSpace {
+	result := make([]*queuedWorkSpace, count)
+	for i := range result {
+		privKey, err := pocec.NewPrivateKey(pocec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ws := &WorkSpace{id: NewSpaceID(int64(i), privKey.PubKey(), 24), rootDir: t.Name()}
+		result[i] = newQueuedWorkSpace(ws, false)
+	}
+	return result
+}
+
+func queuedSpaceIDs(pq *plotterQueue) []string {
+	_, queued := pq.Items()
+	sids := make([]string, len(queued))
+	for i, qws := range queued {
+		sids[i] = qws.ws.id.String()
+	}
+	return sids
+}
+
+func TestPlotterQueue(t *testing.T) {
+	items := newTestQueuedWorkSpaces(t, 4)
+	pq := newPlotterQueue()
+	for _, qws := range items {
+		pq.Push(qws, qws.priority())
+	}
+	sid := func(i int) string { return items[i].ws.id.String() }
+	var checkOrder = func(desc string, expected ...int) {
+		actual := queuedSpaceIDs(pq)
+		if len(actual) != len(expected) {
+			t.Fatalf("%s: expected %d queued, got %d", desc, len(expected), len(actual))
+		}
+		for i, idx := range expected {
+			if actual[i] != sid(idx) {
+				t.Errorf("%s: position %d, expected workSpace %d", desc, i, idx)
+			}
+		}
+	}
+
+	// lower ordinal first by default
+	checkOrder("default", 0, 1, 2, 3)
+
+	if err := pq.Reorder([]string{sid(2), sid(3)}); err != nil {
+		t.Fatal(err)
+	}
+	checkOrder("reorder", 2, 3, 0, 1)
+
+	if err := pq.Reorder([]string{sid(1), "unknown"}); err != ErrWorkSpaceIsNotQueued {
+		t.Errorf("expected %v, got %v", ErrWorkSpaceIsNotQueued, err)
+	}
+	checkOrder("failed reorder", 2, 3, 0, 1)
+
+	// skip workSpaces not runnable
+	qws := pq.PopRunnable(func(qws *queuedWorkSpace) bool { return qws != items[2] })
+	if qws != items[3] || pq.Running(sid(3)) != qws {
+		t.Error("PopRunnable should return the first runnable workSpace and mark it running")
+	}
+	checkOrder("pop runnable", 2, 0, 1)
+
+	pq.Pause()
+	if pq.PopRunnable(func(*queuedWorkSpace) bool { return true }) != nil {
+		t.Error("paused queue should not pop workSpace")
+	}
+	pq.Resume()
+	select {
+	case <-pq.notifyCh:
+	default:
+		t.Error("resume should notify spacePlotter")
+	}
+
+	pq.Finish(qws)
+	if pq.Running(sid(3)) != nil {
+		t.Error("finished workSpace should not be running")
+	}
+}
+
+func TestPlotSlots(t *testing.T) {
+	slots := newPlotSlots(1, 100)
+
+	// the first plot is always allowed
+	if !slots.Fits("sda", 200) {
+		t.Error("first plot should fit")
+	}
+	slots.Acquire("sda", 60)
+	if slots.Fits("sda", 10) {
+		t.Error("device should be limited")
+	}
+	if slots.Fits("sdb", 50) {
+		t.Error("memory should be limited")
+	}
+	if !slots.Fits("sdb", 40) {
+		t.Error("plot on another device should fit")
+	}
+	slots.Release("sda", 60)
+	if !slots.Fits("sda", 100) || slots.count != 0 || slots.memory != 0 {
+		t.Error("slots should be released")
+	}
+}
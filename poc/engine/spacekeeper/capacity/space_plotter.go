@@ -3,6 +3,7 @@ package capacity
 import (
 	"encoding/binary"
 	"math"
+	"sort"
 	"sync"
 
 	"gopkg.in/karalabe/cookiejar.v2/collections/prque"
@@ -13,6 +14,7 @@ import (
 type queuedWorkSpace struct {
 	ws          *WorkSpace
 	wouldMining bool
+	rank        float32 // set by reordering, overrides default priority
 }
 
 // newQueuedWorkSpace creates queuedWorkSpace from an existing workSpace.
@@ -25,70 +27,233 @@ func newQueuedWorkSpace(ws *WorkSpace, wouldMining bool) *queuedWorkSpace {
 }
 
 func (qws *queuedWorkSpace) priority() float32 {
+	if qws.rank != 0 {
+		return qws.rank
+	}
 	// diff prevents workSpaces have same priority
 	var diff = float32(binary.LittleEndian.Uint32(qws.ws.id.PubKeyHash().Bytes()[:4])>>1) / float32(math.MaxUint32)
 	return -float32(qws.ws.id.Ordinal()) + diff
 }
 
+// plotterQueue holds workSpaces waiting to be plotted, along with the running ones.
+// All methods are concurrent safe.
 type plotterQueue struct {
-	*prque.Prque
-	sync.Mutex
-	poppedItem *queuedWorkSpace
+	prque    *prque.Prque
+	mu       sync.Mutex
+	running  map[string]*queuedWorkSpace
+	paused   bool
+	notifyCh chan struct{} // notifies spacePlotter on resuming and reordering
 }
 
 func newPlotterQueue() *plotterQueue {
 	return &plotterQueue{
-		Prque: prque.New(),
+		prque:    prque.New(),
+		running:  make(map[string]*queuedWorkSpace),
+		notifyCh: make(chan struct{}, 1),
 	}
 }
 
-func (pq *plotterQueue) Pop() (*queuedWorkSpace, float32) {
-	pq.Lock()
-	defer pq.Unlock()
+func (pq *plotterQueue) Push(qws *queuedWorkSpace, priority float32) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
 
-	data, priority := pq.Prque.Pop()
-	ws := data.(*queuedWorkSpace)
-	pq.poppedItem = ws
-	return ws, priority
+	pq.prque.Push(qws, priority)
 }
 
+func (pq *plotterQueue) Empty() bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.prque.Empty()
+}
+
+func (pq *plotterQueue) Size() int {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.prque.Size()
+}
+
+// PopItem pops the workSpace with highest priority, without marking it running.
 func (pq *plotterQueue) PopItem() *queuedWorkSpace {
-	pq.Lock()
-	defer pq.Unlock()
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
 
-	ws := pq.Prque.PopItem().(*queuedWorkSpace)
-	pq.poppedItem = ws
-	return ws
+	return pq.prque.PopItem().(*queuedWorkSpace)
+}
+
+// PopRunnable pops the workSpace with highest priority which is accepted by runnable,
+// and marks it running. Nil is returned if no workSpace is accepted or queue is paused.
+func (pq *plotterQueue) PopRunnable(runnable func(qws *queuedWorkSpace) bool) *queuedWorkSpace {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if pq.paused {
+		return nil
+	}
+	var result *queuedWorkSpace
+	var skipped []*queuedWorkSpace
+	var priorities []float32
+	for !pq.prque.Empty() {
+		data, priority := pq.prque.Pop()
+		qws := data.(*queuedWorkSpace)
+		if runnable(qws) {
+			result = qws
+			break
+		}
+		skipped = append(skipped, qws)
+		priorities = append(priorities, priority)
+	}
+	for i, qws := range skipped {
+		pq.prque.Push(qws, priorities[i])
+	}
+	if result != nil {
+		pq.running[result.ws.id.String()] = result
+	}
+	return result
+}
+
+// Finish removes workSpace from running ones.
+func (pq *plotterQueue) Finish(qws *queuedWorkSpace) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	sid := qws.ws.id.String()
+	if pq.running[sid] == qws {
+		delete(pq.running, sid)
+	}
+}
+
+// Running returns the running queuedWorkSpace of sid, or nil if not running.
+func (pq *plotterQueue) Running(sid string) *queuedWorkSpace {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.running[sid]
 }
 
 func (pq *plotterQueue) Delete(sid string) {
-	pq.Lock()
-	defer pq.Unlock()
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
 
 	newQueue := prque.New()
-	for !pq.Empty() {
-		qws, priority := pq.Prque.Pop()
+	for !pq.prque.Empty() {
+		qws, priority := pq.prque.Pop()
 		if qws.(*queuedWorkSpace).ws.id.String() == sid {
 			continue
 		}
 		newQueue.Push(qws, priority)
 	}
-	pq.Prque = newQueue
+	pq.prque = newQueue
 }
 
-func (pq *plotterQueue) PoppedItem() *queuedWorkSpace {
-	pq.Lock()
-	defer pq.Unlock()
+// Items returns running workSpaces sorted by sid, and queued workSpaces in plotting order.
+func (pq *plotterQueue) Items() (running, queued []*queuedWorkSpace) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	for _, qws := range pq.running {
+		running = append(running, qws)
+	}
+	sort.Slice(running, func(i, j int) bool {
+		return running[i].ws.id.String() < running[j].ws.id.String()
+	})
 
-	return pq.poppedItem
+	var priorities []float32
+	for !pq.prque.Empty() {
+		data, priority := pq.prque.Pop()
+		queued = append(queued, data.(*queuedWorkSpace))
+		priorities = append(priorities, priority)
+	}
+	for i, qws := range queued {
+		pq.prque.Push(qws, priorities[i])
+	}
+	return running, queued
 }
 
+// Reorder moves queued workSpaces of sids to the front of queue in given order,
+// the others keep their relative order.
+func (pq *plotterQueue) Reorder(sids []string) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	var queued []*queuedWorkSpace
+	var priorities []float32
+	index := make(map[string]*queuedWorkSpace)
+	for !pq.prque.Empty() {
+		data, priority := pq.prque.Pop()
+		qws := data.(*queuedWorkSpace)
+		queued = append(queued, qws)
+		priorities = append(priorities, priority)
+		index[qws.ws.id.String()] = qws
+	}
+
+	ordered := make([]*queuedWorkSpace, 0, len(queued))
+	moved := make(map[*queuedWorkSpace]bool)
+	for _, sid := range sids {
+		qws, ok := index[sid]
+		if !ok {
+			for i, qws := range queued {
+				pq.prque.Push(qws, priorities[i])
+			}
+			return ErrWorkSpaceIsNotQueued
+		}
+		if !moved[qws] {
+			moved[qws] = true
+			ordered = append(ordered, qws)
+		}
+	}
+	for _, qws := range queued {
+		if !moved[qws] {
+			ordered = append(ordered, qws)
+		}
+	}
+
+	// reordered ranks are always above default priorities, which are less than 1
+	for i, qws := range ordered {
+		qws.rank = float32(len(ordered)-i) + 1
+		pq.prque.Push(qws, qws.rank)
+	}
+	pq.notify()
+	return nil
+}
+
+func (pq *plotterQueue) Pause() {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	pq.paused = true
+}
+
+func (pq *plotterQueue) Resume() {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	pq.paused = false
+	pq.notify()
+}
+
+func (pq *plotterQueue) Paused() bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.paused
+}
+
+// notify is not thread safe, should use lock in upper functions
+func (pq *plotterQueue) notify() {
+	select {
+	case pq.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Reset clears queued workSpaces, running workSpaces are not affected.
 func (pq *plotterQueue) Reset() {
-	pq.Lock()
-	defer pq.Unlock()
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
 
-	pq.Prque.Reset()
-	pq.poppedItem = nil
+	pq.prque.Reset()
 	return
 }
 
@@ -158,35 +323,59 @@ func (sk *SpaceKeeper) spacePlotter() {
 		wg.Done()
 	}
 
-	logging.CPrint(logging.INFO, "space plotter started", logging.LogFormat{"queue_length": sk.queue.Size()})
+	// plots run concurrently, limited by device and memory
+	slots := newPlotSlots(sk.plotPerDisk, sk.plotMemoryLimit)
+	doneCh := make(chan *queuedWorkSpace, maxConcurrentPlots)
+	var runnable = func(qws *queuedWorkSpace) bool {
+		return slots.Fits(sk.devices.Device(qws.ws.rootDir), estimatePlotMemory(qws.ws.id.BitLength()))
+	}
+	var startPlot = func(qws *queuedWorkSpace) {
+		slots.Acquire(sk.devices.Device(qws.ws.rootDir), estimatePlotMemory(qws.ws.id.BitLength()))
+		killMonitorCh := make(chan struct{}, 1)
+		wg.Add(2)
+		go monitor(qws.ws, killMonitorCh)
+		go func() {
+			plotSpace(qws)
+			close(killMonitorCh)
+			doneCh <- qws
+			wg.Done()
+		}()
+	}
+	var finishPlot = func(qws *queuedWorkSpace) {
+		slots.Release(sk.devices.Device(qws.ws.rootDir), estimatePlotMemory(qws.ws.id.BitLength()))
+		sk.queue.Finish(qws)
+	}
+
+	logging.CPrint(logging.INFO, "space plotter started", logging.LogFormat{
+		"queue_length": sk.queue.Size(),
+		"per_disk":     slots.perDevice,
+		"memory_limit": slots.memoryLimit,
+	})
 	defer func() {
 		sk.queue.Reset()
 	}()
 
 	for {
-		for !sk.queue.Empty() {
-			select {
-			case <-sk.quit:
-				wg.Wait()
-				return
-			default:
+		for {
+			qws := sk.queue.PopRunnable(runnable)
+			if qws == nil {
+				break
 			}
-
-			qws := sk.queue.PopItem()
-			killMonitorCh := make(chan struct{}, 1)
-			wg.Add(1)
-			go monitor(qws.ws, killMonitorCh)
-			plotSpace(qws)
-			close(killMonitorCh)
+			startPlot(qws)
 		}
 
 		select {
 		case <-sk.quit:
 			wg.Wait()
+			for len(doneCh) > 0 {
+				finishPlot(<-doneCh)
+			}
 			return
 		case qws := <-sk.newQueuedWorkSpaceCh:
 			addSpaces(qws, sk.newQueuedWorkSpaceCh)
+		case qws := <-doneCh:
+			finishPlot(qws)
+		case <-sk.queue.notifyCh:
 		}
-
 	}
 }
@@ -0,0 +1,262 @@
+package capacity
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc/engine"
+)
+
+const (
+	// SpaceStateFileName is the file persisting desired states of workSpaces, in miner dir.
+	SpaceStateFileName = "spacestates.json"
+
+	spaceStateVersion = 1
+)
+
+// SpaceState is the desired state of a workSpace, which is persisted and restored on startup.
+// Desired is the last action of plot, mine or stop acted on the workSpace, empty if never acted on.
+type SpaceState struct {
+	Desired       string   `json:"desired,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	PayoutAddress string   `json:"payout_address,omitempty"`
+}
+
+type spaceStateFile struct {
+	Version int                    `json:"version"`
+	Spaces  map[string]*SpaceState `json:"spaces"`
+}
+
+// spaceStateStore keeps SpaceStates by sid, and writes all of them to file on each change.
+// All methods are concurrent safe.
+type spaceStateStore struct {
+	mu     sync.Mutex
+	path   string // empty path keeps states in memory only
+	states map[string]*SpaceState
+}
+
+// loadSpaceStateStore loads SpaceStates from path, an empty store is returned if file does not exist.
+func loadSpaceStateStore(path string) (*spaceStateStore, error) {
+	store := &spaceStateStore{
+		path:   path,
+		states: make(map[string]*SpaceState),
+	}
+	if path == "" {
+		return store, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return store, nil
+		}
+		logging.CPrint(logging.ERROR, "fail to read space states", logging.LogFormat{"path": path, "err": err})
+		return nil, err
+	}
+	var file spaceStateFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		logging.CPrint(logging.ERROR, "fail to decode space states", logging.LogFormat{"path": path, "err": err})
+		return nil, err
+	}
+	if file.Version != spaceStateVersion {
+		logging.CPrint(logging.ERROR, "unknown space states version", logging.LogFormat{"path": path, "version": file.Version})
+		return nil, ErrSpaceStateVersion
+	}
+	for sid, state := range file.Spaces {
+		if state != nil {
+			store.states[sid] = state
+		}
+	}
+	return store, nil
+}
+
+// Get returns a copy of SpaceState of sid.
+func (s *spaceStateStore) Get(sid string) (SpaceState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[sid]
+	if !ok {
+		return SpaceState{}, false
+	}
+	return copySpaceState(state), true
+}
+
+// Items returns copies of all SpaceStates.
+func (s *spaceStateStore) Items() map[string]SpaceState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make(map[string]SpaceState, len(s.states))
+	for sid, state := range s.states {
+		result[sid] = copySpaceState(state)
+	}
+	return result
+}
+
+// SetDesired records action as the desired state of sid.
+func (s *spaceStateStore) SetDesired(sid string, action engine.ActionType) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.getOrCreate(sid)
+	if state.Desired == action.String() {
+		return nil
+	}
+	state.Desired = action.String()
+	return s.save()
+}
+
+// SetLabels replaces tags and preferred payout address of sid.
+func (s *spaceStateStore) SetLabels(sid string, tags []string, payoutAddress string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.getOrCreate(sid)
+	state.Tags = normalizeTags(tags)
+	state.PayoutAddress = payoutAddress
+	return s.save()
+}
+
+// Delete removes SpaceState of sid.
+func (s *spaceStateStore) Delete(sid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.states[sid]; !ok {
+		return nil
+	}
+	delete(s.states, sid)
+	return s.save()
+}
+
+// getOrCreate is not thread safe, should use lock in upper functions
+func (s *spaceStateStore) getOrCreate(sid string) *SpaceState {
+	state, ok := s.states[sid]
+	if !ok {
+		state = &SpaceState{}
+		s.states[sid] = state
+	}
+	return state
+}
+
+// save writes all SpaceStates to a temporary file and renames it to path,
+// so that the file is never left half written.
+// save is not thread safe, should use lock in upper functions
+func (s *spaceStateStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(&spaceStateFile{Version: spaceStateVersion, Spaces: s.states}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	tmpPath := s.path + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		logging.CPrint(logging.ERROR, "fail to write space states", logging.LogFormat{"path": tmpPath, "err": err})
+		return err
+	}
+	if err = os.Rename(tmpPath, s.path); err != nil {
+		logging.CPrint(logging.ERROR, "fail to write space states", logging.LogFormat{"path": s.path, "err": err})
+		return err
+	}
+	return nil
+}
+
+func copySpaceState(state *SpaceState) SpaceState {
+	result := *state
+	result.Tags = append([]string(nil), state.Tags...)
+	return result
+}
+
+// normalizeTags removes empty and duplicate tags, and sorts the rest.
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// applySpaceStates overrides the plotter queue of wsList with persisted desired states,
+// workSpaces never acted on keep what they are configured with.
+// applySpaceStates is not thread safe, should use lock in upper functions
+func (sk *SpaceKeeper) applySpaceStates(wsList []*WorkSpace) {
+	var restored = make(map[string]int)
+	for _, ws := range wsList {
+		sid := ws.id.String()
+		state, ok := sk.spaceStates.Get(sid)
+		if !ok || state.Desired == "" {
+			continue
+		}
+		var wouldMining bool
+		switch state.Desired {
+		case engine.Plot.String():
+		case engine.Mine.String():
+			wouldMining = true
+		case engine.Stop.String():
+			sk.queue.Delete(sid)
+			restored[state.Desired]++
+			continue
+		default:
+			logging.CPrint(logging.WARN, "unknown desired state of workSpace", logging.LogFormat{"sid": sid, "desired": state.Desired})
+			continue
+		}
+		sk.queue.Delete(sid)
+		qws := newQueuedWorkSpace(ws, wouldMining)
+		sk.queue.Push(qws, qws.priority())
+		restored[state.Desired]++
+	}
+	if len(restored) != 0 {
+		logging.CPrint(logging.INFO, "restored desired states of workSpaces", logging.LogFormat{"count": restored})
+	}
+}
+
+// SpaceStates returns persisted desired states of all workSpaces by sid.
+func (sk *SpaceKeeper) SpaceStates() map[string]SpaceState {
+	return sk.spaceStates.Items()
+}
+
+// SetSpaceLabels replaces tags and preferred payout address of workSpace,
+// which are persisted along with its desired state.
+func (sk *SpaceKeeper) SetSpaceLabels(sid string, tags []string, payoutAddress string) (SpaceState, error) {
+	if _, ok := sk.workSpaceIndex[allState].Get(sid); !ok {
+		return SpaceState{}, ErrWorkSpaceDoesNotExist
+	}
+	if err := sk.spaceStates.SetLabels(sid, tags, payoutAddress); err != nil {
+		return SpaceState{}, err
+	}
+	state, _ := sk.spaceStates.Get(sid)
+	return state, nil
+}
+
+// PayoutAddress returns preferred payout address of workSpace, which is paid to
+// instead of the configured ones on blocks mined with its proof, empty if not set.
+func (sk *SpaceKeeper) PayoutAddress(sid string) string {
+	state, _ := sk.spaceStates.Get(sid)
+	return state.PayoutAddress
+}
+
+// persistDesired records the action acted on workSpace. The action has already
+// taken effect on failure, which is logged and returned so that caller knows
+// it would not be restored after restart.
+func (sk *SpaceKeeper) persistDesired(sid string, action engine.ActionType) error {
+	if err := sk.spaceStates.SetDesired(sid, action); err != nil {
+		logging.CPrint(logging.ERROR, "fail to persist desired state of workSpace", logging.LogFormat{"sid": sid, "action": action, "err": err})
+		return err
+	}
+	return nil
+}
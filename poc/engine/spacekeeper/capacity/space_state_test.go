@@ -0,0 +1,116 @@
+package capacity
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"massnet.org/mass/poc/engine"
+)
+
+func TestSpaceStateStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spacestates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, SpaceStateFileName)
+
+	store, err := loadSpaceStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Items()) != 0 {
+		t.Fatalf("expected empty store, got %v", store.Items())
+	}
+
+	if err = store.SetDesired("a", engine.Mine); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.SetDesired("b", engine.Plot); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.SetLabels("b", []string{"ssd", "", "rack1", "ssd"}, "ms1qq"); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.SetDesired("c", engine.Stop); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Delete("c"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]SpaceState{
+		"a": {Desired: "mine"},
+		"b": {Desired: "plot", Tags: []string{"rack1", "ssd"}, PayoutAddress: "ms1qq"},
+	}
+	reloaded, err := loadSpaceStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for sid, state := range reloaded.Items() {
+		if !reflect.DeepEqual(state, expected[sid]) {
+			t.Errorf("sid %s, expected %v, got %v", sid, expected[sid], state)
+		}
+	}
+	if len(reloaded.Items()) != len(expected) {
+		t.Errorf("expected %d states, got %d", len(expected), len(reloaded.Items()))
+	}
+
+	if err = ioutil.WriteFile(path, []byte(`{"version":2,"spaces":{}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = loadSpaceStateStore(path); err != ErrSpaceStateVersion {
+		t.Errorf("expected %v, got %v", ErrSpaceStateVersion, err)
+	}
+}
+
+func TestApplySpaceStates(t *testing.T) {
+	items := newTestQueuedWorkSpaces(t, 4)
+	wsList := make([]*WorkSpace, len(items))
+	for i, qws := range items {
+		wsList[i] = qws.ws
+	}
+	sid := func(i int) string { return wsList[i].id.String() }
+
+	store, err := loadSpaceStateStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.SetDesired(sid(0), engine.Mine)
+	store.SetDesired(sid(1), engine.Stop)
+	store.SetLabels(sid(2), []string{"hdd"}, "ms1qq")
+
+	// configured with neither plot nor mine, so that nothing is queued
+	sk := &SpaceKeeper{queue: newPlotterQueue(), spaceStates: store}
+	if sk.PayoutAddress(sid(2)) != "ms1qq" || sk.PayoutAddress(sid(0)) != "" {
+		t.Errorf("unexpected payout addresses %q, %q", sk.PayoutAddress(sid(2)), sk.PayoutAddress(sid(0)))
+	}
+	sk.applySpaceStates(wsList)
+	if sids := queuedSpaceIDs(sk.queue); len(sids) != 1 || sids[0] != sid(0) {
+		t.Fatalf("expected only workSpace 0 queued, got %v", sids)
+	}
+	if _, queued := sk.queue.Items(); !queued[0].wouldMining {
+		t.Errorf("expected workSpace 0 would be mined")
+	}
+
+	// configured with plot, stopped workSpace leaves queue
+	for _, qws := range items {
+		sk.queue.Push(qws, qws.priority())
+	}
+	sk.applySpaceStates(wsList)
+	_, queued := sk.queue.Items()
+	if len(queued) != 3 {
+		t.Fatalf("expected 3 queued, got %d", len(queued))
+	}
+	for _, qws := range queued {
+		if qws.ws.id.String() == sid(1) {
+			t.Errorf("expected stopped workSpace not queued")
+		}
+		if qws.wouldMining != (qws.ws.id.String() == sid(0)) {
+			t.Errorf("workSpace %s, unexpected wouldMining %v", qws.ws.id.String(), qws.wouldMining)
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"massnet.org/mass/poc"
 	"massnet.org/mass/poc/engine"
 	massdb_v1 "massnet.org/mass/poc/engine/massdb/massdb.v1"
+	massdb_v2 "massnet.org/mass/poc/engine/massdb/massdb.v2"
 	"massnet.org/mass/poc/engine/spacekeeper"
 	"massnet.org/mass/pocec"
 )
@@ -25,9 +26,30 @@ const (
 	typeMassDBV1      = massdb_v1.TypeMassDBV1
 	regMassDBV1       = `^\d+_[A-F0-9]{66}_\d{2}\.MASSDB$`
 	suffixMassDBV1    = ".MASSDB"
+	typeMassDBV2      = massdb_v2.TypeMassDBV2
+	regMassDBV2       = `^\d+_[A-F0-9]{66}_\d{2}\.MASSDB2$`
+	suffixMassDBV2    = ".MASSDB2"
 	TypeSpaceKeeperV1 = "spacekeeper.v1"
 )
 
+// massDBFileFormat matches MassDB file names like `ordinal_pubKey_bitLength.suffix`.
+type massDBFileFormat struct {
+	regExp *regexp.Regexp
+	suffix string
+}
+
+// massDBFileFormats are formats of MassDB files loaded by each dbType, massdb.v2 loads
+// massdb.v1 files as well, which are converted into massdb.v2 on plotting.
+var massDBFileFormats = map[string][]massDBFileFormat{
+	typeMassDBV1: {
+		{regExp: regexp.MustCompile(regMassDBV1), suffix: suffixMassDBV1},
+	},
+	typeMassDBV2: {
+		{regExp: regexp.MustCompile(regMassDBV2), suffix: suffixMassDBV2},
+		{regExp: regexp.MustCompile(regMassDBV1), suffix: suffixMassDBV1},
+	},
+}
+
 // NewSpaceKeeperV1
 func NewSpaceKeeperV1(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
 	cfg, poCWallet, err := parseArgs(args...)
@@ -38,20 +60,31 @@ func NewSpaceKeeperV1(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
 	if err != nil {
 		return nil, err
 	}
+	spaceStates, err := loadSpaceStateStore(filepath.Join(cfg.Miner.MinerDir, SpaceStateFileName))
+	if err != nil {
+		return nil, err
+	}
 	sk := &SpaceKeeper{
 		allowGenerateNewSpace: true,
+		autoMine:              cfg.Miner.AutoMine,
+		proofDirs:             append([]string(nil), cfg.Miner.ProofDir...),
 		dbDirs:                cfg.Miner.ProofDir,
-		dbType:                typeMassDBV1,
+		dbType:                cfg.Miner.MassdbType,
 		wallet:                poCWallet,
 		workSpaceIndex:        make([]*WorkSpaceMap, 0),
 		workSpaceList:         make([]*WorkSpace, 0),
 		queue:                 newPlotterQueue(),
+		plotPerDisk:           int(cfg.Miner.PlotPerDisk),
+		plotMemoryLimit:       cfg.Miner.PlotMemoryMb * poc.MiB,
+		plotTempDir:           cfg.Miner.PlotTempDir,
+		spaceStates:           spaceStates,
 		newQueuedWorkSpaceCh:  make(chan *queuedWorkSpace, plotterMaxChanSize),
 		workerPool:            workerPool,
-		fileWatcher:           func() {},
 	}
 	sk.BaseService = service.NewBaseService(sk, TypeSpaceKeeperV1)
-	sk.generateInitialIndex = func() error { return generateInitialIndex(sk, typeMassDBV1, regMassDBV1, suffixMassDBV1) }
+	sk.generateInitialIndex = func() error { return generateInitialIndex(sk, sk.dbType) }
+	sk.fileWatcher = func() { watchProofDirs(sk, sk.dbType) }
+	massdb_v1.SetPlotTempDir(cfg.Miner.PlotTempDir)
 
 	if err = upgradeMassDBFile(sk); err != nil {
 		return nil, err
@@ -97,6 +130,9 @@ func parseArgs(args ...interface{}) (*config.Config, PoCWallet, error) {
 	if !ok {
 		return nil, nil, spacekeeper.ErrInvalidSKArgs
 	}
+	if _, ok = massDBFileFormats[cfg.Miner.MassdbType]; !ok {
+		return nil, nil, ErrMassDBUnknownType
+	}
 	wallet, ok := args[1].(PoCWallet)
 	if !ok {
 		return nil, nil, spacekeeper.ErrInvalidSKArgs
@@ -105,53 +141,48 @@ func parseArgs(args ...interface{}) (*config.Config, PoCWallet, error) {
 	return cfg, wallet, nil
 }
 
-func generateInitialIndex(sk *SpaceKeeper, dbType, regStrB, suffixB string) error {
+func generateInitialIndex(sk *SpaceKeeper, dbType string) error {
 	for s := engine.FirstState; s <= allState; s++ {
 		sk.workSpaceIndex = append(sk.workSpaceIndex, NewWorkSpaceMap())
 	}
 
-	regExpB, err := regexp.Compile(regStrB)
-	if err != nil {
-		return err
-	}
-
+	formats := massDBFileFormats[dbType]
 	dbDirs, dirFileInfos := prepareDirs(sk.dbDirs)
 	sk.dbDirs = dbDirs
 
 	for idx, dbDir := range dbDirs {
 		for _, fi := range dirFileInfos[idx] {
 			fileName := fi.Name()
-			// try match suffix and `ordinal_pubKey_bitLength.suffix`
-			if !strings.HasSuffix(strings.ToUpper(fileName), suffixB) || !regExpB.MatchString(strings.ToUpper(fileName)) {
-				continue
-			}
-
 			filePath := filepath.Join(dbDir, fileName)
-			// extract args
-			args := strings.Split(fileName[:len(fileName)-len(suffixB)], "_")
-			dbIndex, pubKey, bitLength, err := parseMassDBArgsFromString(args[0], args[1], args[2])
-			if err != nil {
-				logging.CPrint(logging.ERROR, "cannot parse MassDB args from filename", logging.LogFormat{"filepath": filePath, "err": err})
-			}
-
-			// verify db ordinal
-			ordinal, exists := sk.wallet.GetPublicKeyOrdinal(pubKey)
-			if !exists || dbIndex != int(ordinal) {
+			spaceID, err := sk.parseMassDBFileName(fileName, formats)
+			switch err {
+			case nil:
+			case ErrMassDBWrongFileName:
+				continue
+			case ErrWalletDoesNotContainPubKey:
 				logging.CPrint(logging.WARN, "spaceKeeper wallet does not contain pubKey",
-					logging.LogFormat{"filePath": filePath, "err": ErrWalletDoesNotContainPubKey})
+					logging.LogFormat{"filePath": filePath, "err": err})
+				continue
+			default:
+				logging.CPrint(logging.ERROR, "cannot parse MassDB args from filename", logging.LogFormat{"filepath": filePath, "err": err})
 				continue
 			}
+			ordinal, pubKey, bitLength := spaceID.Ordinal(), spaceID.PubKey(), spaceID.BitLength()
 
-			// prevent duplicate MassDB
-			sid := NewSpaceID(int64(ordinal), pubKey, bitLength).String()
-			if _, ok := sk.workSpaceIndex[allState].Get(sid); ok {
+			// prevent duplicate MassDB, files of different formats in the same dir
+			// belong to the same massdb.v2 being converted
+			sid := spaceID.String()
+			if ws, ok := sk.workSpaceIndex[allState].Get(sid); ok {
+				if ws.rootDir == dbDir {
+					continue
+				}
 				logging.CPrint(logging.WARN, "duplicate massdb in root dirs",
 					logging.LogFormat{"filepath": filePath, "err": ErrMassDBDuplicate})
 				continue
 			}
 
 			// NewWorkSpace
-			ws, err := NewWorkSpace(dbType, dbDir, int64(ordinal), pubKey, bitLength)
+			ws, err := NewWorkSpace(dbType, dbDir, ordinal, pubKey, bitLength)
 			if err != nil {
 				logging.CPrint(logging.WARN, "fail on NewWorkSpace",
 					logging.LogFormat{"filepath": filePath, "err": err})
@@ -166,6 +197,38 @@ func generateInitialIndex(sk *SpaceKeeper, dbType, regStrB, suffixB string) erro
 	return nil
 }
 
+// parseMassDBFileName extracts SpaceID from file name like `ordinal_pubKey_bitLength.suffix`,
+// and verifies the ordinal with spaceKeeper wallet.
+// ErrMassDBWrongFileName is returned if the file name does not match any of formats.
+func (sk *SpaceKeeper) parseMassDBFileName(fileName string, formats []massDBFileFormat) (*SpaceID, error) {
+	// try match suffix and `ordinal_pubKey_bitLength.suffix`
+	var suffix string
+	for _, format := range formats {
+		if strings.HasSuffix(strings.ToUpper(fileName), format.suffix) && format.regExp.MatchString(strings.ToUpper(fileName)) {
+			suffix = format.suffix
+			break
+		}
+	}
+	if suffix == "" {
+		return nil, ErrMassDBWrongFileName
+	}
+
+	// extract args
+	args := strings.Split(fileName[:len(fileName)-len(suffix)], "_")
+	dbIndex, pubKey, bitLength, err := parseMassDBArgsFromString(args[0], args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	// verify db ordinal
+	ordinal, exists := sk.wallet.GetPublicKeyOrdinal(pubKey)
+	if !exists || dbIndex != int(ordinal) {
+		return nil, ErrWalletDoesNotContainPubKey
+	}
+
+	return NewSpaceID(int64(ordinal), pubKey, bitLength), nil
+}
+
 func upgradeMassDBFile(sk *SpaceKeeper) error {
 	var oldRegStrB, oldRegStrA = `^[A-F0-9]{66}-\d{2}-B\.MASSDB$`, `^[A-F0-9]{66}-\d{2}-A\.MASSDB$`
 	regExpB, err := regexp.Compile(oldRegStrB)
@@ -3,10 +3,14 @@
 package capacity
 
 import (
+	"path/filepath"
+
 	"github.com/panjf2000/ants"
 	"massnet.org/mass/logging"
 	"massnet.org/mass/massutil/service"
+	"massnet.org/mass/poc"
 	"massnet.org/mass/poc/engine"
+	massdb_v1 "massnet.org/mass/poc/engine/massdb/massdb.v1"
 	"massnet.org/mass/poc/engine/spacekeeper"
 )
 
@@ -21,20 +25,31 @@ func NewSpaceKeeperPlasterer(args ...interface{}) (spacekeeper.SpaceKeeper, erro
 	if err != nil {
 		return nil, err
 	}
+	spaceStates, err := loadSpaceStateStore(filepath.Join(cfg.Miner.MinerDir, SpaceStateFileName))
+	if err != nil {
+		return nil, err
+	}
 	sk := &SpaceKeeper{
 		allowGenerateNewSpace: false,
+		autoMine:              cfg.Miner.AutoMine,
+		proofDirs:             append([]string(nil), cfg.Miner.ProofDir...),
 		dbDirs:                cfg.Miner.ProofDir,
-		dbType:                typeMassDBV1,
+		dbType:                cfg.Miner.MassdbType,
 		wallet:                poCWallet,
 		workSpaceIndex:        make([]*WorkSpaceMap, 0),
 		workSpaceList:         make([]*WorkSpace, 0),
 		queue:                 newPlotterQueue(),
+		plotPerDisk:           int(cfg.Miner.PlotPerDisk),
+		plotMemoryLimit:       cfg.Miner.PlotMemoryMb * poc.MiB,
+		plotTempDir:           cfg.Miner.PlotTempDir,
+		spaceStates:           spaceStates,
 		newQueuedWorkSpaceCh:  make(chan *queuedWorkSpace, plotterMaxChanSize),
 		workerPool:            workerPool,
-		fileWatcher:           func() {},
 	}
 	sk.BaseService = service.NewBaseService(sk, TypeSpaceKeeperV1)
-	sk.generateInitialIndex = func() error { return generateInitialIndex(sk, typeMassDBV1, regMassDBV1, suffixMassDBV1) }
+	sk.generateInitialIndex = func() error { return generateInitialIndex(sk, sk.dbType) }
+	sk.fileWatcher = func() { watchProofDirs(sk, sk.dbType) }
+	massdb_v1.SetPlotTempDir(cfg.Miner.PlotTempDir)
 
 	if err = sk.generateInitialIndex(); err != nil {
 		return nil, err
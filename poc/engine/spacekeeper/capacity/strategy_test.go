@@ -1,10 +1,13 @@
 package capacity
 
 import (
+	"encoding/hex"
 	"errors"
 	"regexp"
 	"strings"
 	"testing"
+
+	"massnet.org/mass/pocec"
 )
 
 func TestMatchMassDBName(t *testing.T) {
@@ -52,3 +55,45 @@ func TestMatchMassDBName(t *testing.T) {
 		}
 	}
 }
+
+// ordinalWallet knows ordinals of public keys only.
+type ordinalWallet struct {
+	PoCWallet
+	ordinals map[string]uint32
+}
+
+func (w *ordinalWallet) GetPublicKeyOrdinal(pubKey *pocec.PublicKey) (uint32, bool) {
+	ordinal, ok := w.ordinals[hex.EncodeToString(pubKey.SerializeCompressed())]
+	return ordinal, ok
+}
+
+func TestParseMassDBFileName(t *testing.T) {
+	const pk = "02be7ff1bbbd42b808cb6b7de2d22cd53dea771c9c599fb034c7b15bae0ec53eb3"
+	sk := &SpaceKeeper{wallet: &ordinalWallet{ordinals: map[string]uint32{pk: 3}}}
+
+	tests := []struct {
+		dbType string
+		name   string
+		err    error
+	}{
+		{typeMassDBV1, "3_" + pk + "_24.massdb", nil},
+		{typeMassDBV1, "3_" + pk + "_24.massdb2", ErrMassDBWrongFileName},
+		{typeMassDBV1, "3_" + pk + "_24_a.massdb", ErrMassDBWrongFileName},
+		{typeMassDBV2, "3_" + pk + "_24.massdb2", nil},
+		{typeMassDBV2, "3_" + strings.ToUpper(pk) + "_24.MASSDB2", nil},
+		{typeMassDBV2, "3_" + pk + "_24.massdb", nil},
+		{typeMassDBV2, "3_" + pk + "_24_a.massdb", ErrMassDBWrongFileName},
+		{typeMassDBV2, "3_" + pk + "_24.massdb3", ErrMassDBWrongFileName},
+		{typeMassDBV2, "4_" + pk + "_24.massdb2", ErrWalletDoesNotContainPubKey},
+	}
+	for i, test := range tests {
+		spaceID, err := sk.parseMassDBFileName(test.name, massDBFileFormats[test.dbType])
+		if err != test.err {
+			t.Errorf("%d, %s %s, error mismatched, got %v, want %v", i, test.dbType, test.name, err, test.err)
+			continue
+		}
+		if err == nil && (spaceID.Ordinal() != 3 || spaceID.BitLength() != 24) {
+			t.Errorf("%d, %s %s, unexpected space id %s", i, test.dbType, test.name, spaceID)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package capacity
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/poc/engine"
+)
+
+// fileWatcherInterval is the interval between two scans of proof dirs.
+const fileWatcherInterval = 10 * time.Second
+
+// fileStat is the part of file info used to decide whether a file
+// is still being copied or written.
+type fileStat struct {
+	size    int64
+	modTime time.Time
+}
+
+// located is the position of a MassDB file found in proof dirs.
+type located struct {
+	sid string
+	dir string
+}
+
+// proofDirWatcher polls proof dirs for MassDB files which are added or removed
+// after spaceKeeper started, e.g. by plugging or unplugging disks.
+// It is only accessed by the fileWatcher goroutine.
+type proofDirWatcher struct {
+	sk      *SpaceKeeper
+	dbType  string
+	formats []massDBFileFormat
+	pending map[string]fileStat // files seen once, waiting to be unchanged for another scan
+	ignored map[string]fileStat // files failed to be loaded, would retry once changed
+}
+
+func watchProofDirs(sk *SpaceKeeper, dbType string) {
+	sk.wg.Add(1)
+	defer sk.wg.Done()
+
+	w := &proofDirWatcher{
+		sk:      sk,
+		dbType:  dbType,
+		formats: massDBFileFormats[dbType],
+		pending: make(map[string]fileStat),
+		ignored: make(map[string]fileStat),
+	}
+
+	ticker := time.NewTicker(fileWatcherInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sk.quit:
+			return
+		case <-ticker.C:
+			w.scan()
+		}
+	}
+}
+
+// scan walks through all configured proof dirs (including dirs which are newly
+// mounted), registers new MassDB files, reloads reappeared MassDB files, and marks
+// workSpaces whose MassDB files have vanished as unavailable.
+func (w *proofDirWatcher) scan() {
+	sk := w.sk
+	found := make(map[located]bool)
+	visited := make(map[string]bool)
+
+	sk.stateLock.RLock()
+	proofDirs := append([]string(nil), sk.proofDirs...)
+	sk.stateLock.RUnlock()
+
+	for _, dir := range proofDirs {
+		fis, err := ioutil.ReadDir(dir)
+		if err != nil {
+			// dir might be not mounted yet
+			continue
+		}
+		for _, fi := range fis {
+			fileName := fi.Name()
+			filePath := filepath.Join(dir, fileName)
+			spaceID, err := sk.parseMassDBFileName(fileName, w.formats)
+			if err != nil {
+				continue
+			}
+			sid := spaceID.String()
+			found[located{sid: sid, dir: dir}] = true
+			visited[filePath] = true
+
+			ws, indexed := sk.workSpaceIndex[allState].Get(sid)
+			if indexed && ws.Available() {
+				continue
+			}
+			stat := fileStat{size: fi.Size(), modTime: fi.ModTime()}
+			if !w.stable(filePath, stat) {
+				continue
+			}
+			if indexed {
+				err = w.reloadWorkSpace(ws, spaceID, dir)
+			} else {
+				err = w.registerWorkSpace(spaceID, dir)
+			}
+			if err != nil {
+				logging.CPrint(logging.WARN, "fail on NewWorkSpace for watched massdb",
+					logging.LogFormat{"filepath": filePath, "reload": indexed, "err": err})
+				w.ignored[filePath] = stat
+			}
+		}
+	}
+
+	for filePath := range w.pending {
+		if !visited[filePath] {
+			delete(w.pending, filePath)
+		}
+	}
+
+	sk.stateLock.RLock()
+	defer sk.stateLock.RUnlock()
+	for sid, ws := range sk.workSpaceIndex[allState].Items() {
+		// only plotted workSpaces are expected to be kept on disk
+		if ws.state != engine.Ready && ws.state != engine.Mining {
+			continue
+		}
+		if !ws.Available() || found[located{sid: sid, dir: ws.rootDir}] {
+			continue
+		}
+		ws.setAvailable(false)
+		logging.CPrint(logging.WARN, "massdb file of workSpace vanished, mark workSpace unavailable",
+			logging.LogFormat{"sid": sid, "dir": ws.rootDir, "state": ws.state.String()})
+	}
+}
+
+// stable returns true if the file keeps the same size and modTime as the previous scan,
+// so that files being copied into proof dirs would not be loaded.
+func (w *proofDirWatcher) stable(filePath string, stat fileStat) bool {
+	if ignored, ok := w.ignored[filePath]; ok {
+		if ignored == stat {
+			return false
+		}
+		delete(w.ignored, filePath)
+	}
+	last, ok := w.pending[filePath]
+	w.pending[filePath] = stat
+	if !ok || last != stat {
+		return false
+	}
+	delete(w.pending, filePath)
+	return true
+}
+
+// registerWorkSpace adds newly found MassDB into spaceKeeper index.
+// If spaceKeeper is configured, the workSpace is used immediately,
+// and it would be mined if autoMine is set and the workSpace is ready.
+func (w *proofDirWatcher) registerWorkSpace(spaceID *SpaceID, dir string) error {
+	sk := w.sk
+	sid := spaceID.String()
+	ws, err := NewWorkSpace(w.dbType, dir, spaceID.Ordinal(), spaceID.PubKey(), spaceID.BitLength())
+	if err != nil {
+		return err
+	}
+
+	sk.stateLock.Lock()
+	defer sk.stateLock.Unlock()
+	if _, ok := sk.workSpaceIndex[allState].Get(sid); ok {
+		ws.db.Close()
+		return nil
+	}
+	sk.addWorkSpaceToIndex(ws)
+	if sk.Configured() {
+		sk.useWorkSpace(ws)
+		if sk.autoMine && ws.state == engine.Ready {
+			sk.workSpaceIndex[engine.Ready].Delete(sid)
+			sk.workSpaceIndex[engine.Mining].Set(sid, ws)
+			ws.state = engine.Mining
+		}
+	}
+	logging.CPrint(logging.INFO, "new massdb registered",
+		logging.LogFormat{"sid": sid, "dir": dir, "state": ws.state.String(), "using": ws.using})
+	return nil
+}
+
+// reloadWorkSpace replaces the unavailable workSpace with MassDB reappeared in dir,
+// the workSpace keeps its previous state if the MassDB is still fully plotted.
+func (w *proofDirWatcher) reloadWorkSpace(old *WorkSpace, spaceID *SpaceID, dir string) error {
+	sk := w.sk
+	sid := spaceID.String()
+	ws, err := NewWorkSpace(w.dbType, dir, spaceID.Ordinal(), spaceID.PubKey(), spaceID.BitLength())
+	if err != nil {
+		return err
+	}
+
+	sk.stateLock.Lock()
+	defer sk.stateLock.Unlock()
+	if current, ok := sk.workSpaceIndex[allState].Get(sid); !ok || current != old || old.Available() {
+		ws.db.Close()
+		return nil
+	}
+	if ws.state == engine.Ready {
+		ws.state = old.state
+	}
+	ws.using = old.using
+	sk.workSpaceIndex[old.state].Delete(sid)
+	sk.workSpaceIndex[ws.state].Set(sid, ws)
+	sk.workSpaceIndex[allState].Set(sid, ws)
+	for i, e := range sk.workSpaceList {
+		if e == old {
+			sk.workSpaceList[i] = ws
+		}
+	}
+	old.db.Close()
+	logging.CPrint(logging.INFO, "massdb reappeared, workSpace available again",
+		logging.LogFormat{"sid": sid, "dir": dir, "state": ws.state.String()})
+	return nil
+}
@@ -0,0 +1,236 @@
+package capacity
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/panjf2000/ants"
+	"massnet.org/mass/massutil/service"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/engine/massdb/massdb.v1"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/pocec"
+)
+
+func TestProofDirWatcherStable(t *testing.T) {
+	w := &proofDirWatcher{
+		pending: make(map[string]fileStat),
+		ignored: make(map[string]fileStat),
+	}
+	now := time.Now()
+	path := "1_massdb.massdb"
+
+	tests := []struct {
+		stat   fileStat
+		stable bool
+	}{
+		{stat: fileStat{size: 10, modTime: now}, stable: false},                  // first seen
+		{stat: fileStat{size: 20, modTime: now.Add(time.Second)}, stable: false}, // still copying
+		{stat: fileStat{size: 20, modTime: now.Add(time.Second)}, stable: true},  // unchanged
+		{stat: fileStat{size: 20, modTime: now.Add(time.Second)}, stable: false}, // seen again after loaded
+	}
+	for i, test := range tests {
+		if stable := w.stable(path, test.stat); stable != test.stable {
+			t.Errorf("%d, stable mismatch, expected %v, got %v", i, test.stable, stable)
+		}
+	}
+
+	// ignored file would not be loaded until changed
+	ignored := fileStat{size: 30, modTime: now}
+	w.pending = make(map[string]fileStat)
+	w.ignored[path] = ignored
+	for i := 0; i < 3; i++ {
+		if w.stable(path, ignored) {
+			t.Errorf("%d, ignored file should not be stable", i)
+		}
+	}
+	changed := fileStat{size: 40, modTime: now}
+	if w.stable(path, changed) || !w.stable(path, changed) {
+		t.Error("changed ignored file should be stable after two scans")
+	}
+	if _, ok := w.ignored[path]; ok {
+		t.Error("changed file should be removed from ignored")
+	}
+}
+
+func TestProofDirWatcherScan(t *testing.T) {
+	rootDir, err := ioutil.TempDir("", "watcher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(rootDir)
+	proofDir, unpluggedDir := filepath.Join(rootDir, "proofs"), filepath.Join(rootDir, "unplugged")
+	if err = os.Mkdir(proofDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	pk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet := &ordinalWallet{ordinals: map[string]uint32{hex.EncodeToString(pk.PubKey().SerializeCompressed()): 1}}
+	sk := &SpaceKeeper{proofDirs: []string{proofDir}, dbType: typeMassDBV1, wallet: wallet}
+	for s := engine.FirstState; s <= allState; s++ {
+		sk.workSpaceIndex = append(sk.workSpaceIndex, NewWorkSpaceMap())
+	}
+	w := &proofDirWatcher{
+		sk:      sk,
+		dbType:  typeMassDBV1,
+		formats: massDBFileFormats[typeMassDBV1],
+		pending: make(map[string]fileStat),
+		ignored: make(map[string]fileStat),
+	}
+	sid := NewSpaceID(1, pk.PubKey(), 24).String()
+	indexed := func() *WorkSpace {
+		ws, _ := sk.workSpaceIndex[allState].Get(sid)
+		return ws
+	}
+
+	// file created in proof dir is registered once unchanged for another scan
+	mdb, err := massdb_v1.NewMassDBV1(proofDir, 1, pk.PubKey(), 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mdb.Close()
+	w.scan()
+	if indexed() != nil {
+		t.Fatal("file is registered on first seen")
+	}
+	w.scan()
+	ws := indexed()
+	if ws == nil || !ws.Available() || ws.rootDir != proofDir {
+		t.Fatalf("file is not registered, %v", ws)
+	}
+	defer func() { indexed().db.Close() }()
+
+	// plotted workSpace is unavailable once its file is removed
+	sk.workSpaceIndex[ws.state].Delete(sid)
+	sk.workSpaceIndex[engine.Mining].Set(sid, ws)
+	ws.state = engine.Mining
+	if err = os.Rename(proofDir, unpluggedDir); err != nil {
+		t.Fatal(err)
+	}
+	w.scan()
+	if ws.Available() {
+		t.Fatal("workSpace is available after file removed")
+	}
+
+	// and reloaded once the file is back, not mined since the file is not plotted
+	if err = os.Rename(unpluggedDir, proofDir); err != nil {
+		t.Fatal(err)
+	}
+	w.scan()
+	w.scan()
+	reloaded := indexed()
+	if reloaded == ws || !reloaded.Available() || reloaded.state != engine.Registered {
+		t.Errorf("workSpace is not reloaded, available %v, state %v", reloaded.Available(), reloaded.state)
+	}
+	if _, ok := sk.workSpaceIndex[engine.Mining].Get(sid); ok {
+		t.Error("reloaded workSpace is kept in previous state")
+	}
+}
+
+func TestProofDirWatcherScanWhileMining(t *testing.T) {
+	rootDir, err := ioutil.TempDir("", "watcher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(rootDir)
+	proofDir, unpluggedDir := filepath.Join(rootDir, "proofs"), filepath.Join(rootDir, "unplugged")
+	if err = os.Mkdir(proofDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	pk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	workerPool, err := ants.NewPoolPreMalloc(maxPoolWorker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet := &ordinalWallet{ordinals: map[string]uint32{hex.EncodeToString(pk.PubKey().SerializeCompressed()): 1}}
+	sk := &SpaceKeeper{proofDirs: []string{proofDir}, dbType: typeMassDBV1, wallet: wallet, workerPool: workerPool, configured: 1}
+	for s := engine.FirstState; s <= allState; s++ {
+		sk.workSpaceIndex = append(sk.workSpaceIndex, NewWorkSpaceMap())
+	}
+	sk.BaseService = service.NewBaseService(service.NewBaseService(nil, ""), TypeSpaceKeeperV1)
+	if err = sk.Start(); err != nil {
+		t.Fatal(err)
+	}
+	w := &proofDirWatcher{
+		sk:      sk,
+		dbType:  typeMassDBV1,
+		formats: massDBFileFormats[typeMassDBV1],
+		pending: make(map[string]fileStat),
+		ignored: make(map[string]fileStat),
+	}
+	defer func() {
+		for _, ws := range sk.workSpaceIndex[allState].Items() {
+			ws.db.Close()
+		}
+	}()
+
+	mdb, err := massdb_v1.NewMassDBV1(proofDir, 1, pk.PubKey(), 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mdb.Close()
+	w.scan()
+	w.scan()
+	sid := NewSpaceID(1, pk.PubKey(), 24).String()
+	ws, ok := sk.workSpaceIndex[allState].Get(sid)
+	if !ok {
+		t.Fatal("file is not registered")
+	}
+	sk.workSpaceIndex[ws.state].Delete(sid)
+	sk.workSpaceIndex[engine.Mining].Set(sid, ws)
+	ws.state = engine.Mining
+
+	// mine while the proof dir is unplugged, plugged back and extended
+	quit := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-quit:
+				return
+			default:
+			}
+			if _, err := sk.GetProofs(context.Background(), engine.SFAll, pocutil.Hash{}); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 3; i++ {
+		if err = os.Rename(proofDir, unpluggedDir); err != nil {
+			t.Fatal(err)
+		}
+		w.scan()
+		if err = os.Rename(unpluggedDir, proofDir); err != nil {
+			t.Fatal(err)
+		}
+		mdb, err = massdb_v1.NewMassDBV1(proofDir, 1, pk.PubKey(), 26+i*2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mdb.Close()
+		w.scan()
+		w.scan()
+	}
+	close(quit)
+	wg.Wait()
+
+	if ids, _ := sk.WorkSpaceIDs(engine.SFAll); len(ids) != 4 {
+		t.Errorf("workSpace count mismatch, expected 4, got %d", len(ids))
+	}
+}
@@ -2,6 +2,7 @@ package capacity
 
 import (
 	"bytes"
+	"sync/atomic"
 
 	"github.com/orcaman/concurrent-map"
 	"massnet.org/mass/poc/engine"
@@ -16,6 +17,7 @@ type WorkSpace struct {
 	state   engine.WorkSpaceState
 	using   bool
 	rootDir string
+	missing int32 // atomic, set if MassDB file has vanished from rootDir
 }
 
 // NewWorkSpace loads MassDB from given rootDir with PubKey&BitLength,
@@ -64,6 +66,20 @@ func (ws *WorkSpace) Info() engine.WorkSpaceInfo {
 	}
 }
 
+// Available returns false if MassDB file of WorkSpace has vanished,
+// e.g. the disk holding it has been unplugged.
+func (ws *WorkSpace) Available() bool {
+	return atomic.LoadInt32(&ws.missing) == 0
+}
+
+func (ws *WorkSpace) setAvailable(available bool) {
+	if available {
+		atomic.StoreInt32(&ws.missing, 0)
+	} else {
+		atomic.StoreInt32(&ws.missing, 1)
+	}
+}
+
 func (ws *WorkSpace) PubKey() *pocec.PublicKey {
 	return ws.db.PubKey()
 }
@@ -0,0 +1,16 @@
+package poolmanager
+
+import "errors"
+
+var (
+	ErrNoPoolPubKey        = errors.New("no pool pubKey is configured")
+	ErrInvalidPoolPubKey   = errors.New("invalid pool pubKey")
+	ErrDuplicatePoolPubKey = errors.New("duplicate pool pubKey")
+	ErrPoolPubKeyExhausted = errors.New("all pool pubKeys are in use")
+	ErrNotPoolPubKey       = errors.New("pubKey does not belong to pool")
+	ErrPoolPubKeyNotBound  = errors.New("pool pubKey is not bound")
+
+	ErrNoPoolSigner         = errors.New("pool signer is not set")
+	ErrPoolSignerResponse   = errors.New("invalid response from pool signer")
+	ErrPoolSignatureInvalid = errors.New("pool signature does not match pubKey")
+)
@@ -0,0 +1,192 @@
+package poolmanager
+
+import (
+	"context"
+	"sync"
+
+	"massnet.org/mass/config"
+	"massnet.org/mass/logging"
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/poc/engine/spacekeeper"
+	"massnet.org/mass/poc/engine/spacekeeper/capacity"
+	"massnet.org/mass/poc/pocutil"
+	"massnet.org/mass/pocec"
+)
+
+const TypePoolManager = "poolmanager"
+
+// Chain is the part of blockchain used by PoolManager to check whether
+// pool pubKeys are bound.
+type Chain interface {
+	BestBlockHeight() uint64
+	CheckPubKeyBinding(pubKey *pocec.PublicKey, bitLength int) (massutil.Amount, bool, error)
+}
+
+// PoolManager is a SpaceKeeper managing spaces plotted with pubKeys owned by a
+// pool, so that no private key is kept locally. Blocks are signed through pool
+// signing callback, and only spaces whose pubKeys are bound on chain are mined.
+type PoolManager struct {
+	*capacity.SpaceKeeper
+	chain         Chain
+	wallet        *poolWallet
+	bindingLock   sync.Mutex
+	bindingHeight uint64
+	bindingCache  map[string]bool // sid -> bound, valid at bindingHeight
+}
+
+// NewPoolManager creates PoolManager with args (*config.Config, Chain) and an
+// optional SignHashFunc. If SignHashFunc is not provided, pool_signer of miner
+// config is used to request signatures.
+func NewPoolManager(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
+	cfg, chain, signHash, err := parseArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := newPoolWallet(cfg.Miner.PoolPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if signHash == nil && cfg.Miner.PoolSigner != "" {
+		signHash = NewHTTPSigner(cfg.Miner.PoolSigner)
+	}
+	wallet.setSignHashFunc(signHash)
+
+	sk, err := capacity.NewSpaceKeeperV1(cfg, wallet)
+	if err != nil {
+		return nil, err
+	}
+	pm := &PoolManager{
+		SpaceKeeper:  sk.(*capacity.SpaceKeeper),
+		chain:        chain,
+		wallet:       wallet,
+		bindingCache: make(map[string]bool),
+	}
+	return pm, nil
+}
+
+func parseArgs(args ...interface{}) (*config.Config, Chain, SignHashFunc, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, nil, nil, spacekeeper.ErrInvalidSKArgs
+	}
+	cfg, ok := args[0].(*config.Config)
+	if !ok {
+		return nil, nil, nil, spacekeeper.ErrInvalidSKArgs
+	}
+	chain, ok := args[1].(Chain)
+	if !ok {
+		return nil, nil, nil, spacekeeper.ErrInvalidSKArgs
+	}
+	var signHash SignHashFunc
+	if len(args) == 3 {
+		if signHash, ok = args[2].(SignHashFunc); !ok {
+			return nil, nil, nil, spacekeeper.ErrInvalidSKArgs
+		}
+	}
+
+	return cfg, chain, signHash, nil
+}
+
+func (pm *PoolManager) Type() string {
+	return TypePoolManager
+}
+
+// SetSignHashFunc replaces the pool signing callback.
+func (pm *PoolManager) SetSignHashFunc(fn SignHashFunc) {
+	pm.wallet.setSignHashFunc(fn)
+}
+
+func (pm *PoolManager) GetProof(ctx context.Context, sid string, challenge pocutil.Hash) (*engine.WorkSpaceProof, error) {
+	proof, err := pm.SpaceKeeper.GetProof(ctx, sid, challenge)
+	if err != nil {
+		return nil, err
+	}
+	if proof.Error == nil && !pm.isBound(proof) {
+		return nil, ErrPoolPubKeyNotBound
+	}
+	return proof, nil
+}
+
+func (pm *PoolManager) GetProofs(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) ([]*engine.WorkSpaceProof, error) {
+	proofs, err := pm.SpaceKeeper.GetProofs(ctx, flags, challenge)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*engine.WorkSpaceProof, 0, len(proofs))
+	for _, proof := range proofs {
+		if proof.Error == nil && !pm.isBound(proof) {
+			continue
+		}
+		result = append(result, proof)
+	}
+	return result, nil
+}
+
+func (pm *PoolManager) GetProofReader(ctx context.Context, sid string, challenge pocutil.Hash) (engine.ProofReader, error) {
+	proof, err := pm.GetProof(ctx, sid, challenge)
+	if err != nil {
+		return nil, err
+	}
+	return newProofReader(ctx, []*engine.WorkSpaceProof{proof}), nil
+}
+
+func (pm *PoolManager) GetProofsReader(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) (engine.ProofReader, error) {
+	proofs, err := pm.GetProofs(ctx, flags, challenge)
+	if err != nil {
+		return nil, err
+	}
+	return newProofReader(ctx, proofs), nil
+}
+
+func newProofReader(ctx context.Context, proofs []*engine.WorkSpaceProof) engine.ProofReader {
+	prw := engine.NewProofRW(ctx, len(proofs))
+	go func() {
+		for i, proof := range proofs {
+			if err := prw.Write(proof); err != nil {
+				logging.CPrint(logging.WARN, "fail to write WorkSpaceProofs to ProofRW", logging.LogFormat{
+					"err":   err,
+					"index": i,
+					"count": len(proofs),
+				})
+				break
+			}
+		}
+		prw.Close()
+	}()
+	return prw
+}
+
+// isBound checks whether the pool pubKey of proof has enough binding for its
+// bitLength. Results are cached until the best chain height changes.
+func (pm *PoolManager) isBound(proof *engine.WorkSpaceProof) bool {
+	pm.bindingLock.Lock()
+	defer pm.bindingLock.Unlock()
+
+	if height := pm.chain.BestBlockHeight(); height != pm.bindingHeight {
+		pm.bindingHeight = height
+		pm.bindingCache = make(map[string]bool)
+	}
+	if bound, ok := pm.bindingCache[proof.SpaceID]; ok {
+		return bound
+	}
+
+	totalBinding, bound, err := pm.chain.CheckPubKeyBinding(proof.PublicKey, proof.Proof.BitLength)
+	if err != nil {
+		logging.CPrint(logging.WARN, "fail to check binding of pool pubKey",
+			logging.LogFormat{"sid": proof.SpaceID, "err": err})
+		return false
+	}
+	if !bound {
+		logging.CPrint(logging.DEBUG, "pool pubKey is not bound",
+			logging.LogFormat{"sid": proof.SpaceID, "total_binding": totalBinding, "height": pm.bindingHeight})
+	}
+	pm.bindingCache[proof.SpaceID] = bound
+	return bound
+}
+
+func init() {
+	spacekeeper.AddSpaceKeeperBackend(spacekeeper.SKBackend{
+		Typ:            TypePoolManager,
+		NewSpaceKeeper: NewPoolManager,
+	})
+}
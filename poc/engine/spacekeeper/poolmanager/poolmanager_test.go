@@ -0,0 +1,128 @@
+package poolmanager
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"massnet.org/mass/massutil"
+	"massnet.org/mass/poc"
+	"massnet.org/mass/poc/engine"
+	"massnet.org/mass/pocec"
+	"massnet.org/mass/wire"
+)
+
+func newTestKeys(t *testing.T, count int) ([]*pocec.PrivateKey, []string) {
+	privKeys := make([]*pocec.PrivateKey, count)
+	pubKeyStrs := make([]string, count)
+	for i := range privKeys {
+		privKey, err := pocec.NewPrivateKey(pocec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKeys[i] = privKey
+		pubKeyStrs[i] = hex.EncodeToString(privKey.PubKey().SerializeCompressed())
+	}
+	return privKeys, pubKeyStrs
+}
+
+func TestPoolWallet(t *testing.T) {
+	privKeys, pubKeyStrs := newTestKeys(t, 3)
+
+	if _, err := newPoolWallet(nil); err != ErrNoPoolPubKey {
+		t.Errorf("expected %v, got %v", ErrNoPoolPubKey, err)
+	}
+	if _, err := newPoolWallet([]string{pubKeyStrs[0], pubKeyStrs[0]}); err != ErrDuplicatePoolPubKey {
+		t.Errorf("expected %v, got %v", ErrDuplicatePoolPubKey, err)
+	}
+	if _, err := newPoolWallet([]string{"00"}); err != ErrInvalidPoolPubKey {
+		t.Errorf("expected %v, got %v", ErrInvalidPoolPubKey, err)
+	}
+
+	w, err := newPoolWallet(pubKeyStrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// pubKey found on disk is never handed out
+	if ordinal, ok := w.GetPublicKeyOrdinal(privKeys[1].PubKey()); !ok || ordinal != 1 {
+		t.Errorf("wrong ordinal, expected (1, true), got (%d, %v)", ordinal, ok)
+	}
+	for _, expected := range []uint32{0, 2} {
+		pubKey, ordinal, err := w.GenerateNewPublicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ordinal != expected || !pubKey.IsEqual(privKeys[expected].PubKey()) {
+			t.Errorf("wrong generated pubKey, expected ordinal %d, got %d", expected, ordinal)
+		}
+	}
+	if _, _, err := w.GenerateNewPublicKey(); err != ErrPoolPubKeyExhausted {
+		t.Errorf("expected %v, got %v", ErrPoolPubKeyExhausted, err)
+	}
+
+	// sign through callback
+	message := []byte("pool manager")
+	if _, err := w.SignMessage(privKeys[0].PubKey(), message); err != ErrNoPoolSigner {
+		t.Errorf("expected %v, got %v", ErrNoPoolSigner, err)
+	}
+	w.setSignHashFunc(func(pubKey *pocec.PublicKey, message []byte) (*pocec.Signature, error) {
+		mHash := wire.HashH(message)
+		return privKeys[0].Sign(mHash[:])
+	})
+	if _, err := w.SignMessage(privKeys[0].PubKey(), message); err != nil {
+		t.Errorf("fail to sign message, %v", err)
+	}
+	if _, err := w.SignMessage(privKeys[1].PubKey(), message); err != ErrPoolSignatureInvalid {
+		t.Errorf("expected %v, got %v", ErrPoolSignatureInvalid, err)
+	}
+	otherKeys, _ := newTestKeys(t, 1)
+	if _, err := w.SignMessage(otherKeys[0].PubKey(), message); err != ErrNotPoolPubKey {
+		t.Errorf("expected %v, got %v", ErrNotPoolPubKey, err)
+	}
+}
+
+type mockChain struct {
+	height uint64
+	bound  map[string]bool
+	checks int
+}
+
+func (c *mockChain) BestBlockHeight() uint64 {
+	return c.height
+}
+
+func (c *mockChain) CheckPubKeyBinding(pubKey *pocec.PublicKey, bitLength int) (massutil.Amount, bool, error) {
+	c.checks++
+	return massutil.ZeroAmount(), c.bound[hex.EncodeToString(pubKey.SerializeCompressed())], nil
+}
+
+func TestPoolManagerIsBound(t *testing.T) {
+	privKeys, pubKeyStrs := newTestKeys(t, 2)
+	chain := &mockChain{height: 1, bound: map[string]bool{pubKeyStrs[0]: true}}
+	pm := &PoolManager{chain: chain, bindingCache: make(map[string]bool)}
+
+	proofs := make([]*engine.WorkSpaceProof, len(privKeys))
+	for i, privKey := range privKeys {
+		proofs[i] = &engine.WorkSpaceProof{
+			SpaceID:   pubKeyStrs[i] + "-32",
+			Proof:     &poc.Proof{BitLength: 32},
+			PublicKey: privKey.PubKey(),
+		}
+	}
+
+	for round := 0; round < 2; round++ {
+		if !pm.isBound(proofs[0]) || pm.isBound(proofs[1]) {
+			t.Errorf("round %d, wrong binding result", round)
+		}
+	}
+	if chain.checks != 2 {
+		t.Errorf("binding should be cached, expected 2 checks, got %d", chain.checks)
+	}
+
+	// cache is reset on new block
+	chain.height++
+	chain.bound[pubKeyStrs[1]] = true
+	if !pm.isBound(proofs[1]) {
+		t.Error("binding should be checked again on new block")
+	}
+}
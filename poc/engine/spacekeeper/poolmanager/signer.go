@@ -0,0 +1,60 @@
+package poolmanager
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"massnet.org/mass/logging"
+	"massnet.org/mass/pocec"
+)
+
+const poolSignerTimeout = 5 * time.Second
+
+type signRequest struct {
+	PubKey  string `json:"pub_key"`
+	Message string `json:"message"`
+}
+
+type signResponse struct {
+	Signature string `json:"signature"`
+}
+
+// NewHTTPSigner returns a SignHashFunc which requests signature from the pool
+// signing service at url.  The request is posted as
+// `{"pub_key": "<compressed pubKey hex>", "message": "<message hex>"}`, and the
+// response should be `{"signature": "<DER signature hex>"}`.
+func NewHTTPSigner(url string) SignHashFunc {
+	client := &http.Client{Timeout: poolSignerTimeout}
+	return func(pubKey *pocec.PublicKey, message []byte) (*pocec.Signature, error) {
+		body, err := json.Marshal(&signRequest{
+			PubKey:  hex.EncodeToString(pubKey.SerializeCompressed()),
+			Message: hex.EncodeToString(message),
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			logging.CPrint(logging.ERROR, "fail to request pool signer", logging.LogFormat{"url": url, "err": err})
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			logging.CPrint(logging.ERROR, "pool signer refused to sign", logging.LogFormat{"url": url, "status": resp.Status})
+			return nil, ErrPoolSignerResponse
+		}
+
+		var result signResponse
+		if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, ErrPoolSignerResponse
+		}
+		sigBytes, err := hex.DecodeString(result.Signature)
+		if err != nil {
+			return nil, ErrPoolSignerResponse
+		}
+		return pocec.ParseDERSignature(sigBytes, pocec.S256())
+	}
+}
@@ -0,0 +1,125 @@
+package poolmanager
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"massnet.org/mass/pocec"
+	"massnet.org/mass/wire"
+)
+
+// SignHashFunc signs message with the private key of pubKey held by the pool.
+// Like PoCWallet, the signature should be made on wire.HashH(message).
+type SignHashFunc func(pubKey *pocec.PublicKey, message []byte) (*pocec.Signature, error)
+
+// poolWallet implements capacity.PoCWallet with public keys owned by the pool.
+// The ordinal of a pubKey is its index in configured pool pubKeys, so new
+// pubKeys should only be appended to the config.
+type poolWallet struct {
+	mu       sync.Mutex
+	pubKeys  []*pocec.PublicKey
+	ordinals map[string]uint32 // compressed pubKey hex -> ordinal
+	inUse    map[uint32]bool   // pubKeys found on disk or handed out
+	signHash SignHashFunc
+}
+
+func newPoolWallet(pubKeyStrs []string) (*poolWallet, error) {
+	if len(pubKeyStrs) == 0 {
+		return nil, ErrNoPoolPubKey
+	}
+	w := &poolWallet{
+		pubKeys:  make([]*pocec.PublicKey, 0, len(pubKeyStrs)),
+		ordinals: make(map[string]uint32),
+		inUse:    make(map[uint32]bool),
+	}
+	for i, str := range pubKeyStrs {
+		bs, err := hex.DecodeString(str)
+		if err != nil {
+			return nil, ErrInvalidPoolPubKey
+		}
+		pubKey, err := pocec.ParsePubKey(bs, pocec.S256())
+		if err != nil {
+			return nil, ErrInvalidPoolPubKey
+		}
+		key := hex.EncodeToString(pubKey.SerializeCompressed())
+		if _, exists := w.ordinals[key]; exists {
+			return nil, ErrDuplicatePoolPubKey
+		}
+		w.ordinals[key] = uint32(i)
+		w.pubKeys = append(w.pubKeys, pubKey)
+	}
+	return w, nil
+}
+
+// GenerateNewPublicKey returns the first pool pubKey which is neither found on
+// disk nor handed out before.
+func (w *poolWallet) GenerateNewPublicKey() (*pocec.PublicKey, uint32, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for i, pubKey := range w.pubKeys {
+		ordinal := uint32(i)
+		if !w.inUse[ordinal] {
+			w.inUse[ordinal] = true
+			return pubKey, ordinal, nil
+		}
+	}
+	return nil, 0, ErrPoolPubKeyExhausted
+}
+
+// GetPublicKeyOrdinal returns the ordinal of pool pubKey. It is called by
+// SpaceKeeper for massdb files found on disk, so the pubKey is marked in use.
+func (w *poolWallet) GetPublicKeyOrdinal(pubKey *pocec.PublicKey) (uint32, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ordinal, exists := w.ordinals[hex.EncodeToString(pubKey.SerializeCompressed())]
+	if exists {
+		w.inUse[ordinal] = true
+	}
+	return ordinal, exists
+}
+
+// SignMessage resolves signature through pool signing callback, and the
+// signature is verified before returned.
+func (w *poolWallet) SignMessage(pubKey *pocec.PublicKey, message []byte) (*pocec.Signature, error) {
+	w.mu.Lock()
+	_, exists := w.ordinals[hex.EncodeToString(pubKey.SerializeCompressed())]
+	signHash := w.signHash
+	w.mu.Unlock()
+
+	if !exists {
+		return nil, ErrNotPoolPubKey
+	}
+	if signHash == nil {
+		return nil, ErrNoPoolSigner
+	}
+	sig, err := signHash(pubKey, message)
+	if err != nil {
+		return nil, err
+	}
+	mHash := wire.HashH(message)
+	if !sig.Verify(mHash[:], pubKey) {
+		return nil, ErrPoolSignatureInvalid
+	}
+	return sig, nil
+}
+
+func (w *poolWallet) setSignHashFunc(fn SignHashFunc) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.signHash = fn
+}
+
+// Unlock does nothing as poolWallet holds no private key.
+func (w *poolWallet) Unlock(password []byte) error {
+	return nil
+}
+
+// Lock does nothing as poolWallet holds no private key.
+func (w *poolWallet) Lock() {}
+
+// IsLocked always returns false as poolWallet holds no private key.
+func (w *poolWallet) IsLocked() bool {
+	return false
+}
@@ -2,11 +2,13 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 
 	"massnet.org/mass/api"
 	"massnet.org/mass/blockchain"
+	"massnet.org/mass/config"
 	"massnet.org/mass/consensus"
 	"massnet.org/mass/database"
 	"massnet.org/mass/logging"
@@ -49,6 +51,12 @@ func (s *server) Start() {
 	// Start SyncManager
 	s.syncManager.Start()
 
+	// Reload transactions saved on last shutdown, they are relayed again
+	// once accepted.
+	if _, _, err := s.chain.GetTxPool().LoadMempool(mempoolFilePath()); err != nil {
+		logging.CPrint(logging.WARN, "fail to load memory pool", logging.LogFormat{"err": err})
+	}
+
 	// Start SpaceKeeper
 	if cfg.Miner.Plot {
 		s.spaceKeeper.Start()
@@ -85,12 +93,20 @@ func (s *server) Stop() error {
 		s.spaceKeeper.Stop()
 	}
 
+	// Save the memory pool, which is reloaded on next startup
+	if _, _, err := s.chain.GetTxPool().SaveMempool(mempoolFilePath()); err != nil {
+		logging.CPrint(logging.ERROR, "fail to save memory pool", logging.LogFormat{"err": err})
+	}
+
 	if err := s.pocWallet.Close(); err != nil {
 		logging.CPrint(logging.ERROR, "fail to quit wallet", logging.LogFormat{"err": err})
 	}
 
 	s.syncManager.Stop()
 
+	// Stop script validation workers once no more blocks are received
+	s.chain.Stop()
+
 	// Signal the remaining goroutines to quit.
 	close(s.quit)
 
@@ -104,6 +120,11 @@ func (s *server) WaitForShutdown() {
 	s.wg.Wait()
 }
 
+// mempoolFilePath returns the path of the file the memory pool is saved to.
+func mempoolFilePath() string {
+	return filepath.Join(cfg.Db.DataDir, blockchain.MempoolFileName)
+}
+
 // newServer returns a new mass server configured to listen on addr for the
 // Mass network.
 func newServer(miningAddrs []massutil.Address, db database.Db, pocWallet *wallet.PoCWallet) (*server, error) {
@@ -115,11 +136,30 @@ func newServer(miningAddrs []massutil.Address, db database.Db, pocWallet *wallet
 
 	var err error
 	// Create Blockchain
-	s.chain, err = blockchain.NewBlockchain(db, cfg.Db.DataDir, s)
+	svCfg := &blockchain.ScriptValidationConfig{
+		Workers:      int(cfg.Chain.ScriptWorkers),
+		SigCacheSize: uint(cfg.Chain.SigCacheSize),
+	}
+	if cfg.Chain.AssumeValid != "" {
+		if svCfg.AssumeValid, err = config.DecodeAssumeValid(cfg.Chain.AssumeValid); err != nil {
+			logging.CPrint(logging.ERROR, "invalid assume valid block", logging.LogFormat{"err": err})
+			return nil, err
+		}
+	}
+	s.chain, err = blockchain.NewBlockchain(db, cfg.Db.DataDir, s, svCfg)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "fail on new BlockChain", logging.LogFormat{"err": err})
 		return nil, err
 	}
+	if cfg.Miner.TemplateStrategy != "" {
+		policy := s.chain.TemplatePolicy()
+		policy.Strategy = cfg.Miner.TemplateStrategy
+		if err = s.chain.SetTemplatePolicy(policy); err != nil {
+			logging.CPrint(logging.ERROR, "fail on set block template policy",
+				logging.LogFormat{"err": err, "strategy": cfg.Miner.TemplateStrategy, "available": blockchain.TemplateStrategies()})
+			return nil, err
+		}
+	}
 
 	// New SyncManager
 	newBlockCh := make(chan *wire.Hash, consensus.MaxNewBlockChSize)
@@ -78,3 +78,23 @@ func addInterruptHandler(handler func()) {
 
 	addHandlerChannel <- handler
 }
+
+// scopedInterrupt returns a channel closed when a SIGINT (Ctrl+C) is received
+// before stop is called, so that a step at startup, e.g. reindexing, could be
+// interrupted without leaving a handler for the rest of the process.
+func scopedInterrupt() (interrupt <-chan struct{}, stop func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	interrupted, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		select {
+		case <-signals:
+			close(interrupted)
+		case <-done:
+		}
+	}()
+	return interrupted, func() {
+		signal.Stop(signals)
+		close(done)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"sync"
+	"sync/atomic"
 
 	"github.com/btcsuite/btcd/btcec"
 	"massnet.org/mass/wire"
@@ -35,6 +36,26 @@ type SigCache struct {
 	sync.RWMutex
 	validSigs  map[sigInfo]struct{}
 	maxEntries uint
+
+	// hits and misses are updated atomically by Exists.
+	hits   uint64
+	misses uint64
+}
+
+// SigCacheStats is a snapshot of size and lookups of SigCache.
+type SigCacheStats struct {
+	Entries    uint
+	MaxEntries uint
+	Hits       uint64
+	Misses     uint64
+}
+
+// HitRate returns the ratio of lookups found in cache, or 0 if there is no lookup.
+func (s SigCacheStats) HitRate() float64 {
+	if total := s.Hits + s.Misses; total != 0 {
+		return float64(s.Hits) / float64(total)
+	}
+	return 0
 }
 
 // NewSigCache creates and initializes a new instance of SigCache. Its sole
@@ -58,9 +79,29 @@ func (s *SigCache) Exists(sigHash wire.Hash, sig *btcec.Signature, pubKey *btcec
 	s.RLock()
 	_, ok := s.validSigs[info]
 	s.RUnlock()
+	if ok {
+		atomic.AddUint64(&s.hits, 1)
+	} else {
+		atomic.AddUint64(&s.misses, 1)
+	}
 	return ok
 }
 
+// Stats returns the number of entries and lookups of the SigCache.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *SigCache) Stats() SigCacheStats {
+	s.RLock()
+	entries := uint(len(s.validSigs))
+	s.RUnlock()
+	return SigCacheStats{
+		Entries:    entries,
+		MaxEntries: s.maxEntries,
+		Hits:       atomic.LoadUint64(&s.hits),
+		Misses:     atomic.LoadUint64(&s.misses),
+	}
+}
+
 // Add adds an entry for a signature over 'sigHash' under public key 'pubKey'
 // to the signature cache. In the event that the SigCache is 'full', an
 // existing entry it randomly chosen to be evicted in order to make space for
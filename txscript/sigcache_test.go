@@ -138,3 +138,29 @@ func TestSigCacheAddMaxEntriesZeroOrNegative(t *testing.T) {
 			"been added", len(sigCache.validSigs))
 	}
 }
+
+// TestSigCacheStats tests that lookups are counted as hits and misses.
+func TestSigCacheStats(t *testing.T) {
+	sigCache := NewSigCache(10)
+
+	msg1, sig1, key1, err := genRandomSig()
+	if err != nil {
+		t.Fatalf("unable to generate random signature test data")
+	}
+	if stats := sigCache.Stats(); stats.HitRate() != 0 {
+		t.Errorf("expected hit rate 0 without lookup, got %v", stats.HitRate())
+	}
+
+	sigCache.Exists(*msg1, sig1, key1)
+	sigCache.Add(*msg1, sig1, key1)
+	sigCache.Exists(*msg1, sig1, key1)
+	sigCache.Exists(*msg1, sig1, key1)
+
+	expected := SigCacheStats{Entries: 1, MaxEntries: 10, Hits: 2, Misses: 1}
+	if stats := sigCache.Stats(); stats != expected {
+		t.Errorf("expected stats %+v, got %+v", expected, stats)
+	}
+	if rate := sigCache.Stats().HitRate(); rate < 0.66 || rate > 0.67 {
+		t.Errorf("expected hit rate 2/3, got %v", rate)
+	}
+}